@@ -0,0 +1,83 @@
+// Command tm-export exports the results of a query on the public data model
+// to CSV, JSON Lines or XLSX.
+//
+//	tm-export -o sales.xlsx "SELECT * FROM tm.order WHERE createdts > '2016-01-01'"
+//
+// The client is configured with ticketmatic.NewClientFromEnv: a profile named
+// by TM_PROFILE, the TM_ACCOUNTCODE, TM_ACCESSKEY and TM_SECRETKEY variables,
+// or the default profile.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/export"
+	"github.com/ticketmatic/tm-go/ticketmatic/tools"
+)
+
+func main() {
+	format := flag.String("format", "", "Output format: csv, jsonl or xlsx (default: based on the output file name, csv otherwise)")
+	output := flag.String("o", "", "Output file (default: stdout)")
+	locale := flag.String("locale", "en", "Number and date formatting for CSV: en, nl, fr or de")
+	columns := flag.String("columns", "", "Comma-separated list of columns to export (default: all, sorted by name)")
+	sheet := flag.String("sheet", "", "Worksheet name for XLSX")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] query\n\nUse - as query to read it from stdin.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	err := run(flag.Arg(0), *output, *format, *locale, *columns, *sheet)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tm-export: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(query, output, format, locale, columns, sheet string) error {
+	if query == "-" {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		query = string(data)
+	}
+
+	opts := &export.Options{
+		Columns: export.ParseColumns(columns),
+		Locale:  export.LookupLocale(locale),
+		Sheet:   sheet,
+	}
+	if opts.Locale == nil {
+		return fmt.Errorf("Unknown locale: %s", locale)
+	}
+
+	client, err := ticketmatic.NewClientFromEnv()
+	if err != nil {
+		return err
+	}
+
+	stream, err := tools.Export(client, &ticketmatic.QueryRequest{
+		Query: query,
+	})
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	n, err := export.CopyFile(output, format, os.Stdout, stream, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d rows\n", n)
+	return nil
+}
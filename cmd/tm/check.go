@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/ticketmatic/tm-go/ticketmatic/integrity"
+)
+
+var checkCommand = &command{
+	Name: "check",
+	Args: "[-unused] [-affected resource/id]",
+	Help: "Check the configuration for references to missing or archived objects",
+	Run:  check,
+}
+
+func check(e *env, args []string) error {
+	fs := flag.NewFlagSet("check", flag.ContinueOnError)
+	unused := fs.Bool("unused", false, "Also list settings objects that aren't used")
+	affected := fs.String("affected", "", "Only list the events affected by archiving an object, such as pricetypes/12")
+	_, err := e.parse(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	var resource string
+	var id int64
+	if *affected != "" {
+		parts := strings.SplitN(*affected, "/", 2)
+		if len(parts) != 2 {
+			return errUsage
+		}
+		resource = parts[0]
+		id, err = parseID(parts[1])
+		if err != nil {
+			return err
+		}
+	}
+
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	g, err := integrity.Load(c)
+	if err != nil {
+		return err
+	}
+
+	if *affected != "" {
+		if g.Node(resource, id) == nil {
+			return fmt.Errorf("%s doesn't exist", *affected)
+		}
+		for _, n := range g.Affected(resource, id) {
+			fmt.Fprintln(e.out, n)
+		}
+		return nil
+	}
+
+	issues := g.Check()
+	if *unused {
+		issues = append(issues, g.Unused()...)
+	}
+	for _, issue := range issues {
+		fmt.Fprintln(e.out, issue)
+	}
+	return nil
+}
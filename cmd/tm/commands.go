@@ -0,0 +1,20 @@
+package main
+
+func init() {
+	root.Subs = []*command{
+		ordersCommand,
+		eventsCommand,
+		contactsCommand,
+		newSettingsCommand(),
+		toolsCommand,
+		snapshotCommand,
+		promoteCommand,
+		checkCommand,
+		mirrorCommand,
+		eventstreamCommand,
+		jobsCommand,
+		profilesCommand,
+		completionCommand,
+		completeCommand,
+	}
+}
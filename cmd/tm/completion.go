@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const bashCompletion = `_tm() {
+	local cur="${COMP_WORDS[COMP_CWORD]}"
+	COMPREPLY=( $(compgen -W "$(tm __complete "${COMP_WORDS[@]:1:COMP_CWORD-1}")" -- "$cur") )
+}
+complete -F _tm tm
+`
+
+const zshCompletion = `autoload -U +X bashcompinit && bashcompinit
+` + bashCompletion
+
+const fishCompletion = `complete -c tm -f -a '(tm __complete (commandline -opc)[2..-1])'
+`
+
+var completionCommand = &command{
+	Name: "completion",
+	Args: "bash|zsh|fish",
+	Help: "Print a shell completion script",
+	Run: func(e *env, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+		switch args[0] {
+		case "bash":
+			fmt.Fprint(e.out, bashCompletion)
+		case "zsh":
+			fmt.Fprint(e.out, zshCompletion)
+		case "fish":
+			fmt.Fprint(e.out, fishCompletion)
+		default:
+			return fmt.Errorf("Unsupported shell: %s", args[0])
+		}
+		return nil
+	},
+}
+
+// Used by the completion scripts: prints the candidates for the word after
+// the given ones.
+var completeCommand = &command{
+	Name:   "__complete",
+	Hidden: true,
+	Run: func(e *env, args []string) error {
+		for _, c := range complete(args) {
+			fmt.Fprintln(e.out, c)
+		}
+		return nil
+	},
+}
+
+// Global options that take a value
+var valueFlags = map[string]bool{
+	"-profile": true,
+	"-format":  true,
+	"-columns": true,
+}
+
+// Completion candidates for the word following words
+func complete(words []string) []string {
+	cmd := root
+	for i := 0; i < len(words); i++ {
+		w := strings.TrimLeft(words[i], "-")
+		if strings.HasPrefix(words[i], "-") {
+			if cmd == root && valueFlags["-"+w] {
+				if i == len(words)-1 {
+					return completeFlag("-" + w)
+				}
+				i++
+			}
+			continue
+		}
+		sub := cmd.find(words[i])
+		if sub == nil {
+			return nil
+		}
+		cmd = sub
+	}
+
+	var names []string
+	for _, s := range cmd.Subs {
+		if !s.Hidden {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+func completeFlag(flag string) []string {
+	switch flag {
+	case "-format":
+		return []string{"json", "table"}
+	case "-profile":
+		p, err := loadProfiles()
+		if err != nil {
+			return nil
+		}
+		return p.Names()
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/contacts"
+	"github.com/ticketmatic/tm-go/ticketmatic/events"
+)
+
+var eventsCommand = &command{
+	Name: "events",
+	Help: "Look up events",
+	Subs: []*command{
+		{
+			Name: "list",
+			Args: "[-filter query] [-limit n] [-offset n] [-orderby field]",
+			Help: "List events",
+			Run:  eventsList,
+		},
+		{
+			Name: "get",
+			Args: "id",
+			Help: "Get a single event",
+			Run:  eventsGet,
+		},
+		{
+			Name: "tickets",
+			Args: "id",
+			Help: "Get the tickets of an event",
+			Run:  eventsTickets,
+		},
+	},
+}
+
+var contactsCommand = &command{
+	Name: "contacts",
+	Help: "Look up contacts",
+	Subs: []*command{
+		{
+			Name: "list",
+			Args: "[-filter query] [-limit n] [-offset n] [-orderby field]",
+			Help: "List contacts",
+			Run:  contactsList,
+		},
+		{
+			Name: "get",
+			Args: "id | -email address",
+			Help: "Get a single contact",
+			Run:  contactsGet,
+		},
+	},
+}
+
+func eventsList(e *env, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	q := &ticketmatic.EventQuery{}
+	fs.StringVar(&q.Filter, "filter", "", "Filter query, such as \"SELECT id FROM tm.event WHERE startts > now()\"")
+	fs.Int64Var(&q.Limit, "limit", 0, "Maximum number of events")
+	fs.Int64Var(&q.Offset, "offset", 0, "Number of events to skip")
+	fs.StringVar(&q.Orderby, "orderby", "", "Field to order by")
+	_, err := e.parse(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	list, err := events.Getlist(c, q)
+	if err != nil {
+		return err
+	}
+	return e.print(list.Data, "id", "name", "startts", "locationname", "currentstatus")
+}
+
+func eventsGet(e *env, args []string) error {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	id, err := parseID(rest[0])
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	ev, err := events.Get(c, id)
+	if err != nil {
+		return err
+	}
+	return e.print(ev)
+}
+
+func eventsTickets(e *env, args []string) error {
+	fs := flag.NewFlagSet("tickets", flag.ContinueOnError)
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	id, err := parseID(rest[0])
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	stream, err := events.Gettickets(c, id, nil)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	var tickets []*ticketmatic.EventTicket
+	for {
+		t, err := stream.Next()
+		if err != nil {
+			return err
+		}
+		if t == nil {
+			break
+		}
+		tickets = append(tickets, t)
+	}
+	return e.print(tickets, "id", "orderid", "tickettypepriceid", "price", "seatdescription", "barcode")
+}
+
+func contactsList(e *env, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	q := &ticketmatic.ContactQuery{}
+	fs.StringVar(&q.Filter, "filter", "", "Filter query, such as \"SELECT id FROM tm.contact WHERE email ILIKE '%@example.com'\"")
+	fs.Int64Var(&q.Limit, "limit", 0, "Maximum number of contacts")
+	fs.Int64Var(&q.Offset, "offset", 0, "Number of contacts to skip")
+	fs.StringVar(&q.Orderby, "orderby", "", "Field to order by")
+	fs.BoolVar(&q.Includearchived, "archived", false, "Include archived contacts")
+	_, err := e.parse(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	list, err := contacts.Getlist(c, q)
+	if err != nil {
+		return err
+	}
+	return e.print(list.Data, "id", "firstname", "lastname", "email", "createdts")
+}
+
+func contactsGet(e *env, args []string) error {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	q := &ticketmatic.ContactGetQuery{}
+	fs.StringVar(&q.Email, "email", "", "Find the contact by email address instead of id")
+	rest, err := e.parse(fs, args, 0, 1)
+	if err != nil {
+		return err
+	}
+	var id int64
+	if len(rest) == 1 {
+		id, err = parseID(rest[0])
+		if err != nil {
+			return err
+		}
+	} else if q.Email == "" {
+		return errUsage
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	contact, err := contacts.Get(c, id, q)
+	if err != nil {
+		return err
+	}
+	return e.print(contact)
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/eventstream"
+	"github.com/ticketmatic/tm-go/ticketmatic/jobs"
+)
+
+var eventstreamCommand = &command{
+	Name: "eventstream",
+	Help: "Follow the eventstream",
+	Subs: []*command{
+		{
+			Name: "tail",
+			Args: "[-types a,b] [-since ts | -id id] [-interval d] [-follow=false]",
+			Help: "Print eventstream items as they arrive",
+			Run:  eventstreamTail,
+		},
+	},
+}
+
+var jobsCommand = &command{
+	Name: "jobs",
+	Help: "Follow background jobs",
+	Subs: []*command{
+		{
+			Name: "get",
+			Args: "id",
+			Help: "Get the status of a job",
+			Run:  jobsGet,
+		},
+		{
+			Name: "wait",
+			Args: "[-interval d] [-timeout d] id",
+			Help: "Wait for a job to complete",
+			Run:  jobsWait,
+		},
+	},
+}
+
+func eventstreamTail(e *env, args []string) error {
+	fs := flag.NewFlagSet("tail", flag.ContinueOnError)
+	req := &ticketmatic.EventstreamRequest{}
+	fs.StringVar(&req.Eventtypes, "types", "", "Comma-separated list of event types")
+	fs.StringVar(&req.Ts, "since", "", "Start reading at this ISO-8601 timestamp")
+	fs.StringVar(&req.Id, "id", "", "Start reading at this id")
+	interval := fs.Duration("interval", 5*time.Second, "Time between polls when caught up")
+	follow := fs.Bool("follow", true, "Keep polling for new items, stop when caught up otherwise")
+	_, err := e.parse(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+
+	for {
+		res, err := eventstream.Eventstream(c, req)
+		if err != nil {
+			return err
+		}
+		for _, item := range res.Results {
+			err := e.printItem(item)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Continue from the next id, not the starting timestamp
+		if res.Nextid != "" {
+			req.Id = res.Nextid
+			req.Ts = ""
+		}
+		if !res.Moreresults {
+			if !*follow {
+				return nil
+			}
+			time.Sleep(*interval)
+		}
+	}
+}
+
+// Print a single eventstream item as a line
+func (e *env) printItem(item *ticketmatic.EventstreamItem) error {
+	if e.format == "table" {
+		_, err := fmt.Fprintf(e.out, "%s\t%s\t%s\t%s\n", item.Ts, item.Type, item.Id, cell(item.Data))
+		return err
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(e.out, "%s\n", data)
+	return err
+}
+
+func jobsGet(e *env, args []string) error {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	id, err := parseID(rest[0])
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	job, err := jobs.Get(c, id)
+	if err != nil {
+		return err
+	}
+	return e.print(job)
+}
+
+func jobsWait(e *env, args []string) error {
+	fs := flag.NewFlagSet("wait", flag.ContinueOnError)
+	interval := fs.Duration("interval", 2*time.Second, "Time between polls")
+	timeout := fs.Duration("timeout", time.Hour, "Give up after this time, 0 waits forever")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	id, err := parseID(rest[0])
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+
+	// The values of the job status aren't documented, so completion is
+	// determined by the progress and the status is only reported.
+	start := time.Now()
+	progress := ""
+	status := int64(-1)
+	for {
+		job, err := jobs.Get(c, id)
+		if err != nil {
+			return err
+		}
+		if job.Progresstext != progress || job.Status != status {
+			progress = job.Progresstext
+			status = job.Status
+			fmt.Fprintf(e.err, "%d%% %s (status %d)\n", job.Progress, progress, status)
+		}
+		if job.Progress >= 100 {
+			return e.print(job)
+		}
+		if *timeout > 0 && time.Since(start) > *timeout {
+			return fmt.Errorf("Timeout waiting for job %d (%d%%, status %d)", id, job.Progress, job.Status)
+		}
+		time.Sleep(*interval)
+	}
+}
@@ -0,0 +1,191 @@
+// Command tm is a command-line client for the Ticketmatic API.
+//
+//	tm [-profile name] [-format json|table] [-columns a,b] [-dry-run] command [subcommand] [options] [arguments]
+//
+// Credentials are taken from a profile (see "tm profiles") or from the
+// TM_ACCOUNTCODE, TM_ACCESSKEY and TM_SECRETKEY environment variables.
+//
+// Examples:
+//
+//	tm orders get 1234
+//	tm orders resend -template 3 1234
+//	tm -format table events list -filter "SELECT id FROM tm.event WHERE startts > now()"
+//	tm settings pricetypes
+//	tm settings search -archived student
+//	tm tools export -o sales.xlsx "SELECT * FROM tm.order"
+//	tm snapshot plan ./config
+//	tm eventstream tail -types order
+//	tm jobs wait 12
+//
+// Shell completion is available through "tm completion bash|zsh|fish".
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/export"
+)
+
+// A (sub)command
+type command struct {
+	// Name used on the command line
+	Name string
+
+	// Usage of the options and arguments
+	Args string
+
+	// One-line description
+	Help string
+
+	// Run the command, nil for commands that only group subcommands
+	Run func(e *env, args []string) error
+
+	// Subcommands
+	Subs []*command
+
+	// Not listed in help and completion
+	Hidden bool
+}
+
+func (c *command) find(name string) *command {
+	for _, s := range c.Subs {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// Shared state for the commands
+type env struct {
+	// Profile name, empty for the default
+	profile string
+
+	// Log changes instead of making them
+	dryRun bool
+
+	// Output format: json or table
+	format string
+
+	// Columns for table output
+	columns []string
+
+	// Output streams
+	out io.Writer
+	err io.Writer
+
+	client *ticketmatic.Client
+}
+
+// Get the API client, configured from the selected profile
+func (e *env) Client() (*ticketmatic.Client, error) {
+	if e.client != nil {
+		return e.client, nil
+	}
+	c, err := loadClient(e.profile)
+	if err != nil {
+		return nil, err
+	}
+	if e.dryRun {
+		c.Guard = &ticketmatic.Guard{
+			Mode: ticketmatic.GuardModeDryRun,
+			Log:  e.err,
+		}
+	}
+	e.client = c
+	return c, nil
+}
+
+// Parse the flags of a command, check the number of remaining arguments
+func (e *env) parse(fs *flag.FlagSet, args []string, min, max int) ([]string, error) {
+	fs.SetOutput(e.err)
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+	rest := fs.Args()
+	if len(rest) < min || (max >= 0 && len(rest) > max) {
+		return nil, errUsage
+	}
+	return rest, nil
+}
+
+var errUsage = fmt.Errorf("Invalid arguments")
+
+var root = &command{
+	Name: "tm",
+}
+
+func main() {
+	profile := flag.String("profile", os.Getenv("TM_PROFILE"), "Credentials profile to use")
+	format := flag.String("format", "json", "Output format: json or table")
+	columns := flag.String("columns", "", "Comma-separated list of columns for table output")
+	dryRun := flag.Bool("dry-run", false, "Show the requests that would change the account instead of sending them")
+	flag.Usage = func() {
+		usage(os.Stderr, []string{"tm"}, root)
+		fmt.Fprintf(os.Stderr, "\nGlobal options:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	e := &env{
+		profile: *profile,
+		dryRun:  *dryRun,
+		format:  *format,
+		out:     os.Stdout,
+		err:     os.Stderr,
+	}
+	if *columns != "" {
+		e.columns = export.ParseColumns(*columns)
+	}
+	if e.format != "json" && e.format != "table" {
+		fmt.Fprintf(os.Stderr, "tm: Unknown output format: %s\n", e.format)
+		os.Exit(2)
+	}
+
+	err := dispatch(e, []string{"tm"}, root, flag.Args())
+	if err == errUsage || err == flag.ErrHelp {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tm: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// Find and run the command for the arguments
+func dispatch(e *env, path []string, cmd *command, args []string) error {
+	if len(cmd.Subs) > 0 && len(args) > 0 {
+		if sub := cmd.find(args[0]); sub != nil {
+			return dispatch(e, append(path, sub.Name), sub, args[1:])
+		}
+	}
+
+	if cmd.Run == nil {
+		usage(e.err, path, cmd)
+		if len(args) > 0 {
+			return fmt.Errorf("Unknown command: %s", strings.Join(append(path[1:], args[0]), " "))
+		}
+		return errUsage
+	}
+
+	err := cmd.Run(e, args)
+	if err == errUsage {
+		fmt.Fprintf(e.err, "Usage: %s %s\n", strings.Join(path, " "), cmd.Args)
+	}
+	return err
+}
+
+func usage(w io.Writer, path []string, cmd *command) {
+	fmt.Fprintf(w, "Usage: %s <command>\n\nCommands:\n", strings.Join(path, " "))
+	for _, s := range cmd.Subs {
+		if !s.Hidden {
+			fmt.Fprintf(w, "  %-20s %s\n", s.Name, s.Help)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testEnv(format string) (*env, *bytes.Buffer) {
+	var out bytes.Buffer
+	return &env{
+		format: format,
+		out:    &out,
+		err:    &bytes.Buffer{},
+	}, &out
+}
+
+func TestPrintTable(t *testing.T) {
+	e, out := testEnv("table")
+	list := []map[string]interface{}{
+		{"id": 1, "name": "Free", "nested": map[string]interface{}{"a": 1}},
+		{"id": 22, "name": "Regular"},
+	}
+	err := e.print(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "id  name\n1   Free\n22  Regular\n"
+	if out.String() != expected {
+		t.Errorf("Unexpected output, got %q, expected %q", out.String(), expected)
+	}
+
+	e, out = testEnv("table")
+	e.columns = []string{"name"}
+	err = e.print(map[string]interface{}{"data": list}, "id", "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = "name\nFree\nRegular\n"
+	if out.String() != expected {
+		t.Errorf("Unexpected output, got %q, expected %q", out.String(), expected)
+	}
+}
+
+func TestComplete(t *testing.T) {
+	tests := []struct {
+		words    []string
+		expected []string
+	}{
+		{[]string{"eventstream"}, []string{"tail"}},
+		{[]string{"-format", "table", "jobs"}, []string{"get", "wait"}},
+		{[]string{"-format"}, []string{"json", "table"}},
+		{[]string{"unknown"}, nil},
+	}
+	for _, test := range tests {
+		result := complete(test.words)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Unexpected completion for %v, got %#v, expected %#v", test.words, result, test.expected)
+		}
+	}
+
+	result := complete(nil)
+	for _, name := range result {
+		if name == "__complete" {
+			t.Errorf("Hidden command in completion")
+		}
+	}
+	if len(result) != len(root.Subs)-1 {
+		t.Errorf("Unexpected completion count, got %d, expected %d", len(result), len(root.Subs)-1)
+	}
+}
+
+func TestOrdersGet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/test/orders/12" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"orderid": 12, "code": "ABC", "status": 21002}`)
+	}))
+	defer srv.Close()
+
+	t.Setenv("TM_PROFILE", "")
+	t.Setenv("TM_ACCOUNTCODE", "test")
+	t.Setenv("TM_ACCESSKEY", "key")
+	t.Setenv("TM_SECRETKEY", "secret")
+	t.Setenv("TM_SERVER", srv.URL)
+
+	e, out := testEnv("table")
+	e.columns = []string{"orderid", "code"}
+	err := dispatch(e, []string{"tm"}, root, []string{"orders", "get", "12"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "orderid  12\ncode     ABC\n"
+	if out.String() != expected {
+		t.Errorf("Unexpected output, got %q, expected %q", out.String(), expected)
+	}
+
+	err = dispatch(e, []string{"tm"}, root, []string{"orders", "get"})
+	if err != errUsage {
+		t.Errorf("Unexpected error, got %#v, expected %#v", err, errUsage)
+	}
+}
+
+func TestJobsWait(t *testing.T) {
+	var polls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 3 {
+			fmt.Fprint(w, `{"id": "5", "progress": 10, "progresstext": "Busy", "status": 1}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "5", "progress": 100, "progresstext": "Done", "status": 7}`)
+	}))
+	defer srv.Close()
+
+	t.Setenv("TM_PROFILE", "")
+	t.Setenv("TM_ACCOUNTCODE", "test")
+	t.Setenv("TM_ACCESSKEY", "key")
+	t.Setenv("TM_SECRETKEY", "secret")
+	t.Setenv("TM_SERVER", srv.URL)
+
+	e, out := testEnv("json")
+	err := dispatch(e, []string{"tm"}, root, []string{"jobs", "wait", "-interval", "1ms", "5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if polls != 3 {
+		t.Errorf("Unexpected number of polls, got %d", polls)
+	}
+	if !strings.Contains(out.String(), `"status": 7`) {
+		t.Errorf("Unexpected output, got %s", out.String())
+	}
+
+	polls = 0
+	err = dispatch(e, []string{"tm"}, root, []string{"jobs", "wait", "-interval", "1ms", "-timeout", "0", "5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	polls = -1000
+	err = dispatch(e, []string{"tm"}, root, []string{"jobs", "wait", "-interval", "1ms", "-timeout", "5ms", "5"})
+	if err == nil || !strings.HasPrefix(err.Error(), "Timeout waiting for job 5 (10%, status 1)") {
+		t.Errorf("Unexpected error, got %#v", err)
+	}
+}
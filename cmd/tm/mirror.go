@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/ticketmatic/tm-go/ticketmatic/mirror"
+)
+
+var mirrorCommand = &command{
+	Name: "mirror",
+	Args: "[-resources a,b] [-page-size n] dir",
+	Help: "Copy the changes to orders, contacts and events since the last run to JSONL files",
+	Run:  mirrorSync,
+}
+
+func mirrorSync(e *env, args []string) error {
+	fs := flag.NewFlagSet("mirror", flag.ContinueOnError)
+	resources := fs.String("resources", "", "Comma-separated list of resources (default: all)")
+	pageSize := fs.Int64("page-size", mirror.DefaultPageSize, "Records requested per page")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	sink, err := mirror.NewJSONLSink(rest[0])
+	if err != nil {
+		return err
+	}
+	m := &mirror.Mirror{
+		Client:    c,
+		Sink:      sink,
+		Resources: splitList(*resources),
+		PageSize:  *pageSize,
+		Log:       e.err,
+	}
+	return m.Sync()
+}
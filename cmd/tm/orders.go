@@ -0,0 +1,200 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/orders"
+	"github.com/ticketmatic/tm-go/ticketmatic/tools"
+)
+
+var orderColumns = []string{"orderid", "code", "status", "paymentstatus", "customerid", "totalamount", "amountpaid", "createdts"}
+
+var ordersCommand = &command{
+	Name: "orders",
+	Help: "Look up and manage orders",
+	Subs: []*command{
+		{
+			Name: "list",
+			Args: "[-filter query] [-limit n] [-offset n] [-orderby field] [-all]",
+			Help: "List orders",
+			Run:  ordersList,
+		},
+		{
+			Name: "get",
+			Args: "id",
+			Help: "Get a single order",
+			Run:  ordersGet,
+		},
+		{
+			Name: "logs",
+			Args: "id",
+			Help: "Get the log items of an order",
+			Run:  ordersLogs,
+		},
+		{
+			Name: "resend",
+			Args: "[-template id] id",
+			Help: "Send the delivery email of an order again",
+			Run:  ordersResend,
+		},
+		{
+			Name: "purge",
+			Args: "[-contacts] [-events] [-createdsince ts] -yes",
+			Help: "Purge all orders of a test or staging account",
+			Run:  ordersPurge,
+		},
+	},
+}
+
+func parseID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid id: %s", s)
+	}
+	return id, nil
+}
+
+func ordersList(e *env, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	q := &ticketmatic.OrderQuery{}
+	fs.StringVar(&q.Filter, "filter", "", "Filter query, such as \"SELECT id FROM tm.order WHERE status = 21002\"")
+	fs.Int64Var(&q.Limit, "limit", 0, "Maximum number of orders")
+	fs.Int64Var(&q.Offset, "offset", 0, "Number of orders to skip")
+	fs.StringVar(&q.Orderby, "orderby", "", "Field to order by")
+	fs.BoolVar(&q.Includearchived, "archived", false, "Include archived orders")
+	all := fs.Bool("all", false, "Fetch all pages (limit is used as page size)")
+	_, err := e.parse(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+
+	if !*all {
+		list, err := orders.Getlist(c, q)
+		if err != nil {
+			return err
+		}
+		return e.print(list.Data, orderColumns...)
+	}
+
+	var result []*ticketmatic.Order
+	it := orders.NewIterator(c, q)
+	for {
+		o, err := it.Next()
+		if err != nil {
+			return err
+		}
+		if o == nil {
+			break
+		}
+		result = append(result, o)
+	}
+	return e.print(result, orderColumns...)
+}
+
+func ordersGet(e *env, args []string) error {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	id, err := parseID(rest[0])
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	o, err := orders.Get(c, id)
+	if err != nil {
+		return err
+	}
+	return e.print(o)
+}
+
+func ordersLogs(e *env, args []string) error {
+	fs := flag.NewFlagSet("logs", flag.ContinueOnError)
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	id, err := parseID(rest[0])
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	logs, err := orders.Getlogs(c, id)
+	if err != nil {
+		return err
+	}
+	return e.print(logs, "id", "ts", "typeid", "username", "info")
+}
+
+func ordersResend(e *env, args []string) error {
+	fs := flag.NewFlagSet("resend", flag.ContinueOnError)
+	template := fs.Int64("template", 0, "Order mail template to use (default: the one of the delivery scenario)")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	id, err := parseID(rest[0])
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	o, err := orders.Postticketsemaildelivery(c, id, &ticketmatic.TicketsEmaildeliveryRequest{
+		Templateid: *template,
+	})
+	if err != nil {
+		return err
+	}
+	return e.print(o, orderColumns...)
+}
+
+func ordersPurge(e *env, args []string) error {
+	fs := flag.NewFlagSet("purge", flag.ContinueOnError)
+	req := &ticketmatic.PurgeOrdersRequest{}
+	fs.BoolVar(&req.Contacts, "contacts", false, "Also purge contacts")
+	fs.BoolVar(&req.Events, "events", false, "Also purge events")
+	fs.StringVar(&req.Createdsince, "createdsince", "", "Only purge orders created since this timestamp")
+	yes := fs.Bool("yes", false, "Confirm the purge")
+	_, err := e.parse(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+
+	account, err := tools.Account(c)
+	if err != nil {
+		return err
+	}
+	if !*yes {
+		return fmt.Errorf("Refusing to purge account %s (%s) without -yes", account.Shortname, account.Name)
+	}
+
+	res, err := orders.Purge(c, req)
+	if err != nil {
+		return err
+	}
+	if e.dryRun {
+		return nil
+	}
+	fmt.Fprintf(e.err, "Purge of %s started\n", account.Shortname)
+	return e.print(res)
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+)
+
+// Print a result in the selected output format. The columns are the default
+// columns for table output, when not overridden with -columns.
+func (e *env) print(v interface{}, columns ...string) error {
+	if e.format == "json" {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(e.out, "%s\n", data)
+		return err
+	}
+
+	if len(e.columns) > 0 {
+		columns = e.columns
+	}
+	return printTable(e, v, columns)
+}
+
+func printTable(e *env, v interface{}, columns []string) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var val interface{}
+	err = json.Unmarshal(data, &val)
+	if err != nil {
+		return err
+	}
+
+	// List results: show the data
+	if obj, ok := val.(map[string]interface{}); ok {
+		if list, ok := obj["data"].([]interface{}); ok {
+			val = list
+		}
+	}
+
+	w := tabwriter.NewWriter(e.out, 0, 4, 2, ' ', 0)
+	switch v := val.(type) {
+	case []interface{}:
+		var rows []map[string]interface{}
+		for _, item := range v {
+			row, ok := item.(map[string]interface{})
+			if !ok {
+				row = map[string]interface{}{"value": item}
+			}
+			rows = append(rows, row)
+		}
+		if len(columns) == 0 && len(rows) > 0 {
+			columns = scalarKeys(rows[0])
+		}
+		for i, col := range columns {
+			if i > 0 {
+				fmt.Fprint(w, "\t")
+			}
+			fmt.Fprint(w, col)
+		}
+		fmt.Fprintln(w)
+		for _, row := range rows {
+			for i, col := range columns {
+				if i > 0 {
+					fmt.Fprint(w, "\t")
+				}
+				fmt.Fprint(w, cell(row[col]))
+			}
+			fmt.Fprintln(w)
+		}
+	case map[string]interface{}:
+		// Single object: one field per line
+		keys := columns
+		if len(keys) == 0 {
+			keys = sortedKeys(v)
+		}
+		for _, k := range keys {
+			fmt.Fprintf(w, "%s\t%s\n", k, cell(v[k]))
+		}
+	default:
+		fmt.Fprintln(w, cell(v))
+	}
+	return w.Flush()
+}
+
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Keys of the non-nested fields, sorted
+func scalarKeys(m map[string]interface{}) []string {
+	var keys []string
+	for _, k := range sortedKeys(m) {
+		switch m[k].(type) {
+		case map[string]interface{}, []interface{}:
+			continue
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
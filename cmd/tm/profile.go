@@ -0,0 +1,166 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Build a client for the named profile, see ticketmatic.Config
+func loadClient(name string) (*ticketmatic.Client, error) {
+	c := &ticketmatic.Config{
+		Profile: name,
+	}
+	return c.NewClient()
+}
+
+func loadProfiles() (*ticketmatic.Profiles, error) {
+	path, err := ticketmatic.ProfilesPath()
+	if err != nil {
+		return nil, err
+	}
+	return ticketmatic.LoadProfiles(path)
+}
+
+func saveProfiles(p *ticketmatic.Profiles) error {
+	path, err := ticketmatic.ProfilesPath()
+	if err != nil {
+		return err
+	}
+	return p.Save(path)
+}
+
+var profilesCommand = &command{
+	Name: "profiles",
+	Help: "Manage credential profiles",
+	Subs: []*command{
+		{
+			Name: "list",
+			Help: "List the profiles",
+			Run:  profilesList,
+		},
+		{
+			Name: "add",
+			Args: "-account code -accesskey key -secretkey key [-language lang] [-server url] [-timezone tz] [-readonly] [-default] name",
+			Help: "Add or replace a profile",
+			Run:  profilesAdd,
+		},
+		{
+			Name: "remove",
+			Args: "name",
+			Help: "Remove a profile",
+			Run:  profilesRemove,
+		},
+		{
+			Name: "default",
+			Args: "name",
+			Help: "Set the default profile",
+			Run:  profilesDefault,
+		},
+	},
+}
+
+func profilesList(e *env, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	_, err := e.parse(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	p, err := loadProfiles()
+	if err != nil {
+		return err
+	}
+
+	names := p.Names()
+	type item struct {
+		Name        string `json:"name"`
+		Accountcode string `json:"accountcode"`
+		Language    string `json:"language,omitempty"`
+		Server      string `json:"server,omitempty"`
+		Timezone    string `json:"timezone,omitempty"`
+		Readonly    bool   `json:"readonly"`
+		Default     bool   `json:"default"`
+	}
+	list := make([]*item, 0, len(names))
+	for _, name := range names {
+		prof := p.Profiles[name]
+		list = append(list, &item{
+			Name:        name,
+			Accountcode: prof.Accountcode,
+			Language:    prof.Language,
+			Server:      prof.Server,
+			Timezone:    prof.Timezone,
+			Readonly:    prof.Readonly,
+			Default:     name == p.Default,
+		})
+	}
+	return e.print(list, "name", "accountcode", "language", "server", "timezone", "readonly", "default")
+}
+
+func profilesAdd(e *env, args []string) error {
+	fs := flag.NewFlagSet("add", flag.ContinueOnError)
+	prof := &ticketmatic.Profile{}
+	fs.StringVar(&prof.Accountcode, "account", "", "Account code")
+	fs.StringVar(&prof.Accesskey, "accesskey", "", "API access key")
+	fs.StringVar(&prof.Secretkey, "secretkey", "", "API secret key")
+	fs.StringVar(&prof.Language, "language", "", "Default language")
+	fs.StringVar(&prof.Server, "server", "", "API server (default: production)")
+	fs.StringVar(&prof.Timezone, "timezone", "", "Timezone of the account, such as Europe/Brussels (default: local)")
+	fs.BoolVar(&prof.Readonly, "readonly", false, "Refuse commands that change the account")
+	def := fs.Bool("default", false, "Make this the default profile")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if prof.Accountcode == "" || prof.Accesskey == "" || prof.Secretkey == "" {
+		return errUsage
+	}
+
+	p, err := loadProfiles()
+	if err != nil {
+		return err
+	}
+	p.Profiles[rest[0]] = prof
+	if *def || p.Default == "" {
+		p.Default = rest[0]
+	}
+	return saveProfiles(p)
+}
+
+func profilesRemove(e *env, args []string) error {
+	fs := flag.NewFlagSet("remove", flag.ContinueOnError)
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	p, err := loadProfiles()
+	if err != nil {
+		return err
+	}
+	if p.Profiles[rest[0]] == nil {
+		return fmt.Errorf("Unknown profile: %s", rest[0])
+	}
+	delete(p.Profiles, rest[0])
+	if p.Default == rest[0] {
+		p.Default = ""
+	}
+	return saveProfiles(p)
+}
+
+func profilesDefault(e *env, args []string) error {
+	fs := flag.NewFlagSet("default", flag.ContinueOnError)
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	p, err := loadProfiles()
+	if err != nil {
+		return err
+	}
+	if p.Profiles[rest[0]] == nil {
+		return fmt.Errorf("Unknown profile: %s", rest[0])
+	}
+	p.Default = rest[0]
+	return saveProfiles(p)
+}
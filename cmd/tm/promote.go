@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/promote"
+	"github.com/ticketmatic/tm-go/ticketmatic/snapshot"
+)
+
+var promoteCommand = &command{
+	Name: "promote",
+	Help: "Copy settings to another account",
+	Subs: []*command{
+		{
+			Name: "plan",
+			Args: "[-resources a,b] [-names a,b] target-profile",
+			Help: "Show the changes needed to promote settings to the target profile",
+			Run:  promotePlan,
+		},
+		{
+			Name: "apply",
+			Args: "[-resources a,b] [-names a,b] [-dry-run] target-profile",
+			Help: "Promote settings to the target profile",
+			Run:  promoteApply,
+		},
+	},
+}
+
+// Plan a promotion, shared by plan and apply
+func promoteMakePlan(e *env, fs *flag.FlagSet, args []string) (*promote.Plan, *ticketmatic.Client, error) {
+	resources := fs.String("resources", "", "Comma-separated list of resources (default: all promotable)")
+	names := fs.String("names", "", "Comma-separated list of object names (default: all)")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source, err := e.Client()
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := loadClient(rest[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := &promote.Options{
+		Resources: splitList(*resources),
+	}
+	if *names != "" {
+		selected := make(map[string]bool)
+		for _, n := range splitList(*names) {
+			selected[n] = true
+		}
+		opts.Select = func(resource string, o snapshot.Object) bool {
+			return selected[o.Name()]
+		}
+	}
+	plan, err := promote.MakePlan(source, target, opts)
+	return plan, target, err
+}
+
+func promotePlan(e *env, args []string) error {
+	fs := flag.NewFlagSet("plan", flag.ContinueOnError)
+	plan, _, err := promoteMakePlan(e, fs, args)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(e.out, plan)
+	return nil
+}
+
+func promoteApply(e *env, args []string) error {
+	fs := flag.NewFlagSet("apply", flag.ContinueOnError)
+	dryrun := fs.Bool("dry-run", false, "Only show what would be done")
+	plan, target, err := promoteMakePlan(e, fs, args)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(e.out, plan)
+	if plan.Empty() {
+		return nil
+	}
+	return promote.Apply(target, plan, &snapshot.ApplyOptions{
+		DryRun: *dryrun,
+		Log:    e.out,
+	})
+}
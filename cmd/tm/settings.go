@@ -0,0 +1,163 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/accountparameters"
+)
+
+// A settings resource that can be listed and fetched
+type settingsResource struct {
+	list func(c *ticketmatic.Client) (interface{}, error)
+	get  func(c *ticketmatic.Client, key string) (interface{}, error)
+
+	// Table columns when listing, defaults to id and name
+	columns []string
+}
+
+// Resources from the settings registry, plus account parameters which are
+// looked up by key
+var settingsResources = func() map[string]*settingsResource {
+	result := map[string]*settingsResource{
+		"accountparameters": {
+			list: func(c *ticketmatic.Client) (interface{}, error) {
+				return accountparameters.Getlist(c)
+			},
+			get: func(c *ticketmatic.Client, key string) (interface{}, error) {
+				return accountparameters.Get(c, key)
+			},
+			columns: []string{"key", "value"},
+		},
+	}
+	for _, r := range settings.All {
+		result[r.Name()] = &settingsResource{
+			list: func(c *ticketmatic.Client) (interface{}, error) {
+				data, err := r.ListObjects(c, false)
+				if err != nil {
+					return nil, err
+				}
+				return settingsList{data, len(data)}, nil
+			},
+			get: func(c *ticketmatic.Client, key string) (interface{}, error) {
+				id, err := strconv.ParseInt(key, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("Invalid id: %s", key)
+				}
+				return r.GetObject(c, id)
+			},
+		}
+	}
+	return result
+}()
+
+// Same form as the list results of the settings packages
+type settingsList struct {
+	Data         []interface{} `json:"data"`
+	NbrOfResults int           `json:"nbrofresults"`
+}
+
+func settingsNames() []string {
+	names := make([]string, 0, len(settingsResources))
+	for name := range settingsResources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// One subcommand per settings resource: "tm settings pricetypes" lists the
+// price types, "tm settings pricetypes 12" gets a single one.
+func newSettingsCommand() *command {
+	cmd := &command{
+		Name: "settings",
+		Help: "Look up settings",
+	}
+	for _, name := range settingsNames() {
+		res := settingsResources[name]
+		cmd.Subs = append(cmd.Subs, &command{
+			Name: name,
+			Args: "[id]",
+			Help: "List " + name + " or get a single one",
+			Run: func(e *env, args []string) error {
+				fs := flag.NewFlagSet(name, flag.ContinueOnError)
+				rest, err := e.parse(fs, args, 0, 1)
+				if err != nil {
+					return err
+				}
+				c, err := e.Client()
+				if err != nil {
+					return err
+				}
+
+				var result interface{}
+				if len(rest) == 0 {
+					result, err = res.list(c)
+				} else {
+					result, err = res.get(c, rest[0])
+				}
+				if err != nil {
+					return err
+				}
+				if len(rest) == 0 {
+					columns := res.columns
+					if columns == nil {
+						columns = []string{"id", "name"}
+					}
+					return e.print(result, columns...)
+				}
+				return e.print(result)
+			},
+		})
+	}
+	cmd.Subs = append(cmd.Subs, &command{
+		Name: "search",
+		Args: "[-archived] [-resources a,b] text",
+		Help: "Find settings by name",
+		Run:  settingsSearch,
+	})
+	return cmd
+}
+
+func settingsSearch(e *env, args []string) error {
+	fs := flag.NewFlagSet("search", flag.ContinueOnError)
+	archived := fs.Bool("archived", false, "Include archived objects")
+	names := fs.String("resources", "", "Comma-separated list of resources (default: all)")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	var resources []settings.Any
+	for _, name := range splitList(*names) {
+		r := settings.Lookup(name)
+		if r == nil {
+			return fmt.Errorf("Unknown resource: %s", name)
+		}
+		resources = append(resources, r)
+	}
+
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	matches, err := settings.Search(c, rest[0], *archived, resources...)
+	if err != nil {
+		return err
+	}
+
+	type row struct {
+		Resource string `json:"resource"`
+		Id       int64  `json:"id"`
+		Name     string `json:"name"`
+	}
+	rows := make([]row, 0, len(matches))
+	for _, m := range matches {
+		rows = append(rows, row{m.Resource.Name(), m.Id, m.Name})
+	}
+	return e.print(rows, "resource", "id", "name")
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/ticketmatic/tm-go/ticketmatic/snapshot"
+)
+
+var snapshotCommand = &command{
+	Name: "snapshot",
+	Help: "Manage settings as code",
+	Subs: []*command{
+		{
+			Name: "save",
+			Args: "[-resources a,b] dir",
+			Help: "Save the settings of the account to a directory",
+			Run:  snapshotSave,
+		},
+		{
+			Name: "plan",
+			Args: "[-prune] [-resources a,b] dir",
+			Help: "Show the changes needed to make the account match a directory",
+			Run:  snapshotPlan,
+		},
+		{
+			Name: "apply",
+			Args: "[-prune] [-resources a,b] [-dry-run] dir",
+			Help: "Apply a directory to the account",
+			Run:  snapshotApply,
+		},
+	},
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+func snapshotSave(e *env, args []string) error {
+	fs := flag.NewFlagSet("save", flag.ContinueOnError)
+	resources := fs.String("resources", "", "Comma-separated list of resources (default: all)")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	return snapshot.Save(c, rest[0], splitList(*resources)...)
+}
+
+// Load a snapshot and plan it, shared by plan and apply
+func snapshotMakePlan(e *env, fs *flag.FlagSet, args []string) (*snapshot.Plan, error) {
+	opts := &snapshot.PlanOptions{}
+	fs.BoolVar(&opts.Prune, "prune", false, "Delete objects that aren't in the snapshot")
+	resources := fs.String("resources", "", "Comma-separated list of resources (default: all in the snapshot)")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	opts.Resources = splitList(*resources)
+
+	c, err := e.Client()
+	if err != nil {
+		return nil, err
+	}
+	snap, err := snapshot.Load(rest[0])
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.MakePlan(c, snap, opts)
+}
+
+func snapshotPlan(e *env, args []string) error {
+	fs := flag.NewFlagSet("plan", flag.ContinueOnError)
+	plan, err := snapshotMakePlan(e, fs, args)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(e.out, plan)
+	return nil
+}
+
+func snapshotApply(e *env, args []string) error {
+	fs := flag.NewFlagSet("apply", flag.ContinueOnError)
+	dryrun := fs.Bool("dry-run", false, "Only show what would be done")
+	plan, err := snapshotMakePlan(e, fs, args)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(e.out, plan)
+	if plan.Empty() {
+		return nil
+	}
+
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	return snapshot.Apply(c, plan, &snapshot.ApplyOptions{
+		DryRun: *dryrun,
+		Log:    e.out,
+	})
+}
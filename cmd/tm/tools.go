@@ -0,0 +1,137 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/export"
+	"github.com/ticketmatic/tm-go/ticketmatic/tools"
+)
+
+var toolsCommand = &command{
+	Name: "tools",
+	Help: "Queries, exports and account info",
+	Subs: []*command{
+		{
+			Name: "query",
+			Args: "[-limit n] [-offset n] query",
+			Help: "Run a query on the public data model",
+			Run:  toolsQuery,
+		},
+		{
+			Name: "export",
+			Args: "[-o file] [-format csv|jsonl|xlsx] [-locale en] [-sheet name] query",
+			Help: "Export the results of a query to CSV, JSON Lines or XLSX",
+			Run:  toolsExport,
+		},
+		{
+			Name: "account",
+			Help: "Get info on the account",
+			Run:  toolsAccount,
+		},
+	},
+}
+
+// Query from the arguments, "-" reads it from stdin
+func readQuery(arg string) (string, error) {
+	if arg != "-" {
+		return arg, nil
+	}
+	data, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func toolsQuery(e *env, args []string) error {
+	fs := flag.NewFlagSet("query", flag.ContinueOnError)
+	req := &ticketmatic.QueryRequest{}
+	fs.Int64Var(&req.Limit, "limit", 100, "Maximum number of rows")
+	fs.Int64Var(&req.Offset, "offset", 0, "Number of rows to skip")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	req.Query, err = readQuery(rest[0])
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	res, err := tools.Queries(c, req)
+	if err != nil {
+		return err
+	}
+	if e.format == "table" {
+		return e.print(res.Results)
+	}
+	return e.print(res)
+}
+
+func toolsExport(e *env, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	output := fs.String("o", "", "Output file (default: stdout)")
+	format := fs.String("format", "", "Output format: csv, jsonl or xlsx (default: based on the output file name, csv otherwise)")
+	locale := fs.String("locale", "en", "Number and date formatting for CSV: en, nl, fr or de")
+	sheet := fs.String("sheet", "", "Worksheet name for XLSX")
+	rest, err := e.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	query, err := readQuery(rest[0])
+	if err != nil {
+		return err
+	}
+
+	opts := &export.Options{
+		Columns: e.columns,
+		Locale:  export.LookupLocale(*locale),
+		Sheet:   *sheet,
+	}
+	if opts.Locale == nil {
+		return fmt.Errorf("Unknown locale: %s", *locale)
+	}
+
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+
+	stream, err := tools.Export(c, &ticketmatic.QueryRequest{
+		Query: query,
+	})
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	n, err := export.CopyFile(*output, *format, e.out, stream, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(e.err, "Exported %d rows\n", n)
+	return nil
+}
+
+func toolsAccount(e *env, args []string) error {
+	fs := flag.NewFlagSet("account", flag.ContinueOnError)
+	_, err := e.parse(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	c, err := e.Client()
+	if err != nil {
+		return err
+	}
+	account, err := tools.Account(c)
+	if err != nil {
+		return err
+	}
+	return e.print(account)
+}
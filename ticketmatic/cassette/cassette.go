@@ -0,0 +1,359 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Replacement for secrets in the cassette
+const Scrubbed = "SCRUBBED"
+
+// Whether to record or replay
+type Mode int
+
+const (
+	// Replay if the cassette exists, record otherwise
+	ModeAuto Mode = iota
+
+	// Always send requests and record them, replacing the cassette
+	ModeRecord
+
+	// Never send requests, fail when a request wasn't recorded
+	ModeReplay
+)
+
+// The mode set in $TM_CASSETTE ("record", "replay" or "auto"), ModeAuto
+// when unset.
+func ModeFromEnv() (Mode, error) {
+	switch s := os.Getenv("TM_CASSETTE"); s {
+	case "", "auto":
+		return ModeAuto, nil
+	case "record":
+		return ModeRecord, nil
+	case "replay":
+		return ModeReplay, nil
+	default:
+		return ModeAuto, fmt.Errorf("Invalid TM_CASSETTE: %s", s)
+	}
+}
+
+// A recorded request
+type Request struct {
+	Method string `json:"method"`
+
+	// URL template and its parameters (without the account name)
+	Route  string            `json:"route"`
+	Params map[string]string `json:"params,omitempty"`
+
+	Query url.Values `json:"query,omitempty"`
+	Body  string     `json:"body,omitempty"`
+}
+
+// A recorded response
+type Response struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body"`
+}
+
+// A request and its response
+type Interaction struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+// Contents of a cassette file
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Records or replays requests
+type Recorder struct {
+	// Cassette file
+	Path string
+
+	Mode Mode
+
+	// Transport for recording, defaults to the transport of the first
+	// attached client or http.DefaultTransport
+	Transport http.RoundTripper
+
+	// Values to scrub from recorded requests and responses, in addition to
+	// the keys of the client making the request
+	Secrets []string
+
+	// Compares a request to a recorded one, defaults to Match
+	Match func(req, recorded *Request) bool
+
+	mutex    sync.Mutex
+	cassette *Cassette
+	used     []bool
+
+	// Mode after checking the cassette: record or replay
+	recording bool
+}
+
+// Open a cassette. In ModeAuto, it records when the cassette doesn't exist.
+func New(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		Path:     path,
+		Mode:     mode,
+		cassette: &Cassette{},
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) && mode != ModeReplay {
+		r.recording = true
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if mode == ModeRecord {
+		r.recording = true
+		return r, nil
+	}
+
+	err = json.Unmarshal(data, r.cassette)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid cassette %s: %s", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Whether requests are recorded, rather than replayed
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// Send the requests of a client through the recorder. The keys of the client
+// (as returned by Client.Keys) are looked up for every request and scrubbed
+// from the cassette, so keys rotated later on are scrubbed as well.
+//
+// The transport of the client's HTTPClient is used for recording, unless
+// Transport is set.
+func (r *Recorder) Attach(c *ticketmatic.Client) {
+	hc := &http.Client{}
+	if c.HTTPClient != nil {
+		*hc = *c.HTTPClient
+	}
+
+	r.mutex.Lock()
+	if r.Transport == nil && hc.Transport != nil && hc.Transport != http.RoundTripper(r) {
+		r.Transport = hc.Transport
+	}
+	r.mutex.Unlock()
+
+	hc.Transport = r
+	c.HTTPClient = hc
+}
+
+// Save the cassette when recording
+func (r *Recorder) Stop() error {
+	if !r.recording {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(r.Path), 0755)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.Path, append(data, '\n'), 0644)
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := r.request(req)
+	if err != nil {
+		return nil, err
+	}
+	scrub := r.scrubber(req)
+	if r.recording {
+		return r.record(req, recorded, scrub)
+	}
+	return r.replay(req, recorded, scrub)
+}
+
+// Describe a request, reading its body
+func (r *Recorder) request(req *http.Request) (*Request, error) {
+	route, params := ticketmatic.RequestRoute(req)
+	if route == "" {
+		route = req.URL.Path
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	query := req.URL.Query()
+	if len(query) == 0 {
+		query = nil
+	}
+	if len(params) == 0 {
+		params = nil
+	}
+	return &Request{
+		Method: req.Method,
+		Route:  route,
+		Params: params,
+		Query:  query,
+		Body:   string(body),
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request, recorded *Request, scrub *strings.Replacer) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+	for _, values := range header {
+		for i, v := range values {
+			values[i] = scrub.Replace(v)
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	scrubRequest(recorded, scrub)
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: &Response{
+			Status: resp.StatusCode,
+			Header: header,
+			Body:   scrub.Replace(string(body)),
+		},
+	})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded *Request, scrub *strings.Replacer) (*http.Response, error) {
+	match := r.Match
+	if match == nil {
+		match = Match
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	scrubRequest(recorded, scrub)
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !match(recorded, in.Request) {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.Status, http.StatusText(in.Response.Status)),
+			StatusCode:    in.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(strings.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("No recorded interaction for %s %s in %s", recorded.Method, recorded.Route, r.Path)
+}
+
+// Whether a request matches a recorded one: same method, route, parameters
+// and query, and the same body (compared as JSON when possible)
+func Match(req, recorded *Request) bool {
+	if req.Method != recorded.Method || req.Route != recorded.Route {
+		return false
+	}
+	if len(req.Params) != 0 || len(recorded.Params) != 0 {
+		if !reflect.DeepEqual(req.Params, recorded.Params) {
+			return false
+		}
+	}
+	if len(req.Query) != 0 || len(recorded.Query) != 0 {
+		if !reflect.DeepEqual(req.Query, recorded.Query) {
+			return false
+		}
+	}
+	return sameBody(req.Body, recorded.Body)
+}
+
+func sameBody(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// Replaces the secrets for a request: Secrets and the current keys of the
+// client making the request.
+func (r *Recorder) scrubber(req *http.Request) *strings.Replacer {
+	r.mutex.Lock()
+	secrets := append([]string(nil), r.Secrets...)
+	r.mutex.Unlock()
+
+	if c := ticketmatic.RequestClient(req); c != nil {
+		keys, err := c.Keys()
+		if err != nil {
+			keys = &ticketmatic.Keys{AccessKey: c.AccessKey, SecretKey: c.SecretKey}
+		}
+		secrets = append(secrets, keys.AccessKey, keys.SecretKey)
+	}
+
+	var pairs []string
+	for _, secret := range secrets {
+		if secret != "" {
+			pairs = append(pairs, secret, Scrubbed)
+		}
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+func scrubRequest(req *Request, scrub *strings.Replacer) {
+	for k, v := range req.Params {
+		req.Params[k] = scrub.Replace(v)
+	}
+	for _, values := range req.Query {
+		for i, v := range values {
+			values[i] = scrub.Replace(v)
+		}
+	}
+	req.Body = scrub.Replace(req.Body)
+}
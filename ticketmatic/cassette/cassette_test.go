@@ -0,0 +1,245 @@
+package cassette
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+type item struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Makes the requests under test: a regular and a streamed one
+func run(c *ticketmatic.Client) ([]*item, error) {
+	var result []*item
+
+	r := c.NewRequest("POST", "/{accountname}/items/{id}", "json")
+	r.UrlParameters(map[string]interface{}{"id": 12})
+	r.AddParameter("filter", "all")
+	r.Body(&item{Name: "Concert"}, "json")
+	var obj *item
+	err := r.Run(&obj)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, obj)
+
+	r = c.NewRequest("GET", "/{accountname}/items/export", "json")
+	stream, err := r.Stream()
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	for {
+		var obj *item
+		err := stream.Next(&obj)
+		if err != nil {
+			break
+		}
+		result = append(result, obj)
+	}
+	return result, nil
+}
+
+func TestRecorder(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/api/1/recorded/items/12":
+			data, _ := ioutil.ReadAll(r.Body)
+			var obj item
+			json.Unmarshal(data, &obj)
+			obj.Id = 12
+			obj.Name += " by key"
+			json.NewEncoder(w).Encode(&obj)
+		case "/api/1/recorded/items/export":
+			fmt.Fprintln(w, `{"id":1,"name":"a"}`)
+			fmt.Fprintln(w, `{"id":2,"name":"b"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := New(path, ModeAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rec.Recording() {
+		t.Fatal("Expected to record")
+	}
+	c := ticketmatic.NewClient("recorded", "key", "secret")
+	c.Server = srv.URL
+	rec.Attach(c)
+
+	recorded, err := run(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rec.Stop()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "TM-HMAC") || strings.Contains(string(data), "key") {
+		t.Errorf("Expected cassette to be scrubbed, got %s", data)
+	}
+
+	// Replay with another account, without a server
+	srv.Close()
+	rec, err = New(path, ModeAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Recording() {
+		t.Fatal("Expected to replay")
+	}
+	c = ticketmatic.NewClient("replayed", "otherkey", "othersecret")
+	c.Server = srv.URL
+	rec.Attach(c)
+
+	replayed, err := run(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("Unexpected number of requests, got %d, expected 2", requests)
+	}
+	if len(replayed) != 3 || len(recorded) != 3 {
+		t.Fatalf("Unexpected items, got %d and %d, expected 3", len(recorded), len(replayed))
+	}
+	if replayed[0].Name != "Concert by SCRUBBED" || replayed[2].Name != "b" {
+		t.Errorf("Unexpected items, got %#v and %#v", replayed[0], replayed[2])
+	}
+
+	// Each interaction is replayed once
+	_, err = run(c)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	a := &Request{Method: "PUT", Route: "/{accountname}/items/{id}", Params: map[string]string{"id": "1"}, Body: `{"a":1,"b":2}`}
+	b := &Request{Method: "PUT", Route: "/{accountname}/items/{id}", Params: map[string]string{"id": "1"}, Body: `{"b":2, "a":1}`}
+	if !Match(a, b) {
+		t.Error("Expected bodies to match")
+	}
+	b.Params["id"] = "2"
+	if Match(a, b) {
+		t.Error("Unexpected match of other parameters")
+	}
+}
+
+// Counts the requests sent through a transport
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestAttach(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "name": "Signed by providerkey"}`)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := &countingTransport{}
+	c := ticketmatic.NewClient("recorded", "", "")
+	c.Server = srv.URL
+	c.Secrets = ticketmatic.SecretFunc(func(accountcode string) (*ticketmatic.Keys, error) {
+		return &ticketmatic.Keys{AccessKey: "providerkey", SecretKey: "providersecret"}, nil
+	})
+	c.HTTPClient = &http.Client{Transport: transport, Timeout: time.Minute}
+	rec.Attach(c)
+
+	if c.HTTPClient.Timeout != time.Minute {
+		t.Errorf("Unexpected timeout, got %s", c.HTTPClient.Timeout)
+	}
+	var obj *item
+	err = c.NewRequest("GET", "/{accountname}/items/1", "json").Run(&obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transport.requests != 1 {
+		t.Errorf("Unexpected number of requests, got %d, expected 1", transport.requests)
+	}
+	err = rec.Stop()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "providerkey") || !strings.Contains(string(data), "Signed by SCRUBBED") {
+		t.Errorf("Expected cassette to be scrubbed, got %s", data)
+	}
+}
+
+func TestScrubRotatedKeys(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.Fields(r.Header.Get("Authorization"))[1]
+		w.Header().Set("X-Signed-By", key)
+		fmt.Fprintf(w, `{"id": 1, "name": "Signed by %s"}`, key)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := ticketmatic.NewClient("recorded", "oldkey", "oldsecret")
+	c.Server = srv.URL
+	rec.Attach(c)
+
+	for _, key := range []string{"oldkey", "newkey"} {
+		c.SetKeys(key, key+"secret")
+		var obj *item
+		err = c.NewRequest("GET", "/{accountname}/items/1", "json").Run(&obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = rec.Stop()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "oldkey") || strings.Contains(string(data), "newkey") {
+		t.Errorf("Expected cassette to be scrubbed, got %s", data)
+	}
+	if !strings.Contains(string(data), `"key=SCRUBBED"`) {
+		t.Errorf("Expected scrubbed headers, got %s", data)
+	}
+}
@@ -0,0 +1,22 @@
+// Record and replay API requests, for fast and deterministic tests.
+//
+// A Recorder is an http.RoundTripper that records the requests of a client
+// to a cassette file, and replays them later without network access:
+//
+//	mode, err := cassette.ModeFromEnv()
+//	rec, err := cassette.New("testdata/orders.json", mode)
+//	rec.Attach(client)
+//	defer rec.Stop()
+//
+//	order, err := orders.Get(client, 1)
+//
+// In ModeAuto, the first run records (against a real account) and later runs
+// replay. With ModeFromEnv, TM_CASSETTE=record records again.
+//
+// Requests match on method, route template (so the account code doesn't
+// matter), URL parameters, query and body. Each recorded interaction is
+// replayed once, in order.
+//
+// The Authorization header is never recorded, the keys of attached clients
+// and any other Secrets are replaced by "SCRUBBED" in the cassette.
+package cassette
@@ -2,6 +2,7 @@ package ticketmatic
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/json"
@@ -15,6 +16,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
@@ -66,6 +68,36 @@ type Client struct {
 	AccessKey   string
 	SecretKey   string
 	Language    string
+
+	// API server, defaults to the package-level Server
+	Server string
+
+	// Provides the API keys for each request, instead of AccessKey and
+	// SecretKey (see SecretProvider)
+	Secrets SecretProvider
+
+	// Validate request bodies before sending them, see Validator
+	ValidateRequests bool
+
+	// Timezone of the account. Timestamps without offset in results are
+	// interpreted in it, timestamps in requests are written in it (request
+	// bodies themselves are left unchanged). When nil, time.Local is used, as
+	// before.
+	Location *time.Location
+
+	// HTTP client used for requests, defaults to http.DefaultClient. Set
+	// its Transport to record or replay requests (see the cassette package).
+	HTTPClient *http.Client
+
+	// Refuses or simulates requests that change the account, nil sends all
+	// requests (see Guard)
+	Guard *Guard
+
+	// Keys set with SetKeys
+	keys atomic.Pointer[Keys]
+
+	// Context of the requests, set with WithContext
+	ctx context.Context
 }
 
 // API Request
@@ -91,6 +123,41 @@ func NewClient(accountcode, accesskey, secretkey string) *Client {
 	return client
 }
 
+// A copy of the client, with the same settings, keys and context
+func (c *Client) Clone() *Client {
+	client := &Client{
+		AccountCode:      c.AccountCode,
+		AccessKey:        c.AccessKey,
+		SecretKey:        c.SecretKey,
+		Language:         c.Language,
+		Server:           c.Server,
+		Secrets:          c.Secrets,
+		ValidateRequests: c.ValidateRequests,
+		Location:         c.Location,
+		HTTPClient:       c.HTTPClient,
+		Guard:            c.Guard,
+		ctx:              c.ctx,
+	}
+	client.keys.Store(c.keys.Load())
+	return client
+}
+
+// A copy of the client whose requests use ctx: they are canceled when ctx is
+// done.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	client := c.Clone()
+	client.ctx = ctx
+	return client
+}
+
+// Context of the requests of the client
+func (c *Client) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 func (c *Client) NewRequest(method, url, resultContentType string) *Request {
 	if resultContentType == "" {
 		resultContentType = "json"
@@ -125,6 +192,9 @@ func (r *Request) Body(body interface{}, bodyContentType string) {
 
 func (r *Request) Run(obj interface{}) error {
 	resp, err := r.prepareRequest()
+	if err == errDryRun {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -141,6 +211,7 @@ func (r *Request) Run(obj interface{}) error {
 			if err != nil {
 				return fmt.Errorf("Deserialization failed: %s in %s", err, string(data))
 			}
+			SetLocation(obj, r.client.Location)
 		} else {
 			buff, ok := obj.(*bytes.Buffer)
 			if !ok {
@@ -155,19 +226,31 @@ func (r *Request) Run(obj interface{}) error {
 
 func (r *Request) Stream() (*Stream, error) {
 	resp, err := r.prepareRequest()
+	if err == errDryRun {
+		resp, err = dryRunResponse(), nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStream(resp), nil
+	s := NewStream(resp)
+	s.loc = r.client.Location
+	return s, nil
 }
 
 func (r *Request) prepareRequest() (*http.Response, error) {
 	var body io.Reader
 
+	if r.body != nil && r.client.ValidateRequests {
+		err := validateBody(r.method, r.body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if r.body != nil {
 		if r.bodyContentType == "json" {
-			d, err := json.Marshal(r.body)
+			d, err := json.Marshal(withLocation(r.body, r.client.Location))
 			if err != nil {
 				return nil, err
 			}
@@ -186,12 +269,33 @@ func (r *Request) prepareRequest() (*http.Response, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(r.method, u, body)
+	if r.client.Guard != nil {
+		err := r.client.Guard.check(r.client, &Mutation{
+			Method: r.method,
+			Route:  r.url,
+			Params: r.params,
+			URL:    u,
+			Body:   r.body,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Pass the route to the transport, to match requests regardless of the
+	// account code
+	ctx := context.WithValue(r.client.Context(), routeKey{}, &route{r.url, r.params, r.client})
+
+	req, err := http.NewRequestWithContext(ctx, r.method, u, body)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Authorization", r.authHeader())
+	auth, err := r.authHeader()
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", auth)
 	if r.bodyContentType == "json" {
 		req.Header.Add("Content-Type", "application/json")
 	} else if r.bodyContentType == "svg" {
@@ -203,7 +307,11 @@ func (r *Request) prepareRequest() (*http.Response, error) {
 	}
 	req.Close = true
 
-	resp, err := http.DefaultClient.Do(req)
+	httpClient := r.client.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -244,11 +352,49 @@ func (r *Request) prepareRequest() (*http.Response, error) {
 	}
 }
 
-func (r *Request) authHeader() string {
+type routeKey struct{}
+
+type route struct {
+	url    string
+	params map[string]interface{}
+	client *Client
+}
+
+// The route of a request made by the client: the URL template (such as
+// "/{accountname}/orders/{id}") and its parameters, except for the account
+// name. Empty for other requests.
+func RequestRoute(req *http.Request) (string, map[string]string) {
+	r, ok := req.Context().Value(routeKey{}).(*route)
+	if !ok {
+		return "", nil
+	}
+	params := make(map[string]string, len(r.params))
+	for k, v := range r.params {
+		params[k] = fmt.Sprintf("%v", v)
+	}
+	return r.url, params
+}
+
+// The client that made a request, nil for other requests. Lets a transport
+// look up the current keys of the client, see Client.Keys.
+func RequestClient(req *http.Request) *Client {
+	r, ok := req.Context().Value(routeKey{}).(*route)
+	if !ok {
+		return nil
+	}
+	return r.client
+}
+
+func (r *Request) authHeader() (string, error) {
+	keys, err := r.client.Keys()
+	if err != nil {
+		return "", err
+	}
+
 	ts := time.Now().UTC().Format("2006-01-02T15:04:05")
-	hash := Sign(r.client.AccessKey, r.client.SecretKey, r.client.AccountCode, ts)
+	hash := Sign(keys.AccessKey, keys.SecretKey, r.client.AccountCode, ts)
 
-	return fmt.Sprintf("TM-HMAC-SHA256 key=%s ts=%s sign=%s", r.client.AccessKey, ts, hash)
+	return fmt.Sprintf("TM-HMAC-SHA256 key=%s ts=%s sign=%s", keys.AccessKey, ts, hash), nil
 }
 
 func (r *Request) prepareUrl() (string, error) {
@@ -259,10 +405,17 @@ func (r *Request) prepareUrl() (string, error) {
 	}
 	u = strings.Replace(u, "{accountname}", r.client.AccountCode, 1)
 
-	result := fmt.Sprintf("%s/api/%s%s", Server, Version, u)
+	server := r.client.Server
+	if server == "" {
+		server = Server
+	}
+	result := fmt.Sprintf("%s/api/%s%s", server, Version, u)
 	if len(r.query) > 0 {
 		query := url.Values{}
 		for k, v := range r.query {
+			if t, ok := v.(Time); ok {
+				v = t.In(r.client.Location)
+			}
 			kind := reflect.ValueOf(v).Kind()
 			if kind == reflect.Interface || kind == reflect.Map || kind == reflect.Ptr || kind == reflect.Slice || kind == reflect.Struct {
 				d, err := json.Marshal(v)
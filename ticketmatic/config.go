@@ -0,0 +1,240 @@
+package ticketmatic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Credentials and defaults for an account
+type Profile struct {
+	// Account short name
+	Accountcode string `json:"accountcode"`
+
+	// API keys, can be left empty when a SecretProvider supplies them
+	Accesskey string `json:"accesskey,omitempty"`
+	Secretkey string `json:"secretkey,omitempty"`
+
+	// Default language for translated fields
+	Language string `json:"language,omitempty"`
+
+	// API server, defaults to Server
+	Server string `json:"server,omitempty"`
+
+	// Timezone of the account, such as "Europe/Brussels" (see
+	// Client.Location)
+	Timezone string `json:"timezone,omitempty"`
+
+	// Refuse requests that change the account, for instance for production
+	// accounts (see Guard)
+	Readonly bool `json:"readonly,omitempty"`
+}
+
+// Build a client for the profile. Secrets, if not nil, supplies the keys
+// when the profile has none.
+func (p *Profile) NewClient(secrets SecretProvider) (*Client, error) {
+	if p.Accountcode == "" {
+		return nil, errors.New("No account code in profile")
+	}
+
+	c := NewClient(p.Accountcode, p.Accesskey, p.Secretkey)
+	c.Language = p.Language
+	c.Server = p.Server
+	if p.Timezone != "" {
+		loc, err := time.LoadLocation(p.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid timezone: %s", p.Timezone)
+		}
+		c.Location = loc
+	}
+	if p.Readonly {
+		c.Guard = &Guard{Mode: GuardModeRefuse}
+	}
+	if p.Accesskey == "" || p.Secretkey == "" {
+		if secrets == nil {
+			return nil, fmt.Errorf("No API keys for account %s", p.Accountcode)
+		}
+		c.Secrets = secrets
+	}
+	return c, nil
+}
+
+// A set of named profiles, as stored in the profiles file:
+//
+//	{
+//	  "default": "prod",
+//	  "profiles": {
+//	    "prod": {"accountcode": "mycompany", "accesskey": "...", "secretkey": "..."},
+//	    "qa": {"accountcode": "mycompany-qa", "language": "nl", "server": "https://qa.ticketmatic.com"}
+//	  }
+//	}
+type Profiles struct {
+	// Name of the profile used when none is given
+	Default string `json:"default,omitempty"`
+
+	Profiles map[string]*Profile `json:"profiles"`
+}
+
+// Location of the profiles file: $TM_CONFIG, or ticketmatic/profiles.json in
+// the user configuration directory.
+func ProfilesPath() (string, error) {
+	if p := os.Getenv("TM_CONFIG"); p != "" {
+		return p, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "ticketmatic", "profiles.json"), nil
+}
+
+// Read a profiles file. A missing file gives an empty set of profiles.
+func LoadProfiles(path string) (*Profiles, error) {
+	p := &Profiles{}
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		err = json.Unmarshal(data, p)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid profiles file %s: %s", path, err)
+		}
+	}
+	if p.Profiles == nil {
+		p.Profiles = make(map[string]*Profile)
+	}
+	return p, nil
+}
+
+// Write the profiles file. It is only readable by the current user and is
+// replaced atomically.
+func (p *Profiles) Save(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(path), ".profiles")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(append(data, '\n'))
+	if err == nil {
+		err = f.Chmod(0600)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// Find a profile, the default profile if name is empty
+func (p *Profiles) Get(name string) (*Profile, error) {
+	if name == "" {
+		name = p.Default
+	}
+	if name == "" {
+		return nil, errors.New("No profile selected and no default profile set")
+	}
+	prof, ok := p.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("Unknown profile: %s", name)
+	}
+	return prof, nil
+}
+
+// Profile names, sorted
+func (p *Profiles) Names() []string {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Where to find the client configuration
+type Config struct {
+	// Profile to use. When empty, $TM_PROFILE is used, then the
+	// TM_ACCOUNTCODE environment variables (see ProfileFromEnv) and finally
+	// the default profile.
+	Profile string
+
+	// Profiles file, defaults to ProfilesPath()
+	Path string
+
+	// Supplies the keys for profiles without keys
+	Secrets SecretProvider
+}
+
+// Read a profile from the environment: TM_ACCOUNTCODE, TM_ACCESSKEY,
+// TM_SECRETKEY, TM_LANGUAGE, TM_SERVER and TM_TIMEZONE. Returns nil if
+// TM_ACCOUNTCODE is not set.
+func ProfileFromEnv() *Profile {
+	accountcode := os.Getenv("TM_ACCOUNTCODE")
+	if accountcode == "" {
+		return nil
+	}
+	return &Profile{
+		Accountcode: accountcode,
+		Accesskey:   os.Getenv("TM_ACCESSKEY"),
+		Secretkey:   os.Getenv("TM_SECRETKEY"),
+		Language:    os.Getenv("TM_LANGUAGE"),
+		Server:      os.Getenv("TM_SERVER"),
+		Timezone:    os.Getenv("TM_TIMEZONE"),
+	}
+}
+
+// Build a client from the configuration
+func (c *Config) NewClient() (*Client, error) {
+	name := c.Profile
+	if name == "" {
+		name = os.Getenv("TM_PROFILE")
+	}
+	if name == "" {
+		if prof := ProfileFromEnv(); prof != nil {
+			return prof.NewClient(c.Secrets)
+		}
+	}
+
+	path := c.Path
+	if path == "" {
+		var err error
+		path, err = ProfilesPath()
+		if err != nil {
+			return nil, err
+		}
+	}
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+	prof, err := profiles.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return prof.NewClient(c.Secrets)
+}
+
+// Build a client from the environment: the profile named by TM_PROFILE, the
+// TM_ACCOUNTCODE, TM_ACCESSKEY and TM_SECRETKEY variables, or the default
+// profile, in that order.
+func NewClientFromEnv() (*Client, error) {
+	c := &Config{}
+	return c.NewClient()
+}
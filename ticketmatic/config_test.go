@@ -0,0 +1,217 @@
+package ticketmatic
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestProfiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tm", "profiles.json")
+
+	p, err := LoadProfiles(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Profiles) != 0 {
+		t.Errorf("Unexpected profiles, got %#v", p.Profiles)
+	}
+
+	p.Default = "prod"
+	p.Profiles["prod"] = &Profile{Accountcode: "prod", Accesskey: "a", Secretkey: "s", Language: "nl"}
+	p.Profiles["qa"] = &Profile{Accountcode: "qa", Server: "https://qa.example.com"}
+	err = p.Save(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Errorf("Unexpected permissions, got %v, expected %v", fi.Mode().Perm(), os.FileMode(0600))
+	}
+
+	p, err = LoadProfiles(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := fmt.Sprint(p.Names()); names != "[prod qa]" {
+		t.Errorf("Unexpected names, got %s, expected %s", names, "[prod qa]")
+	}
+
+	t.Setenv("TM_PROFILE", "")
+	t.Setenv("TM_ACCOUNTCODE", "")
+
+	c, err := (&Config{Path: path}).NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.AccountCode != "prod" || c.Language != "nl" {
+		t.Errorf("Unexpected client, got %s/%s", c.AccountCode, c.Language)
+	}
+
+	// No keys and no secret provider
+	_, err = (&Config{Path: path, Profile: "qa"}).NewClient()
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+
+	secrets := SecretFunc(func(accountcode string) (*Keys, error) {
+		return &Keys{AccessKey: "key-" + accountcode, SecretKey: "secret"}, nil
+	})
+	c, err = (&Config{Path: path, Profile: "qa", Secrets: secrets}).NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := c.Keys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys.AccessKey != "key-qa" || c.Server != "https://qa.example.com" {
+		t.Errorf("Unexpected client, got %s/%s", keys.AccessKey, c.Server)
+	}
+
+	_, err = (&Config{Path: path, Profile: "unknown"}).NewClient()
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestConfigEnv(t *testing.T) {
+	t.Setenv("TM_PROFILE", "")
+	t.Setenv("TM_ACCOUNTCODE", "envaccount")
+	t.Setenv("TM_ACCESSKEY", "a")
+	t.Setenv("TM_SECRETKEY", "s")
+	t.Setenv("TM_LANGUAGE", "fr")
+	t.Setenv("TM_SERVER", "http://localhost:1234")
+	t.Setenv("TM_TIMEZONE", "Europe/Brussels")
+
+	c, err := NewClientFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.AccountCode != "envaccount" || c.AccessKey != "a" || c.SecretKey != "s" || c.Language != "fr" || c.Server != "http://localhost:1234" {
+		t.Errorf("Unexpected client, got %#v", c)
+	}
+	if c.Location == nil || c.Location.String() != "Europe/Brussels" {
+		t.Errorf("Unexpected location, got %v", c.Location)
+	}
+
+	t.Setenv("TM_TIMEZONE", "Nowhere/Special")
+	_, err = NewClientFromEnv()
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestCachedSecrets(t *testing.T) {
+	calls := 0
+	secrets := CachedSecrets(SecretFunc(func(accountcode string) (*Keys, error) {
+		calls++
+		if accountcode == "fail" {
+			return nil, errors.New("Failed")
+		}
+		return &Keys{AccessKey: "a", SecretKey: "s"}, nil
+	}), time.Minute)
+
+	for i := 0; i < 3; i++ {
+		_, err := secrets.Keys("test")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Unexpected calls, got %d, expected %d", calls, 1)
+	}
+
+	_, err := secrets.Keys("fail")
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+
+	// A slow lookup doesn't block other accounts
+	started := make(chan struct{})
+	release := make(chan struct{})
+	secrets = CachedSecrets(SecretFunc(func(accountcode string) (*Keys, error) {
+		if accountcode == "slow" {
+			close(started)
+			<-release
+		}
+		return &Keys{AccessKey: accountcode, SecretKey: "s"}, nil
+	}), time.Minute)
+	done := make(chan error)
+	go func() {
+		_, err := secrets.Keys("slow")
+		done <- err
+	}()
+	<-started
+	k, err := secrets.Keys("fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.AccessKey != "fast" {
+		t.Errorf("Unexpected keys, got %#v", k)
+	}
+	close(release)
+	err = <-done
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	pairs := map[string]string{
+		"key1": "secret1",
+		"key2": "secret2",
+	}
+	auth := regexp.MustCompile(`^TM-HMAC-SHA256 key=(\S+) ts=(\S+) sign=(\S+)$`)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m := auth.FindStringSubmatch(r.Header.Get("Authorization"))
+		if m == nil || Sign(m[1], pairs[m[1]], "test", m[2]) != m[3] {
+			w.WriteHeader(401)
+			fmt.Fprint(w, `{"code": 401, "message": "Invalid signature"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"id": 1, "name": "Test", "shortname": "test"}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("test", "key1", "secret1")
+	c.Server = srv.URL
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 40)
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%4 == 0 {
+				if i%8 == 0 {
+					c.SetKeys("key2", "secret2")
+				} else {
+					c.SetKeys("key1", "secret1")
+				}
+			}
+			var info AccountInfo
+			errs <- c.NewRequest("GET", "/{accountname}/tools/account", "json").Run(&info)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,1039 @@
+package ticketmatic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Named types for the status and type codes used throughout the API.
+//
+// The fields in the API types keep their raw int64 type, convert them to get a
+// readable name or to validate them:
+//
+//	if ticketmatic.OrderStatus(order.Status) == ticketmatic.OrderStatusConfirmed {
+//		...
+//	}
+//	fmt.Println(ticketmatic.OrderStatus(order.Status)) // "confirmed"
+//
+// Each enum type marshals to its numeric value in JSON, so it can be used in
+// request and response payloads. When unmarshalling, both the numeric value and
+// the name are accepted. The text encoding (used for map keys, query strings
+// and configuration files) uses the name.
+
+type enumNames map[int64]string
+
+func (n enumNames) name(typ string, v int64) string {
+	if s, ok := n[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("%s(%d)", typ, v)
+}
+
+func (n enumNames) valid(v int64) bool {
+	_, ok := n[v]
+	return ok
+}
+
+func (n enumNames) marshalText(v int64) ([]byte, error) {
+	if s, ok := n[v]; ok {
+		return []byte(s), nil
+	}
+	return []byte(strconv.FormatInt(v, 10)), nil
+}
+
+func (n enumNames) unmarshalText(typ string, data []byte, v *int64) error {
+	s := strings.TrimSpace(string(data))
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*v = i
+		return nil
+	}
+	for k, name := range n {
+		if strings.EqualFold(name, s) {
+			*v = k
+			return nil
+		}
+	}
+	return fmt.Errorf("Unknown %s: %q", typ, s)
+}
+
+func (n enumNames) unmarshalJSON(typ string, data []byte, v *int64) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return err
+		}
+		return n.unmarshalText(typ, []byte(s), v)
+	}
+	return json.Unmarshal(data, v)
+}
+
+func marshalEnum(v int64) ([]byte, error) {
+	return []byte(strconv.FormatInt(v, 10)), nil
+}
+
+// Order status, as used in Order.Status and OrderFilter.Status
+type OrderStatus int64
+
+const (
+	OrderStatusUnconfirmed OrderStatus = 21001
+	OrderStatusConfirmed   OrderStatus = 21002
+	OrderStatusArchived    OrderStatus = 21003
+)
+
+var orderStatusNames = enumNames{
+	21001: "unconfirmed",
+	21002: "confirmed",
+	21003: "archived",
+}
+
+func (e OrderStatus) String() string               { return orderStatusNames.name("OrderStatus", int64(e)) }
+func (e OrderStatus) Valid() bool                  { return orderStatusNames.valid(int64(e)) }
+func (e OrderStatus) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e OrderStatus) MarshalText() ([]byte, error) { return orderStatusNames.marshalText(int64(e)) }
+
+func (e *OrderStatus) UnmarshalJSON(data []byte) error {
+	return orderStatusNames.unmarshalJSON("OrderStatus", data, (*int64)(e))
+}
+
+func (e *OrderStatus) UnmarshalText(data []byte) error {
+	return orderStatusNames.unmarshalText("OrderStatus", data, (*int64)(e))
+}
+
+// Parse an OrderStatus from its name or numeric value
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	var e OrderStatus
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Order payment status, as used in Order.Paymentstatus
+type PaymentStatus int64
+
+const (
+	PaymentStatusIncomplete PaymentStatus = 0
+	PaymentStatusFullyPaid  PaymentStatus = 1
+	PaymentStatusOverpaid   PaymentStatus = 2
+)
+
+var paymentStatusNames = enumNames{
+	0: "incomplete",
+	1: "fullypaid",
+	2: "overpaid",
+}
+
+func (e PaymentStatus) String() string               { return paymentStatusNames.name("PaymentStatus", int64(e)) }
+func (e PaymentStatus) Valid() bool                  { return paymentStatusNames.valid(int64(e)) }
+func (e PaymentStatus) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e PaymentStatus) MarshalText() ([]byte, error) { return paymentStatusNames.marshalText(int64(e)) }
+
+func (e *PaymentStatus) UnmarshalJSON(data []byte) error {
+	return paymentStatusNames.unmarshalJSON("PaymentStatus", data, (*int64)(e))
+}
+
+func (e *PaymentStatus) UnmarshalText(data []byte) error {
+	return paymentStatusNames.unmarshalText("PaymentStatus", data, (*int64)(e))
+}
+
+// Parse a PaymentStatus from its name or numeric value
+func ParsePaymentStatus(s string) (PaymentStatus, error) {
+	var e PaymentStatus
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Order delivery status, as used in Order.Deliverystatus
+type DeliveryStatus int64
+
+const (
+	DeliveryStatusNotDelivered         DeliveryStatus = 2601
+	DeliveryStatusDelivered            DeliveryStatus = 2602
+	DeliveryStatusChangedAfterDelivery DeliveryStatus = 2603
+)
+
+var deliveryStatusNames = enumNames{
+	2601: "notdelivered",
+	2602: "delivered",
+	2603: "changedafterdelivery",
+}
+
+func (e DeliveryStatus) String() string               { return deliveryStatusNames.name("DeliveryStatus", int64(e)) }
+func (e DeliveryStatus) Valid() bool                  { return deliveryStatusNames.valid(int64(e)) }
+func (e DeliveryStatus) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e DeliveryStatus) MarshalText() ([]byte, error) {
+	return deliveryStatusNames.marshalText(int64(e))
+}
+
+func (e *DeliveryStatus) UnmarshalJSON(data []byte) error {
+	return deliveryStatusNames.unmarshalJSON("DeliveryStatus", data, (*int64)(e))
+}
+
+func (e *DeliveryStatus) UnmarshalText(data []byte) error {
+	return deliveryStatusNames.unmarshalText("DeliveryStatus", data, (*int64)(e))
+}
+
+// Parse a DeliveryStatus from its name or numeric value
+func ParseDeliveryStatus(s string) (DeliveryStatus, error) {
+	var e DeliveryStatus
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Access control status of a ticket, as used in EventTicket.Accesscontrolstatus
+// and OrderTicket.Cachedaccesscontrolstatus
+type AccessControlStatus int64
+
+const (
+	AccessControlStatusNotScanned AccessControlStatus = 0
+	AccessControlStatusEntered    AccessControlStatus = 1
+	AccessControlStatusExited     AccessControlStatus = 2
+)
+
+var accessControlStatusNames = enumNames{
+	0: "notscanned",
+	1: "entered",
+	2: "exited",
+}
+
+func (e AccessControlStatus) String() string {
+	return accessControlStatusNames.name("AccessControlStatus", int64(e))
+}
+func (e AccessControlStatus) Valid() bool                  { return accessControlStatusNames.valid(int64(e)) }
+func (e AccessControlStatus) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e AccessControlStatus) MarshalText() ([]byte, error) {
+	return accessControlStatusNames.marshalText(int64(e))
+}
+
+func (e *AccessControlStatus) UnmarshalJSON(data []byte) error {
+	return accessControlStatusNames.unmarshalJSON("AccessControlStatus", data, (*int64)(e))
+}
+
+func (e *AccessControlStatus) UnmarshalText(data []byte) error {
+	return accessControlStatusNames.unmarshalText("AccessControlStatus", data, (*int64)(e))
+}
+
+// Parse an AccessControlStatus from its name or numeric value
+func ParseAccessControlStatus(s string) (AccessControlStatus, error) {
+	var e AccessControlStatus
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Event status, as used in Event.Currentstatus
+type EventStatus int64
+
+const (
+	EventStatusDraft  EventStatus = 19001
+	EventStatusActive EventStatus = 19002
+	EventStatusClosed EventStatus = 19003
+)
+
+var eventStatusNames = enumNames{
+	19001: "draft",
+	19002: "active",
+	19003: "closed",
+}
+
+func (e EventStatus) String() string               { return eventStatusNames.name("EventStatus", int64(e)) }
+func (e EventStatus) Valid() bool                  { return eventStatusNames.valid(int64(e)) }
+func (e EventStatus) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e EventStatus) MarshalText() ([]byte, error) { return eventStatusNames.marshalText(int64(e)) }
+
+func (e *EventStatus) UnmarshalJSON(data []byte) error {
+	return eventStatusNames.unmarshalJSON("EventStatus", data, (*int64)(e))
+}
+
+func (e *EventStatus) UnmarshalText(data []byte) error {
+	return eventStatusNames.unmarshalText("EventStatus", data, (*int64)(e))
+}
+
+// Parse an EventStatus from its name or numeric value
+func ParseEventStatus(s string) (EventStatus, error) {
+	var e EventStatus
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Price type category, as used in PriceType.Typeid
+type PriceTypeCategory int64
+
+const (
+	PriceTypeCategoryNormal       PriceTypeCategory = 2301
+	PriceTypeCategoryReduction    PriceTypeCategory = 2302
+	PriceTypeCategorySpecial      PriceTypeCategory = 2303
+	PriceTypeCategoryFree         PriceTypeCategory = 2304
+	PriceTypeCategorySubscription PriceTypeCategory = 2305
+)
+
+var priceTypeCategoryNames = enumNames{
+	2301: "normal",
+	2302: "reduction",
+	2303: "special",
+	2304: "free",
+	2305: "subscription",
+}
+
+func (e PriceTypeCategory) String() string {
+	return priceTypeCategoryNames.name("PriceTypeCategory", int64(e))
+}
+func (e PriceTypeCategory) Valid() bool                  { return priceTypeCategoryNames.valid(int64(e)) }
+func (e PriceTypeCategory) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e PriceTypeCategory) MarshalText() ([]byte, error) {
+	return priceTypeCategoryNames.marshalText(int64(e))
+}
+
+func (e *PriceTypeCategory) UnmarshalJSON(data []byte) error {
+	return priceTypeCategoryNames.unmarshalJSON("PriceTypeCategory", data, (*int64)(e))
+}
+
+func (e *PriceTypeCategory) UnmarshalText(data []byte) error {
+	return priceTypeCategoryNames.unmarshalText("PriceTypeCategory", data, (*int64)(e))
+}
+
+// Parse a PriceTypeCategory from its name or numeric value
+func ParsePriceTypeCategory(s string) (PriceTypeCategory, error) {
+	var e PriceTypeCategory
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Sales channel type, as used in SalesChannel.Typeid
+type SalesChannelType int64
+
+const (
+	SalesChannelTypeDesk     SalesChannelType = 3001
+	SalesChannelTypeWeb      SalesChannelType = 3002
+	SalesChannelTypeExternal SalesChannelType = 3003
+)
+
+var salesChannelTypeNames = enumNames{
+	3001: "desk",
+	3002: "web",
+	3003: "external",
+}
+
+func (e SalesChannelType) String() string {
+	return salesChannelTypeNames.name("SalesChannelType", int64(e))
+}
+func (e SalesChannelType) Valid() bool                  { return salesChannelTypeNames.valid(int64(e)) }
+func (e SalesChannelType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e SalesChannelType) MarshalText() ([]byte, error) {
+	return salesChannelTypeNames.marshalText(int64(e))
+}
+
+func (e *SalesChannelType) UnmarshalJSON(data []byte) error {
+	return salesChannelTypeNames.unmarshalJSON("SalesChannelType", data, (*int64)(e))
+}
+
+func (e *SalesChannelType) UnmarshalText(data []byte) error {
+	return salesChannelTypeNames.unmarshalText("SalesChannelType", data, (*int64)(e))
+}
+
+// Parse a SalesChannelType from its name or numeric value
+func ParseSalesChannelType(s string) (SalesChannelType, error) {
+	var e SalesChannelType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Payment method type, as used in PaymentMethod.Paymentmethodtypeid
+type PaymentMethodType int64
+
+const (
+	PaymentMethodTypeInternal PaymentMethodType = 1001
+	PaymentMethodTypeStripe   PaymentMethodType = 1005
+	PaymentMethodTypeMollie   PaymentMethodType = 1006
+	PaymentMethodTypeVoucher  PaymentMethodType = 1007
+	PaymentMethodTypePayPal   PaymentMethodType = 1008
+)
+
+var paymentMethodTypeNames = enumNames{
+	1001: "internal",
+	1005: "stripe",
+	1006: "mollie",
+	1007: "voucher",
+	1008: "paypal",
+}
+
+func (e PaymentMethodType) String() string {
+	return paymentMethodTypeNames.name("PaymentMethodType", int64(e))
+}
+func (e PaymentMethodType) Valid() bool                  { return paymentMethodTypeNames.valid(int64(e)) }
+func (e PaymentMethodType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e PaymentMethodType) MarshalText() ([]byte, error) {
+	return paymentMethodTypeNames.marshalText(int64(e))
+}
+
+func (e *PaymentMethodType) UnmarshalJSON(data []byte) error {
+	return paymentMethodTypeNames.unmarshalJSON("PaymentMethodType", data, (*int64)(e))
+}
+
+func (e *PaymentMethodType) UnmarshalText(data []byte) error {
+	return paymentMethodTypeNames.unmarshalText("PaymentMethodType", data, (*int64)(e))
+}
+
+// Parse a PaymentMethodType from its name or numeric value
+func ParsePaymentMethodType(s string) (PaymentMethodType, error) {
+	var e PaymentMethodType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Payment scenario type, as used in PaymentScenario.Typeid
+type PaymentScenarioType int64
+
+const (
+	PaymentScenarioTypeImmediate          PaymentScenarioType = 2701
+	PaymentScenarioTypeMollieBankTransfer PaymentScenarioType = 2702
+	PaymentScenarioTypeBankTransfer       PaymentScenarioType = 2703
+	PaymentScenarioTypeDeferredOnline     PaymentScenarioType = 2704
+	PaymentScenarioTypeDeferredOther      PaymentScenarioType = 2705
+)
+
+var paymentScenarioTypeNames = enumNames{
+	2701: "immediate",
+	2702: "molliebanktransfer",
+	2703: "banktransfer",
+	2704: "deferredonline",
+	2705: "deferredother",
+}
+
+func (e PaymentScenarioType) String() string {
+	return paymentScenarioTypeNames.name("PaymentScenarioType", int64(e))
+}
+func (e PaymentScenarioType) Valid() bool                  { return paymentScenarioTypeNames.valid(int64(e)) }
+func (e PaymentScenarioType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e PaymentScenarioType) MarshalText() ([]byte, error) {
+	return paymentScenarioTypeNames.marshalText(int64(e))
+}
+
+func (e *PaymentScenarioType) UnmarshalJSON(data []byte) error {
+	return paymentScenarioTypeNames.unmarshalJSON("PaymentScenarioType", data, (*int64)(e))
+}
+
+func (e *PaymentScenarioType) UnmarshalText(data []byte) error {
+	return paymentScenarioTypeNames.unmarshalText("PaymentScenarioType", data, (*int64)(e))
+}
+
+// Parse a PaymentScenarioType from its name or numeric value
+func ParsePaymentScenarioType(s string) (PaymentScenarioType, error) {
+	var e PaymentScenarioType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Delivery scenario type, as used in DeliveryScenario.Typeid
+type DeliveryScenarioType int64
+
+const (
+	DeliveryScenarioTypeManual            DeliveryScenarioType = 2501
+	DeliveryScenarioTypeAfterPayment      DeliveryScenarioType = 2502
+	DeliveryScenarioTypeAfterConfirmation DeliveryScenarioType = 2503
+)
+
+var deliveryScenarioTypeNames = enumNames{
+	2501: "manual",
+	2502: "afterpayment",
+	2503: "afterconfirmation",
+}
+
+func (e DeliveryScenarioType) String() string {
+	return deliveryScenarioTypeNames.name("DeliveryScenarioType", int64(e))
+}
+func (e DeliveryScenarioType) Valid() bool                  { return deliveryScenarioTypeNames.valid(int64(e)) }
+func (e DeliveryScenarioType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e DeliveryScenarioType) MarshalText() ([]byte, error) {
+	return deliveryScenarioTypeNames.marshalText(int64(e))
+}
+
+func (e *DeliveryScenarioType) UnmarshalJSON(data []byte) error {
+	return deliveryScenarioTypeNames.unmarshalJSON("DeliveryScenarioType", data, (*int64)(e))
+}
+
+func (e *DeliveryScenarioType) UnmarshalText(data []byte) error {
+	return deliveryScenarioTypeNames.unmarshalText("DeliveryScenarioType", data, (*int64)(e))
+}
+
+// Parse a DeliveryScenarioType from its name or numeric value
+func ParseDeliveryScenarioType(s string) (DeliveryScenarioType, error) {
+	var e DeliveryScenarioType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Order fee type, as used in OrderFeeDefinition.Typeid
+type OrderFeeType int64
+
+const (
+	OrderFeeTypeAutomatic OrderFeeType = 2401
+	OrderFeeTypeScript    OrderFeeType = 2402
+	OrderFeeTypeManual    OrderFeeType = 2403
+)
+
+var orderFeeTypeNames = enumNames{
+	2401: "automatic",
+	2402: "script",
+	2403: "manual",
+}
+
+func (e OrderFeeType) String() string               { return orderFeeTypeNames.name("OrderFeeType", int64(e)) }
+func (e OrderFeeType) Valid() bool                  { return orderFeeTypeNames.valid(int64(e)) }
+func (e OrderFeeType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e OrderFeeType) MarshalText() ([]byte, error) { return orderFeeTypeNames.marshalText(int64(e)) }
+
+func (e *OrderFeeType) UnmarshalJSON(data []byte) error {
+	return orderFeeTypeNames.unmarshalJSON("OrderFeeType", data, (*int64)(e))
+}
+
+func (e *OrderFeeType) UnmarshalText(data []byte) error {
+	return orderFeeTypeNames.unmarshalText("OrderFeeType", data, (*int64)(e))
+}
+
+// Parse an OrderFeeType from its name or numeric value
+func ParseOrderFeeType(s string) (OrderFeeType, error) {
+	var e OrderFeeType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Order mail template type, as used in OrderMailTemplate.Typeid
+type OrderMailType int64
+
+const (
+	OrderMailTypeConfirmation            OrderMailType = 3101
+	OrderMailTypeDelivery                OrderMailType = 3102
+	OrderMailTypePaymentInstructions     OrderMailType = 3103
+	OrderMailTypeOverdue                 OrderMailType = 3104
+	OrderMailTypeExpiration              OrderMailType = 3105
+	OrderMailTypeWaitinglistConfirmation OrderMailType = 3106
+)
+
+var orderMailTypeNames = enumNames{
+	3101: "confirmation",
+	3102: "delivery",
+	3103: "paymentinstructions",
+	3104: "overdue",
+	3105: "expiration",
+	3106: "waitinglistconfirmation",
+}
+
+func (e OrderMailType) String() string               { return orderMailTypeNames.name("OrderMailType", int64(e)) }
+func (e OrderMailType) Valid() bool                  { return orderMailTypeNames.valid(int64(e)) }
+func (e OrderMailType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e OrderMailType) MarshalText() ([]byte, error) { return orderMailTypeNames.marshalText(int64(e)) }
+
+func (e *OrderMailType) UnmarshalJSON(data []byte) error {
+	return orderMailTypeNames.unmarshalJSON("OrderMailType", data, (*int64)(e))
+}
+
+func (e *OrderMailType) UnmarshalText(data []byte) error {
+	return orderMailTypeNames.unmarshalText("OrderMailType", data, (*int64)(e))
+}
+
+// Parse an OrderMailType from its name or numeric value
+func ParseOrderMailType(s string) (OrderMailType, error) {
+	var e OrderMailType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Product type, as used in Product.Typeid
+type ProductType int64
+
+const (
+	ProductTypeSimple       ProductType = 26001
+	ProductTypeVoucher      ProductType = 26002
+	ProductTypeFixedBundle  ProductType = 26003
+	ProductTypeOptionBundle ProductType = 26004
+)
+
+var productTypeNames = enumNames{
+	26001: "simple",
+	26002: "voucher",
+	26003: "fixedbundle",
+	26004: "optionbundle",
+}
+
+func (e ProductType) String() string               { return productTypeNames.name("ProductType", int64(e)) }
+func (e ProductType) Valid() bool                  { return productTypeNames.valid(int64(e)) }
+func (e ProductType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e ProductType) MarshalText() ([]byte, error) { return productTypeNames.marshalText(int64(e)) }
+
+func (e *ProductType) UnmarshalJSON(data []byte) error {
+	return productTypeNames.unmarshalJSON("ProductType", data, (*int64)(e))
+}
+
+func (e *ProductType) UnmarshalText(data []byte) error {
+	return productTypeNames.unmarshalText("ProductType", data, (*int64)(e))
+}
+
+// Parse a ProductType from its name or numeric value
+func ParseProductType(s string) (ProductType, error) {
+	var e ProductType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Order log item type, as used in LogItem.Typeid
+type LogItemType int64
+
+const (
+	LogItemTypeCreateOrder             LogItemType = 18001
+	LogItemTypeRemoveOrder             LogItemType = 18002
+	LogItemTypeAddTickets              LogItemType = 18003
+	LogItemTypeRemoveTickets           LogItemType = 18004
+	LogItemTypeUpdateTickets           LogItemType = 18005
+	LogItemTypeConfirmOrder            LogItemType = 18006
+	LogItemTypeLinkCustomer            LogItemType = 18007
+	LogItemTypeRemoveCustomer          LogItemType = 18008
+	LogItemTypeMoveTickets             LogItemType = 18009
+	LogItemTypeUpdatePriceType         LogItemType = 18010
+	LogItemTypeAddPayment              LogItemType = 18011
+	LogItemTypeSetPaymentScenario      LogItemType = 18012
+	LogItemTypeSetDeliveryScenario     LogItemType = 18013
+	LogItemTypeOrderDelivered          LogItemType = 18014
+	LogItemTypeMailSent                LogItemType = 18015
+	LogItemTypeCustomFieldsUpdated     LogItemType = 18016
+	LogItemTypeRappelDateUpdated       LogItemType = 18017
+	LogItemTypeExpiryDateUpdated       LogItemType = 18018
+	LogItemTypeScanTicket              LogItemType = 18019
+	LogItemTypeAddProducts             LogItemType = 18020
+	LogItemTypeRemoveProducts          LogItemType = 18021
+	LogItemTypePaymentRequestCreated   LogItemType = 18022
+	LogItemTypePaymentRequestConfirmed LogItemType = 18023
+	LogItemTypePaymentRequestCancelled LogItemType = 18024
+	LogItemTypeRemovePayment           LogItemType = 18025
+	LogItemTypeMailNotDelivered        LogItemType = 18026
+	LogItemTypeSplitOrder              LogItemType = 18027
+	LogItemTypeTicketsDownloaded       LogItemType = 18028
+	LogItemTypeTicketsDownloadedViaAPI LogItemType = 18029
+)
+
+var logItemTypeNames = enumNames{
+	18001: "createorder",
+	18002: "removeorder",
+	18003: "addtickets",
+	18004: "removetickets",
+	18005: "updatetickets",
+	18006: "confirmorder",
+	18007: "linkcustomer",
+	18008: "removecustomer",
+	18009: "movetickets",
+	18010: "updatepricetype",
+	18011: "addpayment",
+	18012: "setpaymentscenario",
+	18013: "setdeliveryscenario",
+	18014: "orderdelivered",
+	18015: "mailsent",
+	18016: "customfieldsupdated",
+	18017: "rappeldateupdated",
+	18018: "expirydateupdated",
+	18019: "scanticket",
+	18020: "addproducts",
+	18021: "removeproducts",
+	18022: "paymentrequestcreated",
+	18023: "paymentrequestconfirmed",
+	18024: "paymentrequestcancelled",
+	18025: "removepayment",
+	18026: "mailnotdelivered",
+	18027: "splitorder",
+	18028: "ticketsdownloaded",
+	18029: "ticketsdownloadedviaapi",
+}
+
+func (e LogItemType) String() string               { return logItemTypeNames.name("LogItemType", int64(e)) }
+func (e LogItemType) Valid() bool                  { return logItemTypeNames.valid(int64(e)) }
+func (e LogItemType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e LogItemType) MarshalText() ([]byte, error) { return logItemTypeNames.marshalText(int64(e)) }
+
+func (e *LogItemType) UnmarshalJSON(data []byte) error {
+	return logItemTypeNames.unmarshalJSON("LogItemType", data, (*int64)(e))
+}
+
+func (e *LogItemType) UnmarshalText(data []byte) error {
+	return logItemTypeNames.unmarshalText("LogItemType", data, (*int64)(e))
+}
+
+// Parse a LogItemType from its name or numeric value
+func ParseLogItemType(s string) (LogItemType, error) {
+	var e LogItemType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Voucher type, as used in Voucher.Typeid
+type VoucherType int64
+
+const (
+	VoucherTypePricetype VoucherType = 24001
+	VoucherTypePayment   VoucherType = 24003
+)
+
+var voucherTypeNames = enumNames{
+	24001: "pricetype",
+	24003: "payment",
+}
+
+func (e VoucherType) String() string               { return voucherTypeNames.name("VoucherType", int64(e)) }
+func (e VoucherType) Valid() bool                  { return voucherTypeNames.valid(int64(e)) }
+func (e VoucherType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e VoucherType) MarshalText() ([]byte, error) { return voucherTypeNames.marshalText(int64(e)) }
+
+func (e *VoucherType) UnmarshalJSON(data []byte) error {
+	return voucherTypeNames.unmarshalJSON("VoucherType", data, (*int64)(e))
+}
+
+func (e *VoucherType) UnmarshalText(data []byte) error {
+	return voucherTypeNames.unmarshalText("VoucherType", data, (*int64)(e))
+}
+
+// Parse a VoucherType from its name or numeric value
+func ParseVoucherType(s string) (VoucherType, error) {
+	var e VoucherType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Voucher code format, as used in Voucher.Codeformatid
+type VoucherCodeFormat int64
+
+const (
+	VoucherCodeFormatDigits12       VoucherCodeFormat = 27001
+	VoucherCodeFormatDigits16       VoucherCodeFormat = 27002
+	VoucherCodeFormatAlphanumeric8  VoucherCodeFormat = 27003
+	VoucherCodeFormatAlphanumeric12 VoucherCodeFormat = 27004
+	VoucherCodeFormatAlphanumeric16 VoucherCodeFormat = 27005
+	VoucherCodeFormatList           VoucherCodeFormat = 27099
+)
+
+var voucherCodeFormatNames = enumNames{
+	27001: "digits12",
+	27002: "digits16",
+	27003: "alphanumeric8",
+	27004: "alphanumeric12",
+	27005: "alphanumeric16",
+	27099: "list",
+}
+
+func (e VoucherCodeFormat) String() string {
+	return voucherCodeFormatNames.name("VoucherCodeFormat", int64(e))
+}
+func (e VoucherCodeFormat) Valid() bool                  { return voucherCodeFormatNames.valid(int64(e)) }
+func (e VoucherCodeFormat) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e VoucherCodeFormat) MarshalText() ([]byte, error) {
+	return voucherCodeFormatNames.marshalText(int64(e))
+}
+
+func (e *VoucherCodeFormat) UnmarshalJSON(data []byte) error {
+	return voucherCodeFormatNames.unmarshalJSON("VoucherCodeFormat", data, (*int64)(e))
+}
+
+func (e *VoucherCodeFormat) UnmarshalText(data []byte) error {
+	return voucherCodeFormatNames.unmarshalText("VoucherCodeFormat", data, (*int64)(e))
+}
+
+// Parse a VoucherCodeFormat from its name or numeric value
+func ParseVoucherCodeFormat(s string) (VoucherCodeFormat, error) {
+	var e VoucherCodeFormat
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Object type of field definitions, filter definitions and views, as used in
+// FieldDefinition.Typeid, FilterDefinition.Typeid and View.Typeid
+type ObjectType int64
+
+const (
+	ObjectTypeOrder   ObjectType = 10001
+	ObjectTypeContact ObjectType = 10002
+	ObjectTypeEvent   ObjectType = 10003
+	ObjectTypeTicket  ObjectType = 10004
+	ObjectTypePayment ObjectType = 10005
+)
+
+var objectTypeNames = enumNames{
+	10001: "order",
+	10002: "contact",
+	10003: "event",
+	10004: "ticket",
+	10005: "payment",
+}
+
+func (e ObjectType) String() string               { return objectTypeNames.name("ObjectType", int64(e)) }
+func (e ObjectType) Valid() bool                  { return objectTypeNames.valid(int64(e)) }
+func (e ObjectType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e ObjectType) MarshalText() ([]byte, error) { return objectTypeNames.marshalText(int64(e)) }
+
+func (e *ObjectType) UnmarshalJSON(data []byte) error {
+	return objectTypeNames.unmarshalJSON("ObjectType", data, (*int64)(e))
+}
+
+func (e *ObjectType) UnmarshalText(data []byte) error {
+	return objectTypeNames.unmarshalText("ObjectType", data, (*int64)(e))
+}
+
+// Parse an ObjectType from its name or numeric value
+func ParseObjectType(s string) (ObjectType, error) {
+	var e ObjectType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Object type a custom field is defined for, as used in CustomField.Typeid
+type CustomFieldObjectType int64
+
+const (
+	CustomFieldObjectTypeOrder                  CustomFieldObjectType = 13001
+	CustomFieldObjectTypeContact                CustomFieldObjectType = 13002
+	CustomFieldObjectTypeEvent                  CustomFieldObjectType = 13003
+	CustomFieldObjectTypeTicket                 CustomFieldObjectType = 13004
+	CustomFieldObjectTypeProduct                CustomFieldObjectType = 13005
+	CustomFieldObjectTypePricetype              CustomFieldObjectType = 13006
+	CustomFieldObjectTypePaymentMethod          CustomFieldObjectType = 13007
+	CustomFieldObjectTypePaymentScenario        CustomFieldObjectType = 13008
+	CustomFieldObjectTypeDeliveryScenario       CustomFieldObjectType = 13009
+	CustomFieldObjectTypeEventLocation          CustomFieldObjectType = 13010
+	CustomFieldObjectTypeUser                   CustomFieldObjectType = 13011
+	CustomFieldObjectTypeWaitingListRequest     CustomFieldObjectType = 13012
+	CustomFieldObjectTypeWaitingListRequestItem CustomFieldObjectType = 13013
+)
+
+var customFieldObjectTypeNames = enumNames{
+	13001: "order",
+	13002: "contact",
+	13003: "event",
+	13004: "ticket",
+	13005: "product",
+	13006: "pricetype",
+	13007: "paymentmethod",
+	13008: "paymentscenario",
+	13009: "deliveryscenario",
+	13010: "eventlocation",
+	13011: "user",
+	13012: "waitinglistrequest",
+	13013: "waitinglistrequestitem",
+}
+
+func (e CustomFieldObjectType) String() string {
+	return customFieldObjectTypeNames.name("CustomFieldObjectType", int64(e))
+}
+func (e CustomFieldObjectType) Valid() bool                  { return customFieldObjectTypeNames.valid(int64(e)) }
+func (e CustomFieldObjectType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e CustomFieldObjectType) MarshalText() ([]byte, error) {
+	return customFieldObjectTypeNames.marshalText(int64(e))
+}
+
+func (e *CustomFieldObjectType) UnmarshalJSON(data []byte) error {
+	return customFieldObjectTypeNames.unmarshalJSON("CustomFieldObjectType", data, (*int64)(e))
+}
+
+func (e *CustomFieldObjectType) UnmarshalText(data []byte) error {
+	return customFieldObjectTypeNames.unmarshalText("CustomFieldObjectType", data, (*int64)(e))
+}
+
+// Parse a CustomFieldObjectType from its name or numeric value
+func ParseCustomFieldObjectType(s string) (CustomFieldObjectType, error) {
+	var e CustomFieldObjectType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Value type of a custom field, as used in CustomField.Fieldtypeid
+type CustomFieldFieldType int64
+
+const (
+	CustomFieldFieldTypeString              CustomFieldFieldType = 12001
+	CustomFieldFieldTypeInteger             CustomFieldFieldType = 12002
+	CustomFieldFieldTypeDate                CustomFieldFieldType = 12003
+	CustomFieldFieldTypeBoolean             CustomFieldFieldType = 12004
+	CustomFieldFieldTypeText                CustomFieldFieldType = 12005
+	CustomFieldFieldTypeMultiLanguageString CustomFieldFieldType = 12006
+	CustomFieldFieldTypeMultiLanguageText   CustomFieldFieldType = 12007
+	CustomFieldFieldTypeDecimal             CustomFieldFieldType = 12008
+)
+
+var customFieldFieldTypeNames = enumNames{
+	12001: "string",
+	12002: "integer",
+	12003: "date",
+	12004: "boolean",
+	12005: "text",
+	12006: "multilanguagestring",
+	12007: "multilanguagetext",
+	12008: "decimal",
+}
+
+func (e CustomFieldFieldType) String() string {
+	return customFieldFieldTypeNames.name("CustomFieldFieldType", int64(e))
+}
+func (e CustomFieldFieldType) Valid() bool                  { return customFieldFieldTypeNames.valid(int64(e)) }
+func (e CustomFieldFieldType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e CustomFieldFieldType) MarshalText() ([]byte, error) {
+	return customFieldFieldTypeNames.marshalText(int64(e))
+}
+
+func (e *CustomFieldFieldType) UnmarshalJSON(data []byte) error {
+	return customFieldFieldTypeNames.unmarshalJSON("CustomFieldFieldType", data, (*int64)(e))
+}
+
+func (e *CustomFieldFieldType) UnmarshalText(data []byte) error {
+	return customFieldFieldTypeNames.unmarshalText("CustomFieldFieldType", data, (*int64)(e))
+}
+
+// Parse a CustomFieldFieldType from its name or numeric value
+func ParseCustomFieldFieldType(s string) (CustomFieldFieldType, error) {
+	var e CustomFieldFieldType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Opt-in status, as used in ContactOptIn.Status
+type OptInStatus int64
+
+const (
+	OptInStatusUnknown  OptInStatus = 7601
+	OptInStatusOptedIn  OptInStatus = 7602
+	OptInStatusOptedOut OptInStatus = 7603
+)
+
+var optInStatusNames = enumNames{
+	7601: "unknown",
+	7602: "optedin",
+	7603: "optedout",
+}
+
+func (e OptInStatus) String() string               { return optInStatusNames.name("OptInStatus", int64(e)) }
+func (e OptInStatus) Valid() bool                  { return optInStatusNames.valid(int64(e)) }
+func (e OptInStatus) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e OptInStatus) MarshalText() ([]byte, error) { return optInStatusNames.marshalText(int64(e)) }
+
+func (e *OptInStatus) UnmarshalJSON(data []byte) error {
+	return optInStatusNames.unmarshalJSON("OptInStatus", data, (*int64)(e))
+}
+
+func (e *OptInStatus) UnmarshalText(data []byte) error {
+	return optInStatusNames.unmarshalText("OptInStatus", data, (*int64)(e))
+}
+
+// Parse an OptInStatus from its name or numeric value
+func ParseOptInStatus(s string) (OptInStatus, error) {
+	var e OptInStatus
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Opt-in type, as used in OptIn.Typeid
+type OptInType int64
+
+const (
+	OptInTypeMandatory OptInType = 40001
+	OptInTypeOptional  OptInType = 40002
+)
+
+var optInTypeNames = enumNames{
+	40001: "mandatory",
+	40002: "optional",
+}
+
+func (e OptInType) String() string               { return optInTypeNames.name("OptInType", int64(e)) }
+func (e OptInType) Valid() bool                  { return optInTypeNames.valid(int64(e)) }
+func (e OptInType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e OptInType) MarshalText() ([]byte, error) { return optInTypeNames.marshalText(int64(e)) }
+
+func (e *OptInType) UnmarshalJSON(data []byte) error {
+	return optInTypeNames.unmarshalJSON("OptInType", data, (*int64)(e))
+}
+
+func (e *OptInType) UnmarshalText(data []byte) error {
+	return optInTypeNames.unmarshalText("OptInType", data, (*int64)(e))
+}
+
+// Parse an OptInType from its name or numeric value
+func ParseOptInType(s string) (OptInType, error) {
+	var e OptInType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Report usage type, as used in Report.Usagetypeid
+type ReportUsageType int64
+
+const (
+	ReportUsageTypeSales         ReportUsageType = 17001
+	ReportUsageTypeExternalSales ReportUsageType = 17002
+	ReportUsageTypeHidden        ReportUsageType = 17003
+)
+
+var reportUsageTypeNames = enumNames{
+	17001: "sales",
+	17002: "externalsales",
+	17003: "hidden",
+}
+
+func (e ReportUsageType) String() string {
+	return reportUsageTypeNames.name("ReportUsageType", int64(e))
+}
+func (e ReportUsageType) Valid() bool                  { return reportUsageTypeNames.valid(int64(e)) }
+func (e ReportUsageType) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e ReportUsageType) MarshalText() ([]byte, error) {
+	return reportUsageTypeNames.marshalText(int64(e))
+}
+
+func (e *ReportUsageType) UnmarshalJSON(data []byte) error {
+	return reportUsageTypeNames.unmarshalJSON("ReportUsageType", data, (*int64)(e))
+}
+
+func (e *ReportUsageType) UnmarshalText(data []byte) error {
+	return reportUsageTypeNames.unmarshalText("ReportUsageType", data, (*int64)(e))
+}
+
+// Parse a ReportUsageType from its name or numeric value
+func ParseReportUsageType(s string) (ReportUsageType, error) {
+	var e ReportUsageType
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
+
+// Waiting list request items status, as used in WaitingListRequest.Itemsstatus
+type WaitingListItemsStatus int64
+
+const (
+	WaitingListItemsStatusNone    WaitingListItemsStatus = 29101
+	WaitingListItemsStatusPartial WaitingListItemsStatus = 29102
+	WaitingListItemsStatusFull    WaitingListItemsStatus = 29103
+)
+
+var waitingListItemsStatusNames = enumNames{
+	29101: "none",
+	29102: "partial",
+	29103: "full",
+}
+
+func (e WaitingListItemsStatus) String() string {
+	return waitingListItemsStatusNames.name("WaitingListItemsStatus", int64(e))
+}
+func (e WaitingListItemsStatus) Valid() bool                  { return waitingListItemsStatusNames.valid(int64(e)) }
+func (e WaitingListItemsStatus) MarshalJSON() ([]byte, error) { return marshalEnum(int64(e)) }
+func (e WaitingListItemsStatus) MarshalText() ([]byte, error) {
+	return waitingListItemsStatusNames.marshalText(int64(e))
+}
+
+func (e *WaitingListItemsStatus) UnmarshalJSON(data []byte) error {
+	return waitingListItemsStatusNames.unmarshalJSON("WaitingListItemsStatus", data, (*int64)(e))
+}
+
+func (e *WaitingListItemsStatus) UnmarshalText(data []byte) error {
+	return waitingListItemsStatusNames.unmarshalText("WaitingListItemsStatus", data, (*int64)(e))
+}
+
+// Parse a WaitingListItemsStatus from its name or numeric value
+func ParseWaitingListItemsStatus(s string) (WaitingListItemsStatus, error) {
+	var e WaitingListItemsStatus
+	err := e.UnmarshalText([]byte(s))
+	return e, err
+}
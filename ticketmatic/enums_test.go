@@ -0,0 +1,80 @@
+package ticketmatic
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestEnumString(t *testing.T) {
+	if s := OrderStatus(21002).String(); s != "confirmed" {
+		t.Errorf("Unexpected name, got %#v, expected %#v", s, "confirmed")
+	}
+	if s := fmt.Sprintf("%v", DeliveryStatus(42)); s != "DeliveryStatus(42)" {
+		t.Errorf("Unexpected name, got %#v, expected %#v", s, "DeliveryStatus(42)")
+	}
+	if !PriceTypeCategoryFree.Valid() || PriceTypeCategory(2399).Valid() {
+		t.Errorf("Unexpected validation result")
+	}
+}
+
+func TestEnumJSON(t *testing.T) {
+	var obj struct {
+		Status   OrderStatus              `json:"status"`
+		Delivery DeliveryStatus           `json:"delivery"`
+		Counts   map[PaymentStatus]int64  `json:"counts"`
+		Missing  AccessControlStatus      `json:"missing"`
+		Types    []PaymentMethodType      `json:"types"`
+		Other    map[string]VoucherType   `json:"other"`
+		Empty    map[EventStatus]struct{} `json:"empty,omitempty"`
+	}
+	err := json.Unmarshal([]byte(`{"status": 21001, "delivery": "Delivered", "counts": {"overpaid": 3, "1": 2}, "missing": null, "types": [1005, "paypal"]}`), &obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.Status != OrderStatusUnconfirmed {
+		t.Errorf("Unexpected obj.Status, got %v", obj.Status)
+	}
+	if obj.Delivery != DeliveryStatusDelivered {
+		t.Errorf("Unexpected obj.Delivery, got %v", obj.Delivery)
+	}
+	if obj.Counts[PaymentStatusOverpaid] != 3 || obj.Counts[PaymentStatusFullyPaid] != 2 {
+		t.Errorf("Unexpected obj.Counts, got %v", obj.Counts)
+	}
+	if obj.Types[1] != PaymentMethodTypePayPal {
+		t.Errorf("Unexpected obj.Types, got %v", obj.Types)
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"status":21001,"delivery":2602,"counts":{"fullypaid":2,"overpaid":3},"missing":0,"types":[1005,1008],"other":null}`
+	if string(data) != expected {
+		t.Errorf("Unexpected JSON, got %s, expected %s", data, expected)
+	}
+
+	var s OrderStatus
+	err = json.Unmarshal([]byte(`"unknown"`), &s)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestEnumParse(t *testing.T) {
+	v, err := ParseLogItemType("addpayment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != LogItemTypeAddPayment {
+		t.Errorf("Unexpected value, got %v", v)
+	}
+
+	v2, err := ParseEventStatus("19003")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v2 != EventStatusClosed {
+		t.Errorf("Unexpected value, got %v", v2)
+	}
+}
@@ -0,0 +1,58 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+type csvWriter struct {
+	w      *csv.Writer
+	cols   *columns
+	locale *Locale
+	record []string
+}
+
+// Create a CSV writer. A header row with the column names is written first.
+// The field separator and number/date formatting follow the locale.
+func NewCSVWriter(w io.Writer, opts *Options) Writer {
+	locale := opts.locale()
+	cw := csv.NewWriter(w)
+	if locale.Comma != 0 {
+		cw.Comma = locale.Comma
+	}
+	return &csvWriter{
+		w:      cw,
+		cols:   newColumns(opts),
+		locale: locale,
+	}
+}
+
+func (w *csvWriter) Write(row map[string]interface{}) error {
+	if w.record == nil {
+		w.cols.init(row)
+		err := w.w.Write(w.cols.names)
+		if err != nil {
+			return err
+		}
+		w.record = make([]string, len(w.cols.names))
+	} else if err := w.cols.check(row); err != nil {
+		return err
+	}
+
+	for i, col := range w.cols.names {
+		w.record[i] = w.locale.format(row[col])
+	}
+	return w.w.Write(w.record)
+}
+
+func (w *csvWriter) Close() error {
+	if w.record == nil && w.cols.fixed {
+		// No rows, still write the header
+		err := w.w.Write(w.cols.names)
+		if err != nil {
+			return err
+		}
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
@@ -0,0 +1,25 @@
+// Streaming export of query results and API objects to CSV, JSON Lines and
+// XLSX.
+//
+// Rows can come from any tools.RowReader, such as the stream returned by
+// tools.Export or a tools.QueryIterator. Iterators over API objects (such as
+// orders.Iterator) can be adapted using Rows:
+//
+//	stream, err := tools.Export(client, &ticketmatic.QueryRequest{Query: "SELECT * FROM tm.order"})
+//	if err != nil {
+//		return err
+//	}
+//	defer stream.Close()
+//
+//	w := export.NewCSVWriter(f, &export.Options{Locale: export.LocaleNL})
+//	n, err := export.Copy(w, stream)
+//
+//	// Or for API objects:
+//	n, err = export.Copy(export.NewJSONLWriter(f, nil), export.Rows(orders.NewIterator(client, nil).Next))
+//
+// CopyFile writes to a file in a format detected from its name:
+//
+//	n, err := export.CopyFile("sales.xlsx", "", nil, stream, nil)
+//
+// The tm-export command (in cmd/tm-export) wraps this for use from the shell.
+package export
@@ -0,0 +1,355 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/tools"
+)
+
+// Writes rows to an output format
+type Writer interface {
+	// Write a single row
+	Write(row map[string]interface{}) error
+
+	// Finish the output (writes trailers and flushes buffers). Does not close
+	// the underlying io.Writer.
+	Close() error
+}
+
+// Export options
+type Options struct {
+	// Columns to write, in order. When empty, the columns of the first row are
+	// used, sorted by name. Rows are streamed, so a later row with a value in
+	// a column that isn't in the first row fails the export: set the columns
+	// explicitly when the rows differ.
+	Columns []string
+
+	// Formatting of numbers and dates, defaults to LocaleEN.
+	Locale *Locale
+
+	// Name of the worksheet (XLSX only), defaults to "Export".
+	Sheet string
+}
+
+// Number and date formatting
+type Locale struct {
+	// Decimal separator for numbers
+	DecimalSeparator string
+
+	// Field separator (CSV only)
+	Comma rune
+
+	// Layout for timestamps, see the time package
+	TimeLayout string
+
+	// Layout for dates without time
+	DateLayout string
+}
+
+// Predefined locales
+var (
+	// English: 1234.5, 2016-03-01 14:30:00
+	LocaleEN = &Locale{
+		DecimalSeparator: ".",
+		Comma:            ',',
+		TimeLayout:       "2006-01-02 15:04:05",
+		DateLayout:       "2006-01-02",
+	}
+
+	// Dutch (Belgium and The Netherlands): 1234,5, 01-03-2016 14:30:00
+	LocaleNL = &Locale{
+		DecimalSeparator: ",",
+		Comma:            ';',
+		TimeLayout:       "02-01-2006 15:04:05",
+		DateLayout:       "02-01-2006",
+	}
+
+	// French: 1234,5, 01/03/2016 14:30:00
+	LocaleFR = &Locale{
+		DecimalSeparator: ",",
+		Comma:            ';',
+		TimeLayout:       "02/01/2006 15:04:05",
+		DateLayout:       "02/01/2006",
+	}
+
+	// German: 1234,5, 01.03.2016 14:30:00
+	LocaleDE = &Locale{
+		DecimalSeparator: ",",
+		Comma:            ';',
+		TimeLayout:       "02.01.2006 15:04:05",
+		DateLayout:       "02.01.2006",
+	}
+)
+
+var locales = map[string]*Locale{
+	"en": LocaleEN,
+	"nl": LocaleNL,
+	"fr": LocaleFR,
+	"de": LocaleDE,
+}
+
+// Find a predefined locale by language code ("en", "nl", ...), returns nil if
+// unknown.
+func LookupLocale(code string) *Locale {
+	return locales[strings.ToLower(code)]
+}
+
+func (o *Options) locale() *Locale {
+	if o == nil || o.Locale == nil {
+		return LocaleEN
+	}
+	return o.Locale
+}
+
+// Determines the column order of an export
+type columns struct {
+	names []string
+	fixed bool
+
+	// Columns taken from the first row, nil when set in the options
+	known map[string]bool
+}
+
+func newColumns(opts *Options) *columns {
+	c := &columns{}
+	if opts != nil && len(opts.Columns) > 0 {
+		c.names = opts.Columns
+		c.fixed = true
+	}
+	return c
+}
+
+// Returns true if the columns were determined by this row
+func (c *columns) init(row map[string]interface{}) bool {
+	if c.fixed {
+		return false
+	}
+	c.names = make([]string, 0, len(row))
+	c.known = make(map[string]bool, len(row))
+	for k := range row {
+		c.names = append(c.names, k)
+		c.known[k] = true
+	}
+	sort.Strings(c.names)
+	c.fixed = true
+	return true
+}
+
+// Fails if the row has a value that would be dropped: a column that wasn't in
+// the first row. Empty values are ignored.
+func (c *columns) check(row map[string]interface{}) error {
+	if c.known == nil {
+		return nil
+	}
+	var missing []string
+	for k, v := range row {
+		if v != nil && !c.known[k] {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("Column %s is not in the first row, set the columns explicitly", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Copy all rows from r into w and close w. Returns the number of rows
+// written.
+func Copy(w Writer, r tools.RowReader) (int, error) {
+	n := 0
+	for {
+		row, err := r.Next()
+		if err != nil {
+			return n, err
+		}
+		if row == nil {
+			break
+		}
+		err = w.Write(row)
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, w.Close()
+}
+
+// Writer constructors by format name
+var formats = map[string]func(io.Writer, *Options) Writer{
+	"csv":    NewCSVWriter,
+	"jsonl":  NewJSONLWriter,
+	"ndjson": NewJSONLWriter,
+	"xlsx":   NewXLSXWriter,
+}
+
+// Create a writer for a format: "csv", "jsonl" (or "ndjson") or "xlsx".
+func NewWriter(format string, w io.Writer, opts *Options) (Writer, error) {
+	f, ok := formats[format]
+	if !ok {
+		return nil, fmt.Errorf("Unknown format: %s", format)
+	}
+	return f(w, opts), nil
+}
+
+// Detect the format from the extension of a file name, csv if there is none.
+func DetectFormat(filename string) string {
+	format := strings.TrimPrefix(filepath.Ext(filename), ".")
+	if format == "" || format == "txt" {
+		return "csv"
+	}
+	return strings.ToLower(format)
+}
+
+// Parse a comma-separated list of columns, for Options.Columns. Returns nil
+// for an empty list.
+func ParseColumns(s string) []string {
+	var result []string
+	for _, c := range strings.Split(s, ",") {
+		c = strings.TrimSpace(c)
+		if c != "" {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Copy all rows from r into a new file, or into out when filename is empty.
+// The format is detected from the file name when empty. Returns the number of
+// rows written.
+func CopyFile(filename, format string, out io.Writer, r tools.RowReader, opts *Options) (n int, err error) {
+	if format == "" {
+		format = DetectFormat(filename)
+	}
+	if _, ok := formats[format]; !ok {
+		return 0, fmt.Errorf("Unknown format: %s", format)
+	}
+
+	if filename != "" {
+		f, err := os.Create(filename)
+		if err != nil {
+			return 0, err
+		}
+		defer func() {
+			cerr := f.Close()
+			if err == nil {
+				err = cerr
+			}
+		}()
+		out = f
+	}
+
+	w, err := NewWriter(format, out, opts)
+	if err != nil {
+		return 0, err
+	}
+	return Copy(w, r)
+}
+
+type rowFunc func() (map[string]interface{}, error)
+
+func (f rowFunc) Next() (map[string]interface{}, error) {
+	return f()
+}
+
+// Adapt an iterator over API objects into a RowReader. Each object becomes a
+// row with its JSON fields as columns:
+//
+//	rows := export.Rows(orders.NewIterator(client, nil).Next)
+func Rows[T any](next func() (*T, error)) tools.RowReader {
+	return rowFunc(func() (map[string]interface{}, error) {
+		obj, err := next()
+		if err != nil || obj == nil {
+			return nil, err
+		}
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		var row map[string]interface{}
+		err = json.Unmarshal(data, &row)
+		if err != nil {
+			return nil, err
+		}
+		return row, nil
+	})
+}
+
+// Detect timestamps in the formats used by the API. Returns whether it is a
+// date without time.
+func parseDate(s string) (time.Time, bool, bool) {
+	if len(s) < 10 || s[4] != '-' || s[7] != '-' {
+		return time.Time{}, false, false
+	}
+	if len(s) > 10 && s[10] != ' ' && s[10] != 'T' {
+		return time.Time{}, false, false
+	}
+	t, err := ticketmatic.ParseTime(s)
+	if err != nil {
+		return time.Time{}, false, false
+	}
+	return t, len(s) == 10, true
+}
+
+// Format a value as text, using the locale for numbers and dates. Nested
+// values (objects and arrays) are written as JSON.
+func (l *Locale) format(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		if t, dateOnly, ok := parseDate(v); ok {
+			if dateOnly {
+				return t.Format(l.DateLayout)
+			}
+			return t.Format(l.TimeLayout)
+		}
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return l.number(strconv.FormatFloat(v, 'f', -1, 64))
+	case json.Number:
+		return l.number(string(v))
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int:
+		return strconv.Itoa(v)
+	case time.Time:
+		return v.Format(l.TimeLayout)
+	case ticketmatic.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.Time().Format(l.TimeLayout)
+	case ticketmatic.NullTime:
+		if !v.Valid || v.Time.IsZero() {
+			return ""
+		}
+		return v.Time.Time().Format(l.TimeLayout)
+	case ticketmatic.Money:
+		return l.number(v.String())
+	}
+
+	data, err := json.Marshal(val)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func (l *Locale) number(s string) string {
+	if l.DecimalSeparator == "." {
+		return s
+	}
+	return strings.Replace(s, ".", l.DecimalSeparator, 1)
+}
@@ -0,0 +1,278 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+type sliceReader struct {
+	rows []map[string]interface{}
+}
+
+func (r *sliceReader) Next() (map[string]interface{}, error) {
+	if len(r.rows) == 0 {
+		return nil, nil
+	}
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+func testRows() *sliceReader {
+	return &sliceReader{rows: []map[string]interface{}{
+		{"id": float64(1), "name": "Alice", "amount": 12.5, "createdts": "2016-03-01 14:30:00", "paid": true},
+		{"id": float64(2), "name": "Bob; \"B\"", "amount": float64(3), "createdts": "2016-03-02", "paid": false, "extra": nil},
+		{"id": float64(3), "name": nil, "amount": nil, "createdts": nil, "paid": nil},
+	}}
+}
+
+func TestCSV(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := Copy(NewCSVWriter(&buf, nil), testRows())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("Unexpected row count, got %d, expected %d", n, 3)
+	}
+
+	expected := `amount,createdts,id,name,paid
+12.5,2016-03-01 14:30:00,1,Alice,true
+3,2016-03-02,2,"Bob; ""B""",false
+,,3,,
+`
+	if buf.String() != expected {
+		t.Errorf("Unexpected output, got %q, expected %q", buf.String(), expected)
+	}
+}
+
+func TestCSVLocale(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCSVWriter(&buf, &Options{
+		Locale:  LocaleNL,
+		Columns: []string{"name", "amount", "createdts", "extra"},
+	})
+	_, err := Copy(w, testRows())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `name;amount;createdts;extra
+Alice;12,5;01-03-2016 14:30:00;
+"Bob; ""B""";3;02-03-2016;
+;;;
+`
+	if buf.String() != expected {
+		t.Errorf("Unexpected output, got %q, expected %q", buf.String(), expected)
+	}
+}
+
+func TestNewColumn(t *testing.T) {
+	rows := func() *sliceReader {
+		r := testRows()
+		r.rows[2]["extra"] = "x"
+		return r
+	}
+
+	var buf bytes.Buffer
+	n, err := Copy(NewCSVWriter(&buf, nil), rows())
+	if err == nil || err.Error() != "Column extra is not in the first row, set the columns explicitly" {
+		t.Errorf("Unexpected error, got %#v", err)
+	}
+	if n != 2 {
+		t.Errorf("Unexpected row count, got %d, expected %d", n, 2)
+	}
+	_, err = Copy(NewXLSXWriter(ioutil.Discard, nil), rows())
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+
+	buf.Reset()
+	_, err = Copy(NewCSVWriter(&buf, &Options{Columns: []string{"id", "extra"}}), rows())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "id,extra\n1,\n2,\n3,x\n" {
+		t.Errorf("Unexpected output, got %q", buf.String())
+	}
+}
+
+func TestCSVEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := Copy(NewCSVWriter(&buf, &Options{Columns: []string{"a", "b"}}), &sliceReader{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "a,b\n" {
+		t.Errorf("Unexpected output, got %q, expected %q", buf.String(), "a,b\n")
+	}
+}
+
+func TestJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := Copy(NewJSONLWriter(&buf, &Options{Columns: []string{"id", "name"}}), testRows())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"id":1,"name":"Alice"}
+{"id":2,"name":"Bob; \"B\""}
+{"id":3,"name":null}
+`
+	if buf.String() != expected {
+		t.Errorf("Unexpected output, got %q, expected %q", buf.String(), expected)
+	}
+}
+
+func TestXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := Copy(NewXLSXWriter(&buf, &Options{Sheet: "Sales: 2016"}), testRows())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = string(data)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("Missing part %s", name)
+		}
+	}
+
+	if !strings.Contains(files["xl/workbook.xml"], `name="Sales_ 2016"`) {
+		t.Errorf("Unexpected sheet name in %s", files["xl/workbook.xml"])
+	}
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	checks := []string{
+		`<c r="A1" t="inlineStr" s="3"><is><t xml:space="preserve">amount</t></is></c>`,
+		`<c r="A2"><v>12.5</v></c>`,
+		`<c r="B2" s="1"><v>42430.604166666664</v></c>`,
+		`<c r="B3" s="2"><v>42431</v></c>`,
+		`<c r="D3" t="inlineStr"><is><t xml:space="preserve">Bob; &#34;B&#34;</t></is></c>`,
+		`<c r="E2" t="b"><v>1</v></c>`,
+		`<row r="4"><c r="C4"><v>3</v></c></row>`,
+	}
+	for _, c := range checks {
+		if !strings.Contains(sheet, c) {
+			t.Errorf("Expected %s in sheet %s", c, sheet)
+		}
+	}
+	if !strings.HasSuffix(sheet, "</sheetData></worksheet>") {
+		t.Errorf("Unterminated sheet %s", sheet)
+	}
+}
+
+func TestCellRef(t *testing.T) {
+	tests := map[int]string{0: "A1", 25: "Z1", 26: "AA1", 27: "AB1", 701: "ZZ1", 702: "AAA1"}
+	for col, expected := range tests {
+		if ref := cellRef(col, 1); ref != expected {
+			t.Errorf("Unexpected ref for %d, got %s, expected %s", col, ref, expected)
+		}
+	}
+}
+
+func TestRows(t *testing.T) {
+	orders := []*ticketmatic.Order{
+		{Orderid: 1, Status: 21001},
+		{Orderid: 2, Status: 21002},
+	}
+	next := func() (*ticketmatic.Order, error) {
+		if len(orders) == 0 {
+			return nil, nil
+		}
+		o := orders[0]
+		orders = orders[1:]
+		return o, nil
+	}
+
+	var buf bytes.Buffer
+	n, err := Copy(NewCSVWriter(&buf, &Options{Columns: []string{"orderid", "status"}}), Rows(next))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("Unexpected row count, got %d, expected %d", n, 2)
+	}
+	expected := "orderid,status\n1,21001\n2,21002\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected output, got %q, expected %q", buf.String(), expected)
+	}
+}
+
+func TestRowsError(t *testing.T) {
+	next := func() (*ticketmatic.Order, error) {
+		return nil, errors.New("Failed")
+	}
+	_, err := Copy(NewJSONLWriter(ioutil.Discard, nil), Rows(next))
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestCopyFile(t *testing.T) {
+	if f := DetectFormat("sales.XLSX"); f != "xlsx" {
+		t.Errorf("Unexpected format, got %s, expected %s", f, "xlsx")
+	}
+	if f := DetectFormat(""); f != "csv" {
+		t.Errorf("Unexpected format, got %s, expected %s", f, "csv")
+	}
+	if c := ParseColumns(" id, name,,"); len(c) != 2 || c[0] != "id" || c[1] != "name" {
+		t.Errorf("Unexpected columns, got %#v", c)
+	}
+	_, err := NewWriter("pdf", ioutil.Discard, nil)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+
+	name := filepath.Join(t.TempDir(), "sales.jsonl")
+	n, err := CopyFile(name, "", nil, testRows(), &Options{Columns: []string{"id"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "{\"id\":1}\n{\"id\":2}\n{\"id\":3}\n"
+	if n != 3 || string(data) != expected {
+		t.Errorf("Unexpected output, got %q, expected %q", data, expected)
+	}
+
+	var buf bytes.Buffer
+	_, err = CopyFile("", "csv", &buf, testRows(), &Options{Columns: []string{"id"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "id\n1\n2\n3\n" {
+		t.Errorf("Unexpected output, got %q", buf.String())
+	}
+
+	_, err = CopyFile(filepath.Join(t.TempDir(), "sales.pdf"), "", nil, testRows(), nil)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
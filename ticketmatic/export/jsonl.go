@@ -0,0 +1,42 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+type jsonlWriter struct {
+	buf  *bufio.Writer
+	enc  *json.Encoder
+	cols []string
+}
+
+// Create a JSON Lines writer: one JSON object per line. Values are written as
+// received, only the Columns option applies (to select columns).
+func NewJSONLWriter(w io.Writer, opts *Options) Writer {
+	buf := bufio.NewWriter(w)
+	jw := &jsonlWriter{
+		buf: buf,
+		enc: json.NewEncoder(buf),
+	}
+	if opts != nil {
+		jw.cols = opts.Columns
+	}
+	return jw
+}
+
+func (w *jsonlWriter) Write(row map[string]interface{}) error {
+	if len(w.cols) > 0 {
+		sel := make(map[string]interface{}, len(w.cols))
+		for _, col := range w.cols {
+			sel[col] = row[col]
+		}
+		row = sel
+	}
+	return w.enc.Encode(row)
+}
+
+func (w *jsonlWriter) Close() error {
+	return w.buf.Flush()
+}
@@ -0,0 +1,287 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Cell styles, indexes into cellXfs of styles.xml
+const (
+	styleDefault  = 0
+	styleDateTime = 1
+	styleDate     = 2
+	styleHeader   = 3
+)
+
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+// Built-in number formats: 22 is "m/d/yy h:mm", 14 is "m/d/yyyy". Both are
+// shown in the regional format of the spreadsheet application.
+const xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="22" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="14" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`</cellXfs>` +
+	`</styleSheet>`
+
+const xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const xlsxSheetEnd = `</sheetData></worksheet>`
+
+// Day zero of spreadsheet serial dates
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+type xlsxWriter struct {
+	zip    *zip.Writer
+	sheet  *bufio.Writer
+	cols   *columns
+	name   string
+	rownum int
+	err    error
+}
+
+// Create an XLSX (Office Open XML spreadsheet) writer, with a single
+// worksheet. The first row holds the column names. Numbers, booleans and dates
+// are stored as typed cells so they can be used in formulas; the spreadsheet
+// application decides how they're displayed.
+//
+// Rows are streamed to the output, the file is complete once Close returns.
+func NewXLSXWriter(w io.Writer, opts *Options) Writer {
+	name := "Export"
+	if opts != nil && opts.Sheet != "" {
+		name = sheetName(opts.Sheet)
+	}
+	return &xlsxWriter{
+		zip:  zip.NewWriter(w),
+		cols: newColumns(opts),
+		name: name,
+	}
+}
+
+// Sheet names are at most 31 characters and can't contain []:*?/\
+func sheetName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, s)
+	if r := []rune(s); len(r) > 31 {
+		s = string(r[:31])
+	}
+	return s
+}
+
+// Write the fixed parts and the start of the worksheet
+func (w *xlsxWriter) start() error {
+	var name strings.Builder
+	xml.EscapeText(&name, []byte(w.name))
+
+	parts := []struct {
+		path, content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, name.String())},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for _, p := range parts {
+		f, err := w.zip.Create(p.path)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(f, p.content)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Must be the last part: it stays open while rows are written
+	f, err := w.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	w.sheet = bufio.NewWriter(f)
+	w.sheet.WriteString(xlsxSheetStart)
+	return nil
+}
+
+func (w *xlsxWriter) Write(row map[string]interface{}) error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.sheet == nil {
+		w.cols.init(row)
+		w.err = w.start()
+		if w.err != nil {
+			return w.err
+		}
+		w.header()
+	} else if err := w.cols.check(row); err != nil {
+		return err
+	}
+
+	w.rownum++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.rownum)
+	for i, col := range w.cols.names {
+		w.cell(i, row[col])
+	}
+	w.sheet.WriteString(`</row>`)
+	return nil
+}
+
+func (w *xlsxWriter) header() {
+	w.rownum++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.rownum)
+	for i, col := range w.cols.names {
+		w.text(i, col, styleHeader)
+	}
+	w.sheet.WriteString(`</row>`)
+}
+
+func (w *xlsxWriter) cell(col int, val interface{}) {
+	switch v := val.(type) {
+	case nil:
+		return
+	case string:
+		if t, dateOnly, ok := parseDate(v); ok {
+			style := styleDateTime
+			if dateOnly {
+				style = styleDate
+			}
+			w.number(col, serialDate(t), style)
+			return
+		}
+		w.text(col, v, styleDefault)
+	case bool:
+		b := "0"
+		if v {
+			b = "1"
+		}
+		fmt.Fprintf(w.sheet, `<c r="%s" t="b"><v>%s</v></c>`, cellRef(col, w.rownum), b)
+	case float64:
+		w.number(col, strconv.FormatFloat(v, 'f', -1, 64), styleDefault)
+	case json.Number:
+		w.number(col, string(v), styleDefault)
+	case int64:
+		w.number(col, strconv.FormatInt(v, 10), styleDefault)
+	case int:
+		w.number(col, strconv.Itoa(v), styleDefault)
+	case time.Time:
+		w.number(col, serialDate(v), styleDateTime)
+	case ticketmatic.Time:
+		if !v.IsZero() {
+			w.number(col, serialDate(v.Time()), styleDateTime)
+		}
+	case ticketmatic.NullTime:
+		if v.Valid && !v.Time.IsZero() {
+			w.number(col, serialDate(v.Time.Time()), styleDateTime)
+		}
+	case ticketmatic.Money:
+		w.number(col, v.String(), styleDefault)
+	default:
+		data, err := json.Marshal(val)
+		if err == nil {
+			w.text(col, string(data), styleDefault)
+		}
+	}
+}
+
+func (w *xlsxWriter) number(col int, n string, style int) {
+	fmt.Fprintf(w.sheet, `<c r="%s"`, cellRef(col, w.rownum))
+	if style != styleDefault {
+		fmt.Fprintf(w.sheet, ` s="%d"`, style)
+	}
+	fmt.Fprintf(w.sheet, `><v>%s</v></c>`, n)
+}
+
+func (w *xlsxWriter) text(col int, s string, style int) {
+	fmt.Fprintf(w.sheet, `<c r="%s" t="inlineStr"`, cellRef(col, w.rownum))
+	if style != styleDefault {
+		fmt.Fprintf(w.sheet, ` s="%d"`, style)
+	}
+	w.sheet.WriteString(`><is><t xml:space="preserve">`)
+	xml.EscapeText(w.sheet, []byte(s))
+	w.sheet.WriteString(`</t></is></c>`)
+}
+
+func (w *xlsxWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.sheet == nil {
+		// No rows: write an empty sheet (with header if columns are known)
+		err := w.start()
+		if err != nil {
+			return err
+		}
+		if w.cols.fixed {
+			w.header()
+		}
+	}
+
+	w.sheet.WriteString(xlsxSheetEnd)
+	err := w.sheet.Flush()
+	if err != nil {
+		return err
+	}
+	return w.zip.Close()
+}
+
+// Cell reference such as "A1" or "AB12", col is zero-based
+func cellRef(col, row int) string {
+	name := ""
+	for col >= 0 {
+		name = string(rune('A'+col%26)) + name
+		col = col/26 - 1
+	}
+	return name + strconv.Itoa(row)
+}
+
+// Serial date: days since 1899-12-30, using the wall clock time of t
+func serialDate(t time.Time) string {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	days := wall.Sub(excelEpoch).Hours() / 24
+	return strconv.FormatFloat(days, 'f', -1, 64)
+}
@@ -0,0 +1,28 @@
+// Type-safe builder for the filter queries used by the getlist operations.
+//
+// The Filter field of queries such as OrderQuery, EventQuery and ContactQuery
+// holds a query on the public data model that selects the IDs to return. This
+// package builds such queries from composable conditions, quoting all values
+// so user input can't alter the query:
+//
+//	f, err := filter.Orders(
+//		filter.Order.Status.Eq(ticketmatic.OrderStatusConfirmed),
+//		filter.Order.Createdts.Gte(since),
+//		filter.Or(
+//			filter.Order.Saleschannelid.In(1, 2),
+//			filter.CustomField("source").Contains(userinput),
+//		),
+//	).Build()
+//
+//	list, err := orders.Getlist(client, &ticketmatic.OrderQuery{Filter: f})
+//
+// The above results in:
+//
+//	SELECT id FROM tm.order WHERE status = 21002 AND createdts >= '2026-01-01 00:00:00'
+//	AND (saleschannelid IN (1, 2) OR c_source ILIKE '%...%')
+//
+// Timestamps in the public data model have no timezone, they're in the
+// timezone of the account. Set the Location of a Query to the Location of the
+// client to convert timestamps to it, otherwise they are written in
+// time.Local.
+package filter
@@ -0,0 +1,80 @@
+package filter
+
+// Common order fields, for use with Orders
+var Order = struct {
+	Id             Field
+	Code           Field
+	Customerid     Field
+	Saleschannelid Field
+	Status         Field
+	Paymentstatus  Field
+	Deliverystatus Field
+	Totalamount    Field
+	Amountpaid     Field
+	Createdts      Field
+	Lastupdatets   Field
+}{
+	Id:             "id",
+	Code:           "code",
+	Customerid:     "customerid",
+	Saleschannelid: "saleschannelid",
+	Status:         "status",
+	Paymentstatus:  "paymentstatus",
+	Deliverystatus: "deliverystatus",
+	Totalamount:    "totalamount",
+	Amountpaid:     "amountpaid",
+	Createdts:      "createdts",
+	Lastupdatets:   "lastupdatets",
+}
+
+// Common event fields, for use with Events
+var Event = struct {
+	Id            Field
+	Name          Field
+	Subtitle      Field
+	Code          Field
+	Externalcode  Field
+	Currentstatus Field
+	Locationid    Field
+	Productionid  Field
+	Startts       Field
+	Endts         Field
+	Createdts     Field
+	Lastupdatets  Field
+}{
+	Id:            "id",
+	Name:          "name",
+	Subtitle:      "subtitle",
+	Code:          "code",
+	Externalcode:  "externalcode",
+	Currentstatus: "currentstatus",
+	Locationid:    "locationid",
+	Productionid:  "productionid",
+	Startts:       "startts",
+	Endts:         "endts",
+	Createdts:     "createdts",
+	Lastupdatets:  "lastupdatets",
+}
+
+// Common contact fields, for use with Contacts
+var Contact = struct {
+	Id           Field
+	Email        Field
+	Firstname    Field
+	Lastname     Field
+	Company      Field
+	Languagecode Field
+	Birthdate    Field
+	Createdts    Field
+	Lastupdatets Field
+}{
+	Id:           "id",
+	Email:        "email",
+	Firstname:    "firstname",
+	Lastname:     "lastname",
+	Company:      "company",
+	Languagecode: "languagecode",
+	Birthdate:    "birthdate",
+	Createdts:    "createdts",
+	Lastupdatets: "lastupdatets",
+}
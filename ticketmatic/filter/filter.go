@@ -0,0 +1,432 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+var identifier = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// A condition in a filter query
+type Condition interface {
+	// Write the SQL for this condition
+	build(b *builder)
+}
+
+// Builds the SQL for a set of conditions, keeps the first error.
+type builder struct {
+	buf strings.Builder
+	err error
+	loc *time.Location
+}
+
+func (b *builder) write(s string) {
+	b.buf.WriteString(s)
+}
+
+func (b *builder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// A field (column) of a table in the public data model
+type Field string
+
+// Refer to a custom field by its key (without the c_ prefix)
+func CustomField(key string) Field {
+	return Field("c_" + key)
+}
+
+func (f Field) build(b *builder) {
+	if !identifier.MatchString(string(f)) {
+		b.fail(fmt.Errorf("Invalid field name: %q", string(f)))
+		return
+	}
+	b.write(string(f))
+}
+
+// Field equals value
+func (f Field) Eq(value interface{}) Condition {
+	return &comparison{f, "=", value}
+}
+
+// Field differs from value
+func (f Field) Ne(value interface{}) Condition {
+	return &comparison{f, "<>", value}
+}
+
+// Field is less than value
+func (f Field) Lt(value interface{}) Condition {
+	return &comparison{f, "<", value}
+}
+
+// Field is less than or equal to value
+func (f Field) Lte(value interface{}) Condition {
+	return &comparison{f, "<=", value}
+}
+
+// Field is greater than value
+func (f Field) Gt(value interface{}) Condition {
+	return &comparison{f, ">", value}
+}
+
+// Field is greater than or equal to value
+func (f Field) Gte(value interface{}) Condition {
+	return &comparison{f, ">=", value}
+}
+
+// Field lies between min and max (inclusive)
+func (f Field) Between(min, max interface{}) Condition {
+	return And(f.Gte(min), f.Lte(max))
+}
+
+// Field equals one of the values
+func (f Field) In(values ...interface{}) Condition {
+	return &membership{f, false, values}
+}
+
+// Field equals none of the values
+func (f Field) NotIn(values ...interface{}) Condition {
+	return &membership{f, true, values}
+}
+
+// Field contains the text (case insensitive)
+func (f Field) Contains(text string) Condition {
+	return &like{f, "%" + escapeLike(text) + "%"}
+}
+
+// Field starts with the text (case insensitive)
+func (f Field) HasPrefix(text string) Condition {
+	return &like{f, escapeLike(text) + "%"}
+}
+
+// Field is not set
+func (f Field) IsNull() Condition {
+	return &null{f, false}
+}
+
+// Field is set
+func (f Field) NotNull() Condition {
+	return &null{f, true}
+}
+
+type comparison struct {
+	field Field
+	op    string
+	value interface{}
+}
+
+func (c *comparison) build(b *builder) {
+	if c.value == nil && c.op == "=" {
+		(&null{c.field, false}).build(b)
+		return
+	}
+	if c.value == nil && c.op == "<>" {
+		(&null{c.field, true}).build(b)
+		return
+	}
+
+	c.field.build(b)
+	b.write(" " + c.op + " ")
+	b.write(literal(b, c.value))
+}
+
+type membership struct {
+	field  Field
+	not    bool
+	values []interface{}
+}
+
+func (c *membership) build(b *builder) {
+	if len(c.values) == 0 {
+		// Nothing is in an empty set
+		if c.not {
+			b.write("TRUE")
+		} else {
+			b.write("FALSE")
+		}
+		return
+	}
+
+	c.field.build(b)
+	if c.not {
+		b.write(" NOT IN (")
+	} else {
+		b.write(" IN (")
+	}
+	for i, v := range c.values {
+		if i > 0 {
+			b.write(", ")
+		}
+		b.write(literal(b, v))
+	}
+	b.write(")")
+}
+
+type like struct {
+	field   Field
+	pattern string
+}
+
+func (c *like) build(b *builder) {
+	c.field.build(b)
+	b.write(" ILIKE " + literal(b, c.pattern))
+}
+
+type null struct {
+	field Field
+	not   bool
+}
+
+func (c *null) build(b *builder) {
+	c.field.build(b)
+	if c.not {
+		b.write(" IS NOT NULL")
+	} else {
+		b.write(" IS NULL")
+	}
+}
+
+type group struct {
+	op    string
+	conds []Condition
+}
+
+func (g *group) build(b *builder) {
+	if len(g.conds) == 0 {
+		if g.op == "AND" {
+			b.write("TRUE")
+		} else {
+			b.write("FALSE")
+		}
+		return
+	}
+
+	b.write("(")
+	for i, c := range g.conds {
+		if i > 0 {
+			b.write(" " + g.op + " ")
+		}
+		c.build(b)
+	}
+	b.write(")")
+}
+
+// All conditions must hold
+func And(conds ...Condition) Condition {
+	return &group{"AND", conds}
+}
+
+// At least one of the conditions must hold
+func Or(conds ...Condition) Condition {
+	return &group{"OR", conds}
+}
+
+type not struct {
+	cond Condition
+}
+
+func (n *not) build(b *builder) {
+	b.write("NOT (")
+	n.cond.build(b)
+	b.write(")")
+}
+
+// The condition must not hold
+func Not(cond Condition) Condition {
+	return &not{cond}
+}
+
+// A filter query selecting IDs from a table
+type Query struct {
+	// Timezone of the account, timestamps are written in it. Set it to the
+	// Location of the client, defaults to time.Local.
+	Location *time.Location
+
+	table string
+	conds []Condition
+}
+
+// Select the IDs from a table of the public data model, such as
+// "conf.pricetype".
+func From(table string, conds ...Condition) *Query {
+	return &Query{
+		table: table,
+		conds: conds,
+	}
+}
+
+// Filter orders
+func Orders(conds ...Condition) *Query {
+	return From("tm.order", conds...)
+}
+
+// Filter events
+func Events(conds ...Condition) *Query {
+	return From("tm.event", conds...)
+}
+
+// Filter contacts
+func Contacts(conds ...Condition) *Query {
+	return From("tm.contact", conds...)
+}
+
+// Add conditions, all conditions must hold.
+func (q *Query) Where(conds ...Condition) *Query {
+	q.conds = append(q.conds, conds...)
+	return q
+}
+
+// Build the filter query.
+func (q *Query) Build() (string, error) {
+	b := &builder{loc: q.Location}
+	for _, part := range strings.Split(q.table, ".") {
+		if !identifier.MatchString(part) {
+			return "", fmt.Errorf("Invalid table name: %q", q.table)
+		}
+	}
+
+	b.write("SELECT id FROM " + q.table)
+	for i, c := range q.conds {
+		if i == 0 {
+			b.write(" WHERE ")
+		} else {
+			b.write(" AND ")
+		}
+		c.build(b)
+	}
+	if b.err != nil {
+		return "", b.err
+	}
+	return b.buf.String(), nil
+}
+
+// Build the filter query, panic if it fails.
+func (q *Query) MustBuild() string {
+	s, err := q.Build()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func (q *Query) String() string {
+	s, err := q.Build()
+	if err != nil {
+		return fmt.Sprintf("<invalid filter: %s>", err)
+	}
+	return s
+}
+
+const timeFormat = "2006-01-02 15:04:05"
+
+// Quote a value as an SQL literal, for use in queries on the public data
+// model. Supports strings, booleans, numbers (including enums and Money),
+// timestamps and nil. Timestamps are written in time.Local, zero timestamps
+// become NULL.
+func Literal(value interface{}) (string, error) {
+	return LiteralIn(value, nil)
+}
+
+// Quote a value as an SQL literal, writing timestamps in loc (the timezone of
+// the account). Timestamps without timezone are written as is.
+func LiteralIn(value interface{}, loc *time.Location) (string, error) {
+	b := &builder{loc: loc}
+	s := literal(b, value)
+	if b.err != nil {
+		return "", b.err
+	}
+	return s, nil
+}
+
+// Quote a value as an SQL literal
+func literal(b *builder, value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quote(b, v)
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case time.Time:
+		return timestamp(b, ticketmatic.NewTime(v))
+	case ticketmatic.Time:
+		return timestamp(b, v)
+	case ticketmatic.Money:
+		return v.String()
+	case fmt.Stringer:
+		// Enums are Stringers as well, so handle numbers first
+		if n, ok := number(value); ok {
+			return n
+		}
+		return quote(b, v.String())
+	}
+
+	if n, ok := number(value); ok {
+		return n
+	}
+	b.fail(fmt.Errorf("Unsupported filter value: %#v", value))
+	return "NULL"
+}
+
+// The public data model has timestamps without timezone, in the timezone of
+// the account.
+func timestamp(b *builder, t ticketmatic.Time) string {
+	if t.IsZero() {
+		return "NULL"
+	}
+	loc := b.loc
+	if loc == nil {
+		loc = time.Local
+	}
+	return quote(b, t.In(loc).Time().Format(timeFormat))
+}
+
+func number(value interface{}) (string, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", false
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// Quote a string. Strings with backslashes are written as escape strings
+// (E'...') with the backslashes doubled, so they can't end the string
+// regardless of how the server treats backslashes in plain strings.
+func quote(b *builder, s string) string {
+	if strings.ContainsRune(s, 0) {
+		b.fail(errors.New("Filter values can't contain NUL characters"))
+		return "NULL"
+	}
+	s = strings.Replace(s, "'", "''", -1)
+	if strings.ContainsRune(s, '\\') {
+		return "E'" + strings.Replace(s, `\`, `\\`, -1) + "'"
+	}
+	return "'" + s + "'"
+}
+
+func escapeLike(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return r.Replace(s)
+}
@@ -0,0 +1,110 @@
+package filter
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestBuild(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	testcases := []struct {
+		query    *Query
+		expected string
+	}{
+		{
+			Orders(),
+			"SELECT id FROM tm.order",
+		},
+		{
+			Orders(
+				Order.Status.Eq(ticketmatic.OrderStatusConfirmed),
+				Order.Createdts.Gte(since),
+			),
+			"SELECT id FROM tm.order WHERE status = 21002 AND createdts >= '2026-01-01 00:00:00'",
+		},
+		{
+			Events(Or(Event.Locationid.In(1, 2), Not(Event.Name.IsNull()))),
+			"SELECT id FROM tm.event WHERE (locationid IN (1, 2) OR NOT (name IS NULL))",
+		},
+		{
+			Contacts(Contact.Lastname.Eq("O'Brien'; DELETE FROM tm.contact; --")),
+			"SELECT id FROM tm.contact WHERE lastname = 'O''Brien''; DELETE FROM tm.contact; --'",
+		},
+		{
+			Contacts(CustomField("source").Contains("50%_off")),
+			`SELECT id FROM tm.contact WHERE c_source ILIKE E'%50\\%\\_off%'`,
+		},
+		{
+			From("conf.pricetype").Where(Field("typeid").NotIn(), Field("name").HasPrefix("VIP")),
+			"SELECT id FROM conf.pricetype WHERE TRUE AND name ILIKE 'VIP%'",
+		},
+		{
+			Orders(Order.Totalamount.Between(ticketmatic.MustParseMoney("10.5"), 20), Order.Customerid.Ne(nil)),
+			"SELECT id FROM tm.order WHERE (totalamount >= 10.50 AND totalamount <= 20) AND customerid IS NOT NULL",
+		},
+	}
+	for _, tc := range testcases {
+		tc.query.Location = time.UTC
+		got, err := tc.query.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.expected {
+			t.Errorf("Unexpected filter, got %#v, expected %#v", got, tc.expected)
+		}
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	brussels, err := time.LoadLocation("Europe/Brussels")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var floating ticketmatic.Time
+	err = floating.UnmarshalText([]byte("2026-06-01 10:00:00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := []struct {
+		value    interface{}
+		expected string
+	}{
+		{"a\\' OR 1=1 --", `E'a\\'' OR 1=1 --'`},
+		{`C:\temp`, `E'C:\\temp'`},
+		{time.Time{}, "NULL"},
+		{ticketmatic.Time{}, "NULL"},
+		{time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC), "'2026-06-01 10:00:00'"},
+		{ticketmatic.NewTime(time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)), "'2026-01-01 09:00:00'"},
+		{floating, "'2026-06-01 10:00:00'"},
+	}
+	for _, tc := range testcases {
+		got, err := LiteralIn(tc.value, brussels)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.expected {
+			t.Errorf("Unexpected literal, got %#v, expected %#v", got, tc.expected)
+		}
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	testcases := []*Query{
+		Orders(Field("id; DROP TABLE").Eq(1)),
+		Orders(CustomField("a b").Eq(1)),
+		Orders(Order.Id.Eq([]int{1})),
+		Orders(Order.Totalamount.Eq(math.NaN())),
+		Orders(Order.Code.Eq("a\x00b")),
+		From("tm.order; --"),
+	}
+	for _, q := range testcases {
+		_, err := q.Build()
+		if err == nil {
+			t.Errorf("Expected an error for %s", q)
+		}
+	}
+}
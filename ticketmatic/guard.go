@@ -0,0 +1,166 @@
+package ticketmatic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// How a Guard handles mutating requests
+type GuardMode int
+
+const (
+	// Send mutating requests
+	GuardModeAllow GuardMode = iota
+
+	// Refuse mutating requests with a *GuardError
+	GuardModeRefuse
+
+	// Log mutating requests instead of sending them. The call succeeds with
+	// an empty result.
+	GuardModeDryRun
+)
+
+// POST requests that don't change anything
+var readOnlyRoutes = map[string]bool{
+	"/{accountname}/tools/queries":                         true,
+	"/{accountname}/tools/queries/export":                  true,
+	"/{accountname}/settings/system/fielddefinitions/data": true,
+}
+
+// A request that changes the account
+type Mutation struct {
+	Method string
+
+	// Route and its parameters: "/{accountname}/events/{id}/purge"
+	Route  string
+	Params map[string]interface{}
+
+	// Request URL
+	URL string
+
+	// Request body, nil if there is none
+	Body interface{}
+
+	// Whether the request deletes data: deletes and purges
+	Destructive bool
+}
+
+func (m *Mutation) String() string {
+	return fmt.Sprintf("%s %s", m.Method, m.URL)
+}
+
+// Guards against requests that change an account, for instance to avoid
+// running a script against production by accident. Set as Client.Guard:
+//
+//	client.Guard = &ticketmatic.Guard{
+//		Mode: ticketmatic.GuardModeDryRun,
+//		Log:  os.Stderr,
+//	}
+//
+// Requests that only read data are always sent.
+type Guard struct {
+	Mode GuardMode
+
+	// Receives a line per refused or simulated request (optional)
+	Log io.Writer
+
+	// Decides whether destructive requests are allowed on the account of
+	// the client, nil allows them on all accounts (see tools.AllowAccounts)
+	AllowDestructive func(c *Client) (bool, error)
+
+	// Asked before sending a mutating request, the request is refused when
+	// it returns false (optional). Useful for interactive tools.
+	Confirm func(m *Mutation) (bool, error)
+}
+
+// A request refused by a Guard
+type GuardError struct {
+	Mutation *Mutation
+	Reason   string
+}
+
+func (e *GuardError) Error() string {
+	return fmt.Sprintf("Refused %s: %s", e.Mutation, e.Reason)
+}
+
+// Returned by check for requests that shouldn't be sent
+var errDryRun = errors.New("Dry run")
+
+// Check a request before it is sent. Returns errDryRun for requests that
+// should be simulated.
+func (g *Guard) check(c *Client, m *Mutation) error {
+	if m.Method == "GET" || (m.Method == "POST" && readOnlyRoutes[m.Route]) {
+		return nil
+	}
+	m.Destructive = m.Method == "DELETE" || strings.HasSuffix(m.Route, "/purge")
+
+	if g.Mode == GuardModeRefuse {
+		return g.refuse(m, "mutating requests are not allowed")
+	}
+	if m.Destructive && g.AllowDestructive != nil {
+		ok, err := g.AllowDestructive(c)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return g.refuse(m, fmt.Sprintf("destructive requests are not allowed on account %s", c.AccountCode))
+		}
+	}
+	if g.Mode == GuardModeDryRun {
+		g.logf("Dry run: %s%s\n", m, payload(m.Body))
+		return errDryRun
+	}
+	if g.Confirm != nil {
+		ok, err := g.Confirm(m)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return g.refuse(m, "not confirmed")
+		}
+	}
+	return nil
+}
+
+func (g *Guard) refuse(m *Mutation, reason string) error {
+	err := &GuardError{
+		Mutation: m,
+		Reason:   reason,
+	}
+	g.logf("%s\n", err)
+	return err
+}
+
+func (g *Guard) logf(format string, args ...interface{}) {
+	if g.Log != nil {
+		fmt.Fprintf(g.Log, format, args...)
+	}
+}
+
+// Body of a request for the log, prefixed with a space
+func payload(body interface{}) string {
+	switch b := body.(type) {
+	case nil:
+		return ""
+	case string:
+		return " " + b
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return ""
+	}
+	return " " + string(data)
+}
+
+// Response of a simulated request: empty
+func dryRunResponse() *http.Response {
+	return &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}
+}
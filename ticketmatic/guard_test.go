@@ -0,0 +1,134 @@
+package ticketmatic
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGuard(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Write([]byte(`{"id":12}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("test", "key", "secret")
+	c.Server = srv.URL
+
+	run := func(method, url string, body interface{}) (map[string]interface{}, error) {
+		req := c.NewRequest(method, url, "json")
+		req.UrlParameters(map[string]interface{}{"id": 12})
+		if body != nil {
+			req.Body(body, "json")
+		}
+		var obj map[string]interface{}
+		err := req.Run(&obj)
+		return obj, err
+	}
+
+	// Refuse
+	var log bytes.Buffer
+	c.Guard = &Guard{Mode: GuardModeRefuse, Log: &log}
+	_, err := run("GET", "/{accountname}/events/{id}", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = run("POST", "/{accountname}/tools/queries", map[string]string{"query": "SELECT 1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = run("PUT", "/{accountname}/events/{id}", map[string]string{"name": "Test"})
+	if e, ok := err.(*GuardError); !ok || e.Mutation.Method != "PUT" || e.Mutation.Destructive {
+		t.Errorf("Unexpected error, got %#v", err)
+	}
+	if len(requests) != 2 {
+		t.Errorf("Unexpected requests, got %#v", requests)
+	}
+	if !strings.Contains(log.String(), "Refused PUT "+srv.URL+"/api/1/test/events/12") {
+		t.Errorf("Unexpected log, got %q", log.String())
+	}
+
+	// Dry run
+	log.Reset()
+	requests = nil
+	c.Guard = &Guard{Mode: GuardModeDryRun, Log: &log}
+	obj, err := run("POST", "/{accountname}/orders", map[string]int{"saleschannelid": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj != nil || len(requests) != 0 {
+		t.Errorf("Unexpected result, got %#v after %#v", obj, requests)
+	}
+	expected := "Dry run: POST " + srv.URL + "/api/1/test/orders {\"saleschannelid\":1}\n"
+	if log.String() != expected {
+		t.Errorf("Unexpected log, got %q, expected %q", log.String(), expected)
+	}
+	req := c.NewRequest("POST", "/{accountname}/orders/batch", "json")
+	s, err := req.Stream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Next(&obj)
+	if err != io.EOF {
+		t.Errorf("Unexpected stream result, got %#v", err)
+	}
+	s.Close()
+
+	// Destructive requests and confirmation
+	var allowed bool
+	var confirmed []*Mutation
+	c.Guard = &Guard{
+		AllowDestructive: func(c *Client) (bool, error) {
+			return allowed, nil
+		},
+		Confirm: func(m *Mutation) (bool, error) {
+			confirmed = append(confirmed, m)
+			return m.Method != "POST", nil
+		},
+	}
+	requests = nil
+	_, err = run("PUT", "/{accountname}/events/{id}/purge", nil)
+	if e, ok := err.(*GuardError); !ok || !e.Mutation.Destructive {
+		t.Errorf("Unexpected error, got %#v", err)
+	}
+	_, err = run("DELETE", "/{accountname}/settings/pricing/pricetypes/{id}", nil)
+	if _, ok := err.(*GuardError); !ok {
+		t.Errorf("Unexpected error, got %#v", err)
+	}
+	_, err = run("POST", "/{accountname}/orders", nil)
+	if _, ok := err.(*GuardError); !ok {
+		t.Errorf("Unexpected error, got %#v", err)
+	}
+	allowed = true
+	_, err = run("PUT", "/{accountname}/events/{id}/purge", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 || requests[0] != "PUT /api/1/test/events/12/purge" {
+		t.Errorf("Unexpected requests, got %#v", requests)
+	}
+	if len(confirmed) != 2 || confirmed[1].Route != "/{accountname}/events/{id}/purge" || confirmed[1].Params["id"] != 12 {
+		t.Errorf("Unexpected confirmations, got %#v", confirmed)
+	}
+
+	// Kept by WithContext
+	if c.WithContext(context.Background()).Guard != c.Guard {
+		t.Error("Expected the guard to be kept")
+	}
+
+	// Read-only profiles
+	p := &Profile{Accountcode: "test", Accesskey: "key", Secretkey: "secret", Readonly: true}
+	c, err = p.NewClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Guard == nil || c.Guard.Mode != GuardModeRefuse {
+		t.Errorf("Unexpected guard, got %#v", c.Guard)
+	}
+}
@@ -0,0 +1,25 @@
+// Helpers for importing historic contacts and orders with pre-assigned IDs.
+//
+// Importing with explicit IDs is only possible when those IDs fall in a reserved
+// range (see contacts.Reserve and orders.Reserve). An IDPool reserves such a range
+// up front and hands out IDs to concurrent workers, keyed by a reference from the
+// source system. Because the contact pool is shared with the order import, an
+// imported order can refer to a contact imported in the same run:
+//
+//	contactpool, _ := importer.NewContactPool(client, importer.NewFileStore("contacts.json"))
+//	orderpool, _ := importer.NewOrderPool(client, importer.NewFileStore("orders.json"))
+//
+//	imp := importer.New(client, contactpool, orderpool)
+//	imp.ImportContacts([]*importer.ContactRecord{
+//		{Key: "crm-1", Contact: &ticketmatic.Contact{Firstname: "Alice"}},
+//	})
+//	imp.ImportOrders([]*importer.OrderRecord{
+//		{Key: "sale-1", Customerkey: "crm-1", Order: order},
+//	})
+//
+// The pool state (which keys received which ID and which IDs were imported) is
+// persisted in a Store, so an interrupted import can be resumed without handing
+// out the same ID twice. The state is saved before each import run and after
+// each imported batch. When using a pool directly, call Save before using the
+// IDs it handed out.
+package importer
@@ -0,0 +1,273 @@
+package importer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/contacts"
+	"github.com/ticketmatic/tm-go/ticketmatic/orders"
+)
+
+// Maximum number of records per import call
+const (
+	ContactBatchSize = 1000
+	OrderBatchSize   = 100
+)
+
+// A contact to import
+type ContactRecord struct {
+	// Reference to the contact in the source system
+	Key string
+
+	// Contact data, the ID is assigned from the contact pool
+	Contact *ticketmatic.Contact
+}
+
+// An order to import
+type OrderRecord struct {
+	// Reference to the order in the source system
+	Key string
+
+	// Reference to the customer, resolved through the contact pool
+	Customerkey string
+
+	// References to the ticket holders, one for each ticket in the order.
+	// Empty references are left untouched.
+	Ticketholderkeys []string
+
+	// Order data, the ID is assigned from the order pool
+	Order *ticketmatic.ImportOrder
+}
+
+// Imports contacts and orders with IDs taken from reservation pools
+type Importer struct {
+	// Number of batches that are imported concurrently, defaults to 1
+	Workers int
+
+	client   *ticketmatic.Client
+	contacts *IDPool
+	orders   *IDPool
+}
+
+// Create a new importer. Either pool may be nil when only one type of record
+// is imported.
+func New(client *ticketmatic.Client, contacts, orders *IDPool) *Importer {
+	return &Importer{
+		Workers:  1,
+		client:   client,
+		contacts: contacts,
+		orders:   orders,
+	}
+}
+
+// Import contacts.
+//
+// Returns the import status of each record, in the same order as the records.
+// Records that were imported in an earlier run are skipped and reported as
+// succeeded.
+func (imp *Importer) ImportContacts(records []*ContactRecord) ([]*ticketmatic.ContactImportStatus, error) {
+	if imp.contacts == nil {
+		return nil, fmt.Errorf("No contact pool configured")
+	}
+
+	result := make([]*ticketmatic.ContactImportStatus, len(records))
+	pending := make([]int, 0, len(records))
+	for i, rec := range records {
+		id, err := imp.contacts.ID(rec.Key)
+		if err != nil {
+			return nil, err
+		}
+		if imp.contacts.Used(id) {
+			result[i] = &ticketmatic.ContactImportStatus{Id: id, Ok: true}
+			continue
+		}
+		rec.Contact.Id = id
+		pending = append(pending, i)
+	}
+
+	// Persist the assigned IDs before they are used
+	err := imp.contacts.Save()
+	if err != nil {
+		return nil, err
+	}
+
+	err = imp.run(pending, ContactBatchSize, func(batch []int) error {
+		data := make([]*ticketmatic.Contact, len(batch))
+		for j, i := range batch {
+			data[j] = records[i].Contact
+		}
+
+		status, err := contacts.Import(imp.client, data)
+		if err != nil {
+			return err
+		}
+		if len(status) != len(batch) {
+			return fmt.Errorf("Unexpected number of import results: got %d, expected %d", len(status), len(batch))
+		}
+
+		used := make([]int64, 0, len(status))
+		for j, i := range batch {
+			result[i] = status[j]
+			if status[j].Ok {
+				used = append(used, data[j].Id)
+			}
+		}
+		return imp.contacts.MarkUsed(used...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Import orders.
+//
+// Customer and ticket holder references are resolved through the contact
+// pool, which means the contacts can be part of the same import run.
+//
+// Returns the import status of each record, in the same order as the records.
+// Records that were imported in an earlier run are skipped and reported as
+// succeeded.
+func (imp *Importer) ImportOrders(records []*OrderRecord) ([]*ticketmatic.OrderImportStatus, error) {
+	if imp.orders == nil {
+		return nil, fmt.Errorf("No order pool configured")
+	}
+
+	result := make([]*ticketmatic.OrderImportStatus, len(records))
+	pending := make([]int, 0, len(records))
+	for i, rec := range records {
+		id, err := imp.orders.ID(rec.Key)
+		if err != nil {
+			return nil, err
+		}
+		if imp.orders.Used(id) {
+			result[i] = &ticketmatic.OrderImportStatus{Id: id, Ok: true}
+			continue
+		}
+		rec.Order.Orderid = id
+
+		err = imp.resolveContacts(rec)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, i)
+	}
+
+	// Persist the assigned IDs before they are used
+	err := imp.orders.Save()
+	if err != nil {
+		return nil, err
+	}
+	if imp.contacts != nil {
+		err = imp.contacts.Save()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = imp.run(pending, OrderBatchSize, func(batch []int) error {
+		data := make([]*ticketmatic.ImportOrder, len(batch))
+		for j, i := range batch {
+			data[j] = records[i].Order
+		}
+
+		status, err := orders.Import(imp.client, data)
+		if err != nil {
+			return err
+		}
+		if len(status) != len(batch) {
+			return fmt.Errorf("Unexpected number of import results: got %d, expected %d", len(status), len(batch))
+		}
+
+		used := make([]int64, 0, len(status))
+		for j, i := range batch {
+			result[i] = status[j]
+			if status[j].Ok {
+				used = append(used, data[j].Orderid)
+			}
+		}
+		return imp.orders.MarkUsed(used...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (imp *Importer) resolveContacts(rec *OrderRecord) error {
+	if rec.Customerkey == "" && len(rec.Ticketholderkeys) == 0 {
+		return nil
+	}
+	if imp.contacts == nil {
+		return fmt.Errorf("Order %s refers to contacts, but no contact pool configured", rec.Key)
+	}
+	if len(rec.Ticketholderkeys) > len(rec.Order.Tickets) {
+		return fmt.Errorf("Order %s has more ticket holders than tickets", rec.Key)
+	}
+
+	if rec.Customerkey != "" {
+		id, err := imp.contacts.ID(rec.Customerkey)
+		if err != nil {
+			return err
+		}
+		rec.Order.Customerid = id
+	}
+
+	for i, key := range rec.Ticketholderkeys {
+		if key == "" {
+			continue
+		}
+		id, err := imp.contacts.ID(key)
+		if err != nil {
+			return err
+		}
+		rec.Order.Tickets[i].Ticketholderid = id
+	}
+	return nil
+}
+
+// Split the indexes in batches and process them with the configured number of
+// workers. Returns the first error that occurred.
+func (imp *Importer) run(indexes []int, size int, fn func(batch []int) error) error {
+	workers := imp.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	batches := make(chan []int)
+	go func() {
+		for start := 0; start < len(indexes); start += size {
+			end := start + size
+			if end > len(indexes) {
+				end = len(indexes)
+			}
+			batches <- indexes[start:end]
+		}
+		close(batches)
+	}()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firsterr error
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				err := fn(batch)
+				if err != nil {
+					mu.Lock()
+					if firsterr == nil {
+						firsterr = err
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return firsterr
+}
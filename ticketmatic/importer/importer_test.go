@@ -0,0 +1,103 @@
+package importer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestImportCrossReference(t *testing.T) {
+	var imported []*ticketmatic.ImportOrder
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/test/contacts/import":
+			var data []*ticketmatic.Contact
+			json.NewDecoder(r.Body).Decode(&data)
+			status := make([]*ticketmatic.ContactImportStatus, len(data))
+			for i, c := range data {
+				status[i] = &ticketmatic.ContactImportStatus{Id: c.Id, Ok: true}
+			}
+			json.NewEncoder(w).Encode(status)
+		case "/api/1/test/orders/import":
+			var data []*ticketmatic.ImportOrder
+			json.NewDecoder(r.Body).Decode(&data)
+			imported = append(imported, data...)
+			status := make([]*ticketmatic.OrderImportStatus, len(data))
+			for i, o := range data {
+				status[i] = &ticketmatic.OrderImportStatus{Id: o.Orderid, Ok: o.Customerid != 0}
+			}
+			json.NewEncoder(w).Encode(status)
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer srv.Close()
+
+	server := ticketmatic.Server
+	ticketmatic.Server = srv.URL
+	defer func() { ticketmatic.Server = server }()
+
+	c := ticketmatic.NewClient("test", "key", "secret")
+	reserve := func(id int64) (int64, error) { return id, nil }
+	contactpool, _ := NewPool(reserve, 1000, nil)
+	orderpool, _ := NewPool(reserve, 5000, nil)
+	imp := New(c, contactpool, orderpool)
+
+	cstatus, err := imp.ImportContacts([]*ContactRecord{
+		{Key: "c1", Contact: &ticketmatic.Contact{Firstname: "Alice"}},
+		{Key: "c2", Contact: &ticketmatic.Contact{Firstname: "Bob"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cstatus[1].Id != 1001 {
+		t.Errorf("Unexpected cstatus[1].Id, got %#v, expected %#v", cstatus[1].Id, 1001)
+	}
+
+	ostatus, err := imp.ImportOrders([]*OrderRecord{
+		{
+			Key:              "o1",
+			Customerkey:      "c2",
+			Ticketholderkeys: []string{"", "c1"},
+			Order: &ticketmatic.ImportOrder{
+				Tickets: []*ticketmatic.ImportTicket{{}, {}},
+			},
+		},
+		{Key: "o2", Order: &ticketmatic.ImportOrder{}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ostatus[0].Ok || ostatus[1].Ok {
+		t.Errorf("Unexpected order status, got %#v and %#v", ostatus[0].Ok, ostatus[1].Ok)
+	}
+	if imported[0].Orderid != 5000 {
+		t.Errorf("Unexpected imported[0].Orderid, got %#v, expected %#v", imported[0].Orderid, 5000)
+	}
+	if imported[0].Customerid != 1001 {
+		t.Errorf("Unexpected imported[0].Customerid, got %#v, expected %#v", imported[0].Customerid, 1001)
+	}
+	if imported[0].Tickets[0].Ticketholderid != 0 || imported[0].Tickets[1].Ticketholderid != 1000 {
+		t.Errorf("Unexpected ticket holders, got %#v and %#v", imported[0].Tickets[0].Ticketholderid, imported[0].Tickets[1].Ticketholderid)
+	}
+
+	// Running again only retries the failed order
+	imported = nil
+	ostatus, err = imp.ImportOrders([]*OrderRecord{
+		{Key: "o1", Customerkey: "c2", Order: &ticketmatic.ImportOrder{}},
+		{Key: "o2", Customerkey: "c1", Order: &ticketmatic.ImportOrder{}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported) != 1 || imported[0].Orderid != 5001 {
+		t.Errorf("Unexpected retry, got %#v", imported)
+	}
+	if !ostatus[0].Ok || !ostatus[1].Ok {
+		t.Errorf("Unexpected order status, got %#v and %#v", ostatus[0].Ok, ostatus[1].Ok)
+	}
+}
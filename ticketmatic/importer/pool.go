@@ -0,0 +1,328 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/contacts"
+	"github.com/ticketmatic/tm-go/ticketmatic/orders"
+	"github.com/ticketmatic/tm-go/ticketmatic/tools"
+)
+
+// Default number of IDs that are reserved at once
+const DefaultChunk = 1000
+
+// Reserves all unused IDs lower than or equal to id, returns the maximum
+// reserved ID.
+type ReserveFunc func(id int64) (int64, error)
+
+// Pool state, as persisted in a Store
+type PoolState struct {
+	// Next ID that will be handed out
+	Next int64 `json:"next"`
+
+	// Highest reserved ID
+	Max int64 `json:"max"`
+
+	// IDs assigned to source references
+	Assigned map[string]int64 `json:"assigned"`
+
+	// IDs that were imported successfully
+	Used map[int64]bool `json:"used"`
+}
+
+// Persistent storage for the pool state
+type Store interface {
+	// Load the stored state, returns nil if nothing was stored yet.
+	Load() (*PoolState, error)
+
+	// Save the state
+	Save(state *PoolState) error
+}
+
+// Store that keeps the pool state in a JSON file
+type FileStore struct {
+	path string
+}
+
+func NewFileStore(path string) *FileStore {
+	return &FileStore{
+		path: path,
+	}
+}
+
+func (s *FileStore) Load() (*PoolState, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state *PoolState
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read pool state %s: %s", s.path, err)
+	}
+	return state, nil
+}
+
+func (s *FileStore) Save(state *PoolState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, to avoid a corrupt state file when
+	// we get interrupted.
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Close()
+	} else {
+		tmp.Close()
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// A pool of reserved IDs. It is safe for concurrent use.
+//
+// IDs are reserved in chunks whenever the pool runs out of reserved IDs.
+//
+// The state is written to the store on checkpoints rather than for every ID:
+// by MarkUsed and Save. Call Save before using the handed out IDs, the
+// Importer does so before each import run.
+type IDPool struct {
+	// Number of IDs to reserve at once, defaults to DefaultChunk
+	Chunk int64
+
+	mu      sync.Mutex
+	reserve ReserveFunc
+	store   Store
+	state   *PoolState
+	dirty   bool
+}
+
+// Create a new pool that hands out IDs starting from start.
+//
+// If the store (which may be nil) holds a previous state, the pool continues
+// from there and start is ignored.
+func NewPool(reserve ReserveFunc, start int64, store Store) (*IDPool, error) {
+	return newPool(reserve, func() (int64, error) { return start, nil }, store)
+}
+
+// Create a pool of contact IDs, starting after the highest reserved or
+// existing contact ID.
+func NewContactPool(client *ticketmatic.Client, store Store) (*IDPool, error) {
+	reserve := func(id int64) (int64, error) {
+		r, err := contacts.Reserve(client, &ticketmatic.ContactIdReservation{Id: id})
+		if err != nil {
+			return 0, err
+		}
+		return r.Id, nil
+	}
+	return newPool(reserve, poolStart(client, "tm.contact", reserve), store)
+}
+
+// Create a pool of order IDs, starting after the highest reserved or existing
+// order ID.
+func NewOrderPool(client *ticketmatic.Client, store Store) (*IDPool, error) {
+	reserve := func(id int64) (int64, error) {
+		r, err := orders.Reserve(client, &ticketmatic.OrderIdReservation{Id: id})
+		if err != nil {
+			return 0, err
+		}
+		return r.Id, nil
+	}
+	return newPool(reserve, poolStart(client, "tm.order", reserve), store)
+}
+
+// Start a pool after the highest ID that is either reserved or in use in a
+// table of the public data model.
+//
+// A reservation of ID 0 reserves nothing and returns the highest reserved ID,
+// but the API isn't guaranteed to do more than echo the requested ID, so the
+// highest ID in use is queried as well.
+func poolStart(client *ticketmatic.Client, table string, reserve ReserveFunc) func() (int64, error) {
+	return func() (int64, error) {
+		reserved, err := reserve(0)
+		if err != nil {
+			return 0, err
+		}
+		used, err := maxID(client, table)
+		if err != nil {
+			return 0, err
+		}
+		if used > reserved {
+			return used + 1, nil
+		}
+		return reserved + 1, nil
+	}
+}
+
+// Determine the highest ID in use in a table of the public data model, 0 when
+// the table is empty.
+func maxID(client *ticketmatic.Client, table string) (int64, error) {
+	res, err := tools.Queries(client, &ticketmatic.QueryRequest{
+		Query: fmt.Sprintf("SELECT max(id) AS id FROM %s", table),
+		Limit: 1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(res.Results) == 0 || res.Results[0]["id"] == nil {
+		return 0, nil
+	}
+
+	max, ok := res.Results[0]["id"].(float64)
+	if !ok {
+		return 0, fmt.Errorf("Unexpected max(id) for %s: %v", table, res.Results[0]["id"])
+	}
+	return int64(max), nil
+}
+
+func newPool(reserve ReserveFunc, start func() (int64, error), store Store) (*IDPool, error) {
+	var state *PoolState
+	if store != nil {
+		s, err := store.Load()
+		if err != nil {
+			return nil, err
+		}
+		state = s
+	}
+
+	if state == nil {
+		next, err := start()
+		if err != nil {
+			return nil, err
+		}
+		state = &PoolState{
+			Next: next,
+			Max:  next - 1,
+		}
+	}
+	if state.Assigned == nil {
+		state.Assigned = make(map[string]int64)
+	}
+	if state.Used == nil {
+		state.Used = make(map[int64]bool)
+	}
+
+	return &IDPool{
+		Chunk:   DefaultChunk,
+		reserve: reserve,
+		store:   store,
+		state:   state,
+	}, nil
+}
+
+// Get the ID for the given source reference. A new ID is taken from the pool
+// when the reference has not been seen before.
+func (p *IDPool) ID(key string) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if id, ok := p.state.Assigned[key]; ok {
+		return id, nil
+	}
+
+	id, err := p.take()
+	if err != nil {
+		return 0, err
+	}
+	p.state.Assigned[key] = id
+	return id, nil
+}
+
+// Take an ID that isn't linked to a source reference.
+func (p *IDPool) Take() (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.take()
+}
+
+// Look up the ID assigned to a source reference, without assigning one.
+func (p *IDPool) Lookup(key string) (int64, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	id, ok := p.state.Assigned[key]
+	return id, ok
+}
+
+// Record that the given IDs were imported.
+func (p *IDPool) MarkUsed(ids ...int64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, id := range ids {
+		p.state.Used[id] = true
+	}
+	p.dirty = true
+	return p.save()
+}
+
+// Whether the given ID was imported.
+func (p *IDPool) Used(id int64) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.state.Used[id]
+}
+
+func (p *IDPool) take() (int64, error) {
+	if p.state.Next > p.state.Max {
+		chunk := p.Chunk
+		if chunk <= 0 {
+			chunk = DefaultChunk
+		}
+
+		max, err := p.reserve(p.state.Next + chunk - 1)
+		if err != nil {
+			return 0, err
+		}
+		if max < p.state.Next {
+			return 0, fmt.Errorf("Reservation up to %d does not cover ID %d", max, p.state.Next)
+		}
+		p.state.Max = max
+	}
+
+	id := p.state.Next
+	p.state.Next++
+	p.dirty = true
+	return id, nil
+}
+
+// Write the state to the store, if it changed since the last save.
+func (p *IDPool) Save() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.save()
+}
+
+func (p *IDPool) save() error {
+	if p.store == nil || !p.dirty {
+		return nil
+	}
+	err := p.store.Save(p.state)
+	if err != nil {
+		return err
+	}
+	p.dirty = false
+	return nil
+}
@@ -0,0 +1,250 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestPoolConcurrent(t *testing.T) {
+	var reservations []int64
+	reserve := func(id int64) (int64, error) {
+		reservations = append(reservations, id)
+		return id, nil
+	}
+
+	pool, err := NewPool(reserve, 100, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool.Chunk = 10
+
+	var mu sync.Mutex
+	seen := make(map[int64]bool)
+	var wg sync.WaitGroup
+	for i := 0; i < 25; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := pool.Take()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if seen[id] {
+				t.Errorf("ID %d handed out twice", id)
+			}
+			seen[id] = true
+		}()
+	}
+	wg.Wait()
+
+	for id := int64(100); id < 125; id++ {
+		if !seen[id] {
+			t.Errorf("Expected ID %d to be handed out", id)
+		}
+	}
+	if len(reservations) != 3 {
+		t.Errorf("Unexpected number of reservations, got %#v, expected %#v", len(reservations), 3)
+	}
+	if reservations[2] != 129 {
+		t.Errorf("Unexpected reservation, got %#v, expected %#v", reservations[2], 129)
+	}
+}
+
+func TestPoolKeys(t *testing.T) {
+	reserve := func(id int64) (int64, error) { return id, nil }
+
+	pool, err := NewPool(reserve, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, _ := pool.ID("a")
+	b, _ := pool.ID("b")
+	a2, _ := pool.ID("a")
+	if a != a2 {
+		t.Errorf("Unexpected ID for a, got %#v, expected %#v", a2, a)
+	}
+	if a == b {
+		t.Errorf("Expected different IDs for a and b, got %#v", a)
+	}
+
+	if _, ok := pool.Lookup("c"); ok {
+		t.Errorf("Unexpected ID for unknown key c")
+	}
+}
+
+func TestPoolShortReservation(t *testing.T) {
+	reserve := func(id int64) (int64, error) { return 5, nil }
+
+	pool, err := NewPool(reserve, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = pool.Take()
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestPoolResume(t *testing.T) {
+	reserve := func(id int64) (int64, error) { return id, nil }
+	store := NewFileStore(filepath.Join(t.TempDir(), "pool.json"))
+
+	pool, err := NewPool(reserve, 1, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := pool.ID("a")
+	b, _ := pool.ID("b")
+	err = pool.MarkUsed(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Start should be ignored, state is loaded from the store
+	pool2, err := NewPool(reserve, 500, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2, _ := pool2.ID("b")
+	if b2 != b {
+		t.Errorf("Unexpected ID for b, got %#v, expected %#v", b2, b)
+	}
+	if !pool2.Used(a) {
+		t.Errorf("Expected ID %d to be used", a)
+	}
+	if pool2.Used(b) {
+		t.Errorf("Expected ID %d to be unused", b)
+	}
+	c, _ := pool2.ID("c")
+	if c != 3 {
+		t.Errorf("Unexpected ID for c, got %#v, expected %#v", c, 3)
+	}
+}
+
+// Counts the saves of a state
+type countingStore struct {
+	saves int
+}
+
+func (s *countingStore) Load() (*PoolState, error) {
+	return nil, nil
+}
+
+func (s *countingStore) Save(state *PoolState) error {
+	s.saves++
+	return nil
+}
+
+func TestPoolSave(t *testing.T) {
+	reserve := func(id int64) (int64, error) { return id, nil }
+	store := &countingStore{}
+
+	pool, err := NewPool(reserve, 1, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		_, err := pool.ID(fmt.Sprintf("key%d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if store.saves != 0 {
+		t.Errorf("Unexpected number of saves, got %#v, expected %#v", store.saves, 0)
+	}
+
+	err = pool.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pool.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.saves != 1 {
+		t.Errorf("Unexpected number of saves, got %#v, expected %#v", store.saves, 1)
+	}
+	err = pool.MarkUsed(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.saves != 2 {
+		t.Errorf("Unexpected number of saves, got %#v, expected %#v", store.saves, 2)
+	}
+}
+
+func TestContactPoolStart(t *testing.T) {
+	tests := []struct {
+		reserved int64
+		used     interface{}
+		first    int64
+	}{
+		// Reservation returns the highest reserved ID
+		{41, nil, 42},
+		{41, 12.0, 42},
+		// Reservation echoes the requested ID
+		{0, 120.0, 121},
+		{0, nil, 1},
+	}
+
+	for _, test := range tests {
+		var reservations []int64
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/1/test/contacts/import/reserve":
+				var req ticketmatic.ContactIdReservation
+				json.NewDecoder(r.Body).Decode(&req)
+				reservations = append(reservations, req.Id)
+				if req.Id == 0 {
+					req.Id = test.reserved
+				}
+				json.NewEncoder(w).Encode(req)
+			case "/api/1/test/tools/queries":
+				var req ticketmatic.QueryRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				if req.Query != "SELECT max(id) AS id FROM tm.contact" {
+					t.Errorf("Unexpected query: %s", req.Query)
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"nbrofresults": 1,
+					"results":      []map[string]interface{}{{"id": test.used}},
+				})
+			default:
+				t.Errorf("Unexpected request: %s", r.URL.Path)
+				w.WriteHeader(404)
+			}
+		}))
+
+		c := ticketmatic.NewClient("test", "key", "secret")
+		c.Server = srv.URL
+		pool, err := NewContactPool(c, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool.Chunk = 10
+		id, err := pool.Take()
+		if err != nil {
+			t.Fatal(err)
+		}
+		srv.Close()
+
+		if id != test.first {
+			t.Errorf("Unexpected ID, got %#v, expected %#v", id, test.first)
+		}
+		if len(reservations) != 2 || reservations[1] != test.first+9 {
+			t.Errorf("Unexpected reservations, got %#v", reservations)
+		}
+	}
+}
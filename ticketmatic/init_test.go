@@ -11,11 +11,12 @@ func TestMain(m *testing.M) {
 	accesskey := os.Getenv("TM_TEST_ACCESSKEY")
 	secretkey := os.Getenv("TM_TEST_SECRETKEY")
 
+	// The tests of this package run offline, the variables are only needed
+	// by tests against the API
 	if accountcode == "" || accesskey == "" || secretkey == "" {
-		log.Println("No test variables found. Make sure you set the")
+		log.Println("No test variables found, running the offline tests only. Set")
 		log.Println("TM_TEST_ACCOUNTCODE, TM_TEST_ACCESSKEY and TM_TEST_SECRETKEY")
-		log.Println("environment variables.")
-		os.Exit(1)
+		log.Println("to test against the API.")
 	}
 
 	os.Exit(m.Run())
@@ -0,0 +1,133 @@
+package integrity
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apiutil"
+)
+
+// Kind of issue
+type Kind string
+
+const (
+	// Reference to an object that doesn't exist
+	Dangling Kind = "dangling"
+
+	// Reference to an archived object
+	Archived Kind = "archived"
+
+	// Object that nothing refers to
+	Unused Kind = "unused"
+)
+
+// An integrity issue
+type Issue struct {
+	Kind Kind
+
+	// The referring object, or the unused object
+	Node *Node
+
+	// The reference, nil for unused objects
+	Edge *Edge
+}
+
+func (i *Issue) String() string {
+	switch i.Kind {
+	case Dangling:
+		return fmt.Sprintf("%s: %s refers to %s, which doesn't exist", i.Node, i.Edge.Path, apiutil.Label(i.Edge.Resource, i.Edge.Id, ""))
+	case Archived:
+		return fmt.Sprintf("%s: %s refers to archived %s", i.Node, i.Edge.Path, i.Edge.To)
+	default:
+		return fmt.Sprintf("%s is not used", i.Node)
+	}
+}
+
+// Find references to objects that don't exist or are archived. References
+// from archived objects are skipped.
+func (g *Graph) Check() []*Issue {
+	var issues []*Issue
+	for _, resource := range g.resources {
+		for _, n := range g.sortedNodes(resource) {
+			if n.Archived {
+				continue
+			}
+			for _, e := range n.Refs {
+				switch {
+				case e.To == nil:
+					issues = append(issues, &Issue{Kind: Dangling, Node: n, Edge: e})
+				case e.To.Archived:
+					issues = append(issues, &Issue{Kind: Archived, Node: n, Edge: e})
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// Find objects that nothing refers to, for the given resources (default:
+// all resources that can be referred to). Archived objects are skipped.
+//
+// Only the references known to the checker count: objects can still be in
+// use by orders or in ways the checker doesn't know about.
+func (g *Graph) Unused(resources ...string) []*Issue {
+	if len(resources) == 0 {
+		resources = g.referenced()
+	}
+
+	var issues []*Issue
+	for _, resource := range resources {
+		for _, n := range g.sortedNodes(resource) {
+			if !n.Archived && len(n.Referrers) == 0 {
+				issues = append(issues, &Issue{Kind: Unused, Node: n})
+			}
+		}
+	}
+	return issues
+}
+
+// Resources that are referred to, in load order
+func (g *Graph) referenced() []string {
+	seen := make(map[string]bool)
+	for _, e := range g.Edges {
+		seen[e.Resource] = true
+	}
+	var result []string
+	for _, resource := range g.resources {
+		if seen[resource] {
+			result = append(result, resource)
+		}
+	}
+	return result
+}
+
+// Events that would be affected by archiving an object: the events that
+// refer to it, directly or through other objects (an event using a price
+// list that contains a price type). Sorted by id.
+func (g *Graph) Affected(resource string, id int64) []*Node {
+	start := g.Node(resource, id)
+	if start == nil {
+		return nil
+	}
+
+	var result []*Node
+	seen := map[*Node]bool{start: true}
+	queue := []*Node{start}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, e := range n.Referrers {
+			if seen[e.From] {
+				continue
+			}
+			seen[e.From] = true
+			if e.From.Resource == "events" {
+				result = append(result, e.From)
+			} else {
+				queue = append(queue, e.From)
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Id < result[j].Id })
+	return result
+}
@@ -0,0 +1,22 @@
+// Referential integrity checks for the configuration of an account.
+//
+// Load builds a dependency graph of the events and settings objects of an
+// account, which can then be checked for references to objects that don't
+// exist or are archived:
+//
+//	g, err := integrity.Load(client)
+//	for _, issue := range g.Check() {
+//		fmt.Println(issue)
+//	}
+//
+//	// events/12 "Concert": ticketfeeid refers to archived ticketfees/3 "Old fees"
+//	// deliveryscenarios/4 "Mail": ordermailtemplateid_delivery refers to ordermails/9, which doesn't exist
+//
+// The graph also tells which settings objects aren't used, and which events
+// would be affected by archiving an object:
+//
+//	events := g.Affected("pricetypes", 5)
+//
+// Only the references known to the checker are taken into account, see
+// snapshot.References.
+package integrity
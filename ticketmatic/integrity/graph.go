@@ -0,0 +1,200 @@
+package integrity
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/events"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apiutil"
+	"github.com/ticketmatic/tm-go/ticketmatic/snapshot"
+)
+
+// References of events to settings objects
+var eventReferences = []snapshot.Reference{
+	{Path: "locationid", Resource: "eventlocations"},
+	{Path: "seatingplanid", Resource: "seatingplans"},
+	{Path: "seatingplanpricelistid", Resource: "pricelists"},
+	{Path: "seatingplaneventspecificprices.prices[].pricetypeid", Resource: "pricetypes"},
+	{Path: "seatingplaneventspecificprices.prices[].saleschannels[]", Resource: "saleschannels"},
+	{Path: "seatingplaneventspecificprices.seatrankids[]", Resource: "seatranks"},
+	{Path: "ticketfeeid", Resource: "ticketfees"},
+	{Path: "ticketlayoutid", Resource: "ticketlayouts"},
+	{Path: "contingents[].pricelistid", Resource: "pricelists"},
+	{Path: "contingents[].eventspecificprices.prices[].pricetypeid", Resource: "pricetypes"},
+	{Path: "contingents[].eventspecificprices.prices[].saleschannels[]", Resource: "saleschannels"},
+	{Path: "contingents[].eventspecificprices.seatrankids[]", Resource: "seatranks"},
+	{Path: "seated_contingents[].pricelistid", Resource: "pricelists"},
+	{Path: "seated_contingents[].eventspecificprices.prices[].pricetypeid", Resource: "pricetypes"},
+	{Path: "seated_contingents[].eventspecificprices.prices[].saleschannels[]", Resource: "saleschannels"},
+	{Path: "seated_contingents[].eventspecificprices.seatrankids[]", Resource: "seatranks"},
+}
+
+// Page size used when loading events
+const eventPageSize = 100
+
+// An event or settings object
+type Node struct {
+	// Resource name: "events", "pricetypes"
+	Resource string
+
+	Id       int64
+	Name     string
+	Archived bool
+
+	// References to other objects
+	Refs []*Edge
+
+	// References from other objects
+	Referrers []*Edge
+}
+
+func (n *Node) String() string {
+	return apiutil.Label(n.Resource, n.Id, n.Name)
+}
+
+// A reference from one object to another
+type Edge struct {
+	From *Node
+
+	// Path of the id field: "ticketfeeid", "prices.prices[].pricetypeid"
+	Path string
+
+	// Referenced resource and id
+	Resource string
+	Id       int64
+
+	// Referenced object, nil if it doesn't exist
+	To *Node
+}
+
+// Dependency graph of the objects of an account
+type Graph struct {
+	// Objects, by resource and id
+	Nodes map[string]map[int64]*Node
+
+	// All references, in load order
+	Edges []*Edge
+
+	// Resources in load order
+	resources []string
+}
+
+// Load the events and all settings objects (including archived ones) of an
+// account and build their dependency graph.
+func Load(client *ticketmatic.Client) (*Graph, error) {
+	objects := make(map[string][]snapshot.Object)
+	order := []string{"events"}
+
+	evts, err := loadEvents(client)
+	if err != nil {
+		return nil, fmt.Errorf("events: %s", err)
+	}
+	objects["events"] = evts
+
+	for _, r := range snapshot.Resources {
+		list, err := r.ListAll(client)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name, err)
+		}
+		objects[r.Name] = list
+		order = append(order, r.Name)
+	}
+	return build(order, objects), nil
+}
+
+func loadEvents(client *ticketmatic.Client) ([]snapshot.Object, error) {
+	var result []snapshot.Object
+	for offset := int64(0); ; offset += eventPageSize {
+		list, err := events.Getlist(client, &ticketmatic.EventQuery{
+			Limit:  eventPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range list.Data {
+			var o snapshot.Object
+			err := apiutil.Convert(e, &o)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, o)
+		}
+		if len(list.Data) < eventPageSize {
+			return result, nil
+		}
+	}
+}
+
+// Build the dependency graph of a set of objects, per resource. Events are
+// stored as resource "events".
+func Build(objects map[string][]snapshot.Object) *Graph {
+	var order []string
+	for name := range objects {
+		order = append(order, name)
+	}
+	sort.Strings(order)
+	return build(order, objects)
+}
+
+func build(order []string, objects map[string][]snapshot.Object) *Graph {
+	g := &Graph{
+		Nodes:     make(map[string]map[int64]*Node),
+		resources: order,
+	}
+	for _, resource := range order {
+		g.Nodes[resource] = make(map[int64]*Node)
+		for _, o := range objects[resource] {
+			archived, _ := o["isarchived"].(bool)
+			g.Nodes[resource][o.ID()] = &Node{
+				Resource: resource,
+				Id:       o.ID(),
+				Name:     o.Name(),
+				Archived: archived,
+			}
+		}
+	}
+
+	for _, resource := range order {
+		refs := snapshot.References[resource]
+		if resource == "events" {
+			refs = eventReferences
+		}
+		for _, o := range objects[resource] {
+			from := g.Nodes[resource][o.ID()]
+			for _, ref := range refs {
+				for _, id := range ref.IDs(o) {
+					e := &Edge{
+						From:     from,
+						Path:     ref.Path,
+						Resource: ref.Resource,
+						Id:       id,
+						To:       g.Nodes[ref.Resource][id],
+					}
+					from.Refs = append(from.Refs, e)
+					if e.To != nil {
+						e.To.Referrers = append(e.To.Referrers, e)
+					}
+					g.Edges = append(g.Edges, e)
+				}
+			}
+		}
+	}
+	return g
+}
+
+// Look up an object, nil if it doesn't exist
+func (g *Graph) Node(resource string, id int64) *Node {
+	return g.Nodes[resource][id]
+}
+
+// Nodes of a resource, sorted by id
+func (g *Graph) sortedNodes(resource string) []*Node {
+	nodes := make([]*Node, 0, len(g.Nodes[resource]))
+	for _, n := range g.Nodes[resource] {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Id < nodes[j].Id })
+	return nodes
+}
@@ -0,0 +1,132 @@
+package integrity
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/snapshot"
+)
+
+// Serves a fixed response per path, an empty list for other paths
+func setup(t *testing.T, responses map[string]string) *ticketmatic.Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/api/1/test/")
+		if path == "events" && r.URL.Query().Get("offset") != "" {
+			t.Errorf("Unexpected second page: %s", r.URL)
+		}
+		if strings.HasPrefix(path, "settings/") && r.URL.Query().Get("includearchived") != "true" {
+			t.Errorf("Expected archived objects to be included: %s", r.URL)
+		}
+		if resp, ok := responses[path]; ok {
+			w.Write([]byte(resp))
+			return
+		}
+		w.Write([]byte(`{"data": []}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := ticketmatic.NewClient("test", "key", "secret")
+	c.Server = srv.URL
+	return c
+}
+
+func TestCheck(t *testing.T) {
+	c := setup(t, map[string]string{
+		"events": `{"data": [
+			{"id": 1, "name": "Concert", "ticketfeeid": 3, "contingents": [{"pricelistid": 4}]},
+			{"id": 2, "name": "Play", "ticketlayoutid": 9},
+			{"id": 5, "name": "Opera", "seatingplanpricelistid": 6}
+		]}`,
+		"settings/pricing/ticketfees": `{"data": [{"id": 3, "name": "Old fees", "isarchived": true}]}`,
+		"settings/pricing/pricetypes": `{"data": [{"id": 7, "name": "Regular"}, {"id": 8, "name": "Senior"}]}`,
+		"settings/pricing/pricelists": `{"data": [
+			{"id": 4, "name": "Standard", "prices": {"prices": [{"pricetypeid": 7}]}},
+			{"id": 6, "name": "Seated", "prices": {"prices": [{"pricetypeid": 7}]}}
+		]}`,
+		"settings/ticketsales/deliveryscenarios": `{"data": [{"id": 10, "name": "Mail", "ordermailtemplateid_delivery": 11}]}`,
+	})
+
+	g, err := Load(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result []string
+	for _, issue := range g.Check() {
+		result = append(result, issue.String())
+	}
+	expected := []string{
+		`events/1 "Concert": ticketfeeid refers to archived ticketfees/3 "Old fees"`,
+		`events/2 "Play": ticketlayoutid refers to ticketlayouts/9, which doesn't exist`,
+		`deliveryscenarios/10 "Mail": ordermailtemplateid_delivery refers to ordermails/11, which doesn't exist`,
+	}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Unexpected issues, got %#v, expected %#v", result, expected)
+	}
+
+	result = nil
+	for _, issue := range g.Unused("pricetypes", "pricelists") {
+		result = append(result, issue.String())
+	}
+	expected = []string{`pricetypes/8 "Senior" is not used`}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Unexpected unused objects, got %#v, expected %#v", result, expected)
+	}
+
+	result = nil
+	for _, n := range g.Affected("pricetypes", 7) {
+		result = append(result, n.String())
+	}
+	expected = []string{`events/1 "Concert"`, `events/5 "Opera"`}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Unexpected affected events, got %#v, expected %#v", result, expected)
+	}
+
+	if affected := g.Affected("pricetypes", 99); affected != nil {
+		t.Errorf("Unexpected affected events, got %#v", affected)
+	}
+}
+
+func TestSeatedContingents(t *testing.T) {
+	g := Build(map[string][]snapshot.Object{
+		"events": {
+			{"id": float64(1), "name": "Opera", "seated_contingents": []interface{}{
+				map[string]interface{}{
+					"pricelistid": float64(4),
+					"eventspecificprices": map[string]interface{}{
+						"prices": []interface{}{
+							map[string]interface{}{"pricetypeid": float64(7), "saleschannels": []interface{}{float64(2), float64(3)}},
+						},
+						"seatrankids": []interface{}{float64(5)},
+					},
+				},
+			}},
+		},
+		"pricelists":    {{"id": float64(4), "name": "Seated"}},
+		"pricetypes":    {{"id": float64(7), "name": "Regular"}},
+		"saleschannels": {{"id": float64(2), "name": "Web"}},
+		"seatranks":     {{"id": float64(5), "name": "Rank A", "isarchived": true}},
+	})
+
+	var result []string
+	for _, issue := range g.Check() {
+		result = append(result, issue.String())
+	}
+	expected := []string{
+		`events/1 "Opera": seated_contingents[].eventspecificprices.prices[].saleschannels[] refers to saleschannels/3, which doesn't exist`,
+		`events/1 "Opera": seated_contingents[].eventspecificprices.seatrankids[] refers to archived seatranks/5 "Rank A"`,
+	}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Unexpected issues, got %#v, expected %#v", result, expected)
+	}
+
+	for _, resource := range []string{"pricelists", "pricetypes", "saleschannels"} {
+		if unused := g.Unused(resource); unused != nil {
+			t.Errorf("Unexpected unused %s, got %#v", resource, unused)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// A fake account for the offline tests of the packages built on top of the
+// API operations.
+package apitest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Code of the fake account
+const AccountCode = "test"
+
+// Serves the list, get, create, update and delete operations of the objects
+// it holds, the way the settings endpoints do:
+//
+//	GET    {path}?includearchived=&lastupdatesince=&limit=&offset=
+//	POST   {path}
+//	GET    {path}/{id}
+//	PUT    {path}/{id}
+//	DELETE {path}/{id}
+type Account struct {
+	// Objects per path below the account, such as
+	// "settings/pricing/pricetypes", in list order
+	Objects map[string][]map[string]interface{}
+
+	// Id of the last created object, the next object gets the next id
+	LastID int64
+
+	// Archive objects on DELETE (set isarchived) rather than removing them
+	Archive bool
+
+	// Serves the requests for other paths (optional), called without
+	// holding the lock
+	Fallback http.Handler
+
+	mutex    sync.Mutex
+	requests []*Request
+}
+
+// A request served by an Account
+type Request struct {
+	Method string
+
+	// Path below the account
+	Path string
+
+	Query url.Values
+}
+
+// Create an account holding objects
+func New(objects map[string][]map[string]interface{}) *Account {
+	if objects == nil {
+		objects = make(map[string][]map[string]interface{})
+	}
+	return &Account{
+		Objects: objects,
+	}
+}
+
+// Objects as JSON objects, e.g. to fill Objects from API types
+func Objects(values ...interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(values))
+	for _, v := range values {
+		var o map[string]interface{}
+		data, err := json.Marshal(v)
+		if err != nil {
+			panic(err)
+		}
+		err = json.Unmarshal(data, &o)
+		if err != nil {
+			panic(err)
+		}
+		result = append(result, o)
+	}
+	return result
+}
+
+// Serve the account until the test ends, returns a client for it
+func (a *Account) Client(t testing.TB) *ticketmatic.Client {
+	srv := httptest.NewServer(a)
+	t.Cleanup(srv.Close)
+
+	c := ticketmatic.NewClient(AccountCode, "key", "secret")
+	c.Server = srv.URL
+	return c
+}
+
+// The requests served for a path, including those below it. An empty path
+// returns all requests.
+func (a *Account) Requests(path string) []*Request {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	var result []*Request
+	for _, r := range a.requests {
+		if path == "" || r.Path == path || strings.HasPrefix(r.Path, path+"/") {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// The object with the given id, nil if there is none
+func (a *Account) Object(path string, id int64) map[string]interface{} {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	_, o := a.find(path, id)
+	return o
+}
+
+func (a *Account) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mutex.Lock()
+	path := strings.TrimPrefix(r.URL.Path, "/api/1/"+AccountCode+"/")
+	a.requests = append(a.requests, &Request{
+		Method: r.Method,
+		Path:   path,
+		Query:  r.URL.Query(),
+	})
+
+	// The longest path holding objects
+	var resource, rest string
+	for p := range a.Objects {
+		if (path == p || strings.HasPrefix(path, p+"/")) && len(p) > len(resource) {
+			resource = p
+			rest = strings.TrimPrefix(strings.TrimPrefix(path, p), "/")
+		}
+	}
+	var id int64
+	if rest != "" {
+		var err error
+		id, err = strconv.ParseInt(rest, 10, 64)
+		if err != nil || id <= 0 {
+			resource = ""
+		}
+	}
+	if resource == "" {
+		a.mutex.Unlock()
+		if a.Fallback != nil {
+			a.Fallback.ServeHTTP(w, r)
+		} else {
+			http.NotFound(w, r)
+		}
+		return
+	}
+	defer a.mutex.Unlock()
+
+	var body map[string]interface{}
+	if r.Method == "POST" || r.Method == "PUT" {
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if id == 0 {
+		switch r.Method {
+		case "GET":
+			json.NewEncoder(w).Encode(a.list(resource, r.URL.Query()))
+		case "POST":
+			a.LastID++
+			body["id"] = a.LastID
+			a.Objects[resource] = append(a.Objects[resource], body)
+			json.NewEncoder(w).Encode(body)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	i, o := a.find(resource, id)
+	if o == nil {
+		http.NotFound(w, r)
+		return
+	}
+	switch r.Method {
+	case "GET":
+		json.NewEncoder(w).Encode(o)
+	case "PUT":
+		for k, v := range body {
+			o[k] = v
+		}
+		o["id"] = id
+		json.NewEncoder(w).Encode(o)
+	case "DELETE":
+		if a.Archive {
+			o["isarchived"] = true
+		} else {
+			objects := a.Objects[resource]
+			a.Objects[resource] = append(objects[:i:i], objects[i+1:]...)
+		}
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (a *Account) list(resource string, q url.Values) map[string]interface{} {
+	archived := q.Get("includearchived") == "true"
+	since := strings.Replace(strings.Trim(q.Get("lastupdatesince"), `"`), "T", " ", 1)
+
+	data := []interface{}{}
+	for _, o := range a.Objects[resource] {
+		if o["isarchived"] == true && !archived {
+			continue
+		}
+		if ts, _ := o["lastupdatets"].(string); since != "" && ts <= since {
+			continue
+		}
+		data = append(data, o)
+	}
+	total := len(data)
+
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	if limit, _ := strconv.Atoi(q.Get("limit")); limit > 0 {
+		data = data[min(offset, total):min(offset+limit, total)]
+	}
+	return map[string]interface{}{"data": data, "nbrofresults": total}
+}
+
+func (a *Account) find(resource string, id int64) (int, map[string]interface{}) {
+	for i, o := range a.Objects[resource] {
+		if objectID(o) == id {
+			return i, o
+		}
+	}
+	return -1, nil
+}
+
+// Id of an object, whether it was decoded from JSON or set from Go
+func objectID(o map[string]interface{}) int64 {
+	switch id := o["id"].(type) {
+	case float64:
+		return int64(id)
+	case int:
+		return int64(id)
+	case int64:
+		return id
+	}
+	return 0
+}
@@ -0,0 +1,79 @@
+package apitest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/pricetypes"
+)
+
+func TestAccount(t *testing.T) {
+	account := New(map[string][]map[string]interface{}{
+		"settings/pricing/pricetypes": Objects(
+			&ticketmatic.PriceType{Id: 1, Name: "Regular", Lastupdatets: ticketmatic.NewTime(ticketmatic.MustParseTime("2026-01-01 10:00:00"))},
+			&ticketmatic.PriceType{Id: 2, Name: "Free", Isarchived: true},
+		),
+	})
+	account.LastID = 10
+	account.Archive = true
+	account.Fallback = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": 99}`))
+	})
+	c := account.Client(t)
+
+	list, err := pricetypes.Getlist(c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Data) != 1 || list.Data[0].Name != "Regular" {
+		t.Errorf("Unexpected list, got %#v", list.Data)
+	}
+	list, err = pricetypes.Getlist(c, &ticketmatic.PriceTypeQuery{Includearchived: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Data) != 2 || list.Data[1].Id != 2 {
+		t.Errorf("Unexpected list, got %#v", list.Data)
+	}
+
+	created, err := pricetypes.Create(c, &ticketmatic.PriceType{Name: "Student"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Id != 11 {
+		t.Errorf("Unexpected id, got %d, expected %d", created.Id, 11)
+	}
+	_, err = pricetypes.Update(c, 1, &ticketmatic.PriceType{Remark: "Default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o := account.Object("settings/pricing/pricetypes", 1); o["name"] != "Regular" || o["remark"] != "Default" {
+		t.Errorf("Unexpected object, got %#v", o)
+	}
+	err = pricetypes.Delete(c, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o := account.Object("settings/pricing/pricetypes", 1); o["isarchived"] != true {
+		t.Errorf("Expected an archived object, got %#v", o)
+	}
+	_, err = pricetypes.Get(c, 3)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+
+	// Other paths go to the fallback
+	var o struct{ Id int64 }
+	err = c.NewRequest("GET", "/{accountname}/other", "json").Run(&o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Id != 99 {
+		t.Errorf("Unexpected fallback result, got %#v", o)
+	}
+
+	if n := len(account.Requests("settings/pricing/pricetypes")); n != 6 {
+		t.Errorf("Unexpected number of requests, got %d, expected %d", n, 6)
+	}
+}
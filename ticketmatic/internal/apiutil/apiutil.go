@@ -0,0 +1,39 @@
+// Helpers shared by the packages built on top of the API operations.
+package apiutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Convert between types through JSON, also used to deep-copy objects
+func Convert(from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
+
+// Label of an object for messages: `pricetypes/12 "Regular"`. The id and name
+// are left out when not set.
+func Label(resource string, id int64, name string) string {
+	var b strings.Builder
+	b.WriteString(resource)
+	if id != 0 {
+		fmt.Fprintf(&b, "/%d", id)
+	}
+	if name != "" {
+		fmt.Fprintf(&b, " %q", name)
+	}
+	return b.String()
+}
+
+// Whether a request failed because the object doesn't exist
+func IsNotFound(err error) bool {
+	e, ok := err.(*ticketmatic.RequestError)
+	return ok && e.StatusCode == 404
+}
@@ -0,0 +1,45 @@
+package apiutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestLabel(t *testing.T) {
+	tests := []struct {
+		id       int64
+		name     string
+		expected string
+	}{
+		{12, "Regular", `pricetypes/12 "Regular"`},
+		{0, "Regular", `pricetypes "Regular"`},
+		{12, "", `pricetypes/12`},
+	}
+	for _, test := range tests {
+		if s := Label("pricetypes", test.id, test.name); s != test.expected {
+			t.Errorf("Unexpected label, got %q, expected %q", s, test.expected)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	var o map[string]interface{}
+	err := Convert(&ticketmatic.PriceType{Id: 3, Name: "Free"}, &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o["id"] != float64(3) || o["name"] != "Free" {
+		t.Errorf("Unexpected result, got %#v", o)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	if !IsNotFound(&ticketmatic.RequestError{StatusCode: 404}) {
+		t.Error("Expected a not found error")
+	}
+	if IsNotFound(&ticketmatic.RequestError{StatusCode: 500}) || IsNotFound(errors.New("404")) {
+		t.Error("Unexpected not found error")
+	}
+}
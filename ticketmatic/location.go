@@ -0,0 +1,120 @@
+package ticketmatic
+
+import (
+	"reflect"
+	"time"
+)
+
+var timeType = reflect.TypeOf(Time{})
+
+// Interpret the timestamps without timezone in v in loc, and write the
+// timestamps of v in loc from then on. v is a pointer to an API type, or a
+// slice or map of them. The client does this for results when its Location
+// is set, request bodies are written through a copy.
+func SetLocation(v interface{}, loc *time.Location) {
+	if loc == nil || v == nil {
+		return
+	}
+	setLocation(reflect.ValueOf(v), loc)
+}
+
+func setLocation(v reflect.Value, loc *time.Location) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			setLocation(v.Elem(), loc)
+		}
+	case reflect.Struct:
+		if v.Type() == timeType {
+			if v.CanSet() {
+				t := v.Addr().Interface().(*Time)
+				*t = t.In(loc)
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				setLocation(v.Field(i), loc)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			setLocation(v.Index(i), loc)
+		}
+	case reflect.Map:
+		// Map values can't be changed in place, only those behind pointers
+		iter := v.MapRange()
+		for iter.Next() {
+			if iter.Value().Kind() == reflect.Ptr {
+				setLocation(iter.Value(), loc)
+			}
+		}
+	}
+}
+
+// A copy of v with its timestamps in loc, v itself is left unchanged. Used for
+// request bodies, which may be shared between goroutines.
+func withLocation(v interface{}, loc *time.Location) interface{} {
+	if loc == nil || v == nil {
+		return v
+	}
+	return copyWithLocation(reflect.ValueOf(v), loc).Interface()
+}
+
+func copyWithLocation(v reflect.Value, loc *time.Location) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(copyWithLocation(v.Elem(), loc))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(copyWithLocation(v.Elem(), loc))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		if v.Type() == timeType {
+			c.Set(reflect.ValueOf(v.Interface().(Time).In(loc)))
+			return c
+		}
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				c.Field(i).Set(copyWithLocation(v.Field(i), loc))
+			}
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyWithLocation(v.Index(i), loc))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyWithLocation(v.Index(i), loc))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), copyWithLocation(iter.Value(), loc))
+		}
+		return c
+	}
+	return v
+}
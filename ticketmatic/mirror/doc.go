@@ -0,0 +1,17 @@
+// Incremental mirror of orders, contacts and events into a local store.
+//
+// The first sync copies all records, later syncs only request the records
+// that changed since the previous one, using the Lastupdatesince query field.
+// The high-water mark of each resource is kept by the sink, together with
+// the records:
+//
+//	sink, err := mirror.NewJSONLSink("data")
+//	m := &mirror.Mirror{Client: client, Sink: sink}
+//	err = m.Sync()
+//
+// Deleted contacts are reported by the API and removed from the sink. Other
+// deletions can be picked up from the eventstream by setting Deletions.
+//
+// Two sinks are included: JSONLSink appends changes to a JSON Lines file per
+// resource, SQLSink stores records in tables of any database/sql database.
+package mirror
@@ -0,0 +1,114 @@
+package mirror
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Name of the file that holds the marks of a JSONLSink
+const marksFile = "marks.json"
+
+// A line in the file of a JSONLSink
+type line struct {
+	Id      int64       `json:"id"`
+	Updated time.Time   `json:"updated,omitzero"`
+	Deleted bool        `json:"deleted,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// Appends changes to a JSON Lines file per resource (orders.jsonl), one
+// line per change:
+//
+//	{"id":12,"updated":"2026-01-02T10:00:00Z","data":{...}}
+//	{"id":14,"deleted":true}
+//
+// Later lines replace earlier lines with the same id. Marks are kept in
+// marks.json.
+type JSONLSink struct {
+	dir string
+
+	mutex sync.Mutex
+	marks map[string]Mark
+}
+
+// Create a sink that writes to a directory, which is created if needed
+func NewJSONLSink(dir string) (*JSONLSink, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &JSONLSink{
+		dir:   dir,
+		marks: make(map[string]Mark),
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, marksFile))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		err = json.Unmarshal(data, &s.marks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *JSONLSink) Mark(resource string) (Mark, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.marks[resource], nil
+}
+
+func (s *JSONLSink) Write(resource string, records []*Record, mark Mark) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(records) > 0 {
+		f, err := os.OpenFile(filepath.Join(s.dir, resource+".jsonl"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(f)
+		for _, r := range records {
+			l := &line{Id: r.Id, Updated: r.Updated, Deleted: r.Deleted}
+			if !r.Deleted {
+				l.Data = r.Data
+			}
+			err = enc.Encode(l)
+			if err != nil {
+				f.Close()
+				return err
+			}
+		}
+		err = f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.marks[resource] == mark {
+		return nil
+	}
+	s.marks[resource] = mark
+	return s.saveMarks()
+}
+
+// Replace the marks file atomically
+func (s *JSONLSink) saveMarks() error {
+	data, err := json.MarshalIndent(s.marks, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(s.dir, marksFile+".tmp")
+	err = ioutil.WriteFile(tmp, data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(s.dir, marksFile))
+}
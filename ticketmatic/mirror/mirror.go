@@ -0,0 +1,356 @@
+package mirror
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/contacts"
+	"github.com/ticketmatic/tm-go/ticketmatic/events"
+	"github.com/ticketmatic/tm-go/ticketmatic/eventstream"
+	"github.com/ticketmatic/tm-go/ticketmatic/orders"
+)
+
+// Default number of records requested per page
+const DefaultPageSize = 100
+
+// Name of the mark that holds the eventstream position
+const StreamMark = "eventstream"
+
+// How far before the oldest mark the eventstream is read when it starts.
+// Marks hold the last update times of records, which are in the timezone of
+// the account (see Client.Location): the margin covers any offset, reading
+// a deletion twice is harmless.
+const streamMargin = 24 * time.Hour
+
+// A changed record
+type Record struct {
+	// Resource: "orders", "contacts" or "events"
+	Resource string
+
+	Id int64
+
+	// Last update of the record, zero for deletions from the eventstream
+	Updated time.Time
+
+	// Whether the record was deleted, Data is nil for deletions from the
+	// eventstream
+	Deleted bool
+
+	// The record: *ticketmatic.Order, *ticketmatic.Contact or
+	// *ticketmatic.Event
+	Data interface{}
+}
+
+// Position up to which a resource has been synced
+type Mark struct {
+	// Highest last update time seen. For StreamMark: the time to start
+	// reading the eventstream at, until Stream is set.
+	Updated time.Time `json:"updated"`
+
+	// Eventstream id to continue from
+	Stream string `json:"stream,omitempty"`
+}
+
+// Stores mirrored records and marks
+type Sink interface {
+	// Current mark of a resource, zero when it was never synced
+	Mark(resource string) (Mark, error)
+
+	// Store changed records (replacing existing ones, removing deleted
+	// ones) and the new mark of the resource. Should be atomic where the
+	// store supports it.
+	Write(resource string, records []*Record, mark Mark) error
+}
+
+// A resource that can be mirrored
+type resource struct {
+	name  string
+	fetch func(c *ticketmatic.Client, since ticketmatic.Time, limit, offset int64) ([]*Record, error)
+}
+
+// Mirrored resources, in sync order
+var resources = []*resource{
+	{"contacts", func(c *ticketmatic.Client, since ticketmatic.Time, limit, offset int64) ([]*Record, error) {
+		l, err := contacts.Getlist(c, &ticketmatic.ContactQuery{
+			Includearchived: true,
+			Lastupdatesince: since,
+			Limit:           limit,
+			Offset:          offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result := make([]*Record, 0, len(l.Data))
+		for _, o := range l.Data {
+			result = append(result, &Record{"contacts", o.Id, o.Lastupdatets.Time(), o.Isdeleted, o})
+		}
+		return result, nil
+	}},
+	{"events", func(c *ticketmatic.Client, since ticketmatic.Time, limit, offset int64) ([]*Record, error) {
+		l, err := events.Getlist(c, &ticketmatic.EventQuery{
+			Lastupdatesince: since,
+			Limit:           limit,
+			Offset:          offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result := make([]*Record, 0, len(l.Data))
+		for _, o := range l.Data {
+			result = append(result, &Record{"events", o.Id, o.Lastupdatets.Time(), false, o})
+		}
+		return result, nil
+	}},
+	{"orders", func(c *ticketmatic.Client, since ticketmatic.Time, limit, offset int64) ([]*Record, error) {
+		l, err := orders.Getlist(c, &ticketmatic.OrderQuery{
+			Includearchived: true,
+			Lastupdatesince: since,
+			Limit:           limit,
+			Offset:          offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result := make([]*Record, 0, len(l.Data))
+		for _, o := range l.Data {
+			result = append(result, &Record{"orders", o.Orderid, o.Lastupdatets.Time(), false, o})
+		}
+		return result, nil
+	}},
+}
+
+// Names of the resources that can be mirrored
+func Resources() []string {
+	result := make([]string, 0, len(resources))
+	for _, r := range resources {
+		result = append(result, r.name)
+	}
+	return result
+}
+
+// Mirrors an account into a sink
+type Mirror struct {
+	Client *ticketmatic.Client
+	Sink   Sink
+
+	// Resources to mirror (default: all)
+	Resources []string
+
+	// Records requested per page (default: DefaultPageSize)
+	PageSize int64
+
+	// Recognizes deletions in eventstream items. When set, Sync also reads
+	// the eventstream and removes the records for which it returns ok. The
+	// eventstream is read from before the first sync with Deletions set, or
+	// from before the oldest mark when resources were synced without it.
+	Deletions func(item *ticketmatic.EventstreamItem) (resource string, id int64, ok bool)
+
+	// Receives a line per synced resource (optional)
+	Log io.Writer
+}
+
+// Sync the changes since the previous sync. Stops at the first failure,
+// the records written until then are kept and synced again next time.
+func (m *Mirror) Sync() error {
+	names := m.Resources
+	if len(names) == 0 {
+		names = Resources()
+	}
+	var selected []*resource
+	for _, name := range names {
+		r := lookup(name)
+		if r == nil {
+			return fmt.Errorf("Unknown resource: %s", name)
+		}
+		selected = append(selected, r)
+	}
+
+	// Fix the eventstream position before syncing, so deletions made during
+	// the sync aren't missed
+	if m.Deletions != nil {
+		err := m.startStream(names)
+		if err != nil {
+			return fmt.Errorf("%s: %s", StreamMark, err)
+		}
+	}
+
+	for _, r := range selected {
+		err := m.sync(r)
+		if err != nil {
+			return fmt.Errorf("%s: %s", r.name, err)
+		}
+	}
+
+	if m.Deletions != nil {
+		err := m.syncStream()
+		if err != nil {
+			return fmt.Errorf("%s: %s", StreamMark, err)
+		}
+	}
+	return nil
+}
+
+func (m *Mirror) sync(r *resource) error {
+	mark, err := m.Sink.Mark(r.name)
+	if err != nil {
+		return err
+	}
+
+	limit := m.PageSize
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+
+	// Page through a fixed query, the mark only moves once all pages are
+	// written so an interrupted sync starts over.
+	var since ticketmatic.Time
+	if !mark.Updated.IsZero() {
+		since = ticketmatic.NewTime(mark.Updated)
+	}
+	next := mark
+	updated, deleted := 0, 0
+	for offset := int64(0); ; offset += limit {
+		records, err := r.fetch(m.Client, since, limit, offset)
+		if err != nil {
+			return err
+		}
+		for _, rec := range records {
+			if rec.Updated.After(next.Updated) {
+				next.Updated = rec.Updated
+			}
+			if rec.Deleted {
+				deleted++
+			} else {
+				updated++
+			}
+		}
+
+		done := int64(len(records)) < limit
+		current := mark
+		if done {
+			current = next
+		}
+		err = m.Sink.Write(r.name, records, current)
+		if err != nil {
+			return err
+		}
+		if done {
+			break
+		}
+	}
+
+	m.logf("%s: %d updated, %d deleted\n", r.name, updated, deleted)
+	return nil
+}
+
+// Store the time to start reading the eventstream at, unless it was started
+// before: before the oldest mark of the resources, or before now when none
+// were synced.
+func (m *Mirror) startStream(names []string) error {
+	mark, err := m.Sink.Mark(StreamMark)
+	if err != nil {
+		return err
+	}
+	if mark.Stream != "" || !mark.Updated.IsZero() {
+		return nil
+	}
+
+	start := time.Now()
+	for _, name := range names {
+		current, err := m.Sink.Mark(name)
+		if err != nil {
+			return err
+		}
+		if !current.Updated.IsZero() && current.Updated.Before(start) {
+			start = current.Updated
+		}
+	}
+	return m.Sink.Write(StreamMark, nil, Mark{Updated: start.Add(-streamMargin)})
+}
+
+func (m *Mirror) syncStream() error {
+	mark, err := m.Sink.Mark(StreamMark)
+	if err != nil {
+		return err
+	}
+
+	req := &ticketmatic.EventstreamRequest{Id: mark.Stream}
+	if mark.Stream == "" {
+		req.Ts = mark.Updated.UTC().Format(time.RFC3339)
+	}
+
+	deleted := make(map[string]int)
+	for {
+		res, err := eventstream.Eventstream(m.Client, req)
+		if err != nil {
+			return err
+		}
+
+		batches := make(map[string][]*Record)
+		var order []string
+		for _, item := range res.Results {
+			resource, id, ok := m.Deletions(item)
+			if !ok {
+				continue
+			}
+			if _, ok := batches[resource]; !ok {
+				order = append(order, resource)
+			}
+			batches[resource] = append(batches[resource], &Record{Resource: resource, Id: id, Deleted: true})
+			deleted[resource]++
+		}
+		for _, resource := range order {
+			current, err := m.Sink.Mark(resource)
+			if err != nil {
+				return err
+			}
+			err = m.Sink.Write(resource, batches[resource], current)
+			if err != nil {
+				return err
+			}
+		}
+
+		if res.Nextid != "" {
+			req.Id = res.Nextid
+			req.Ts = ""
+		}
+		err = m.Sink.Write(StreamMark, nil, Mark{Stream: req.Id})
+		if err != nil {
+			return err
+		}
+		if !res.Moreresults {
+			break
+		}
+	}
+
+	names := make([]string, 0, len(deleted))
+	for resource := range deleted {
+		names = append(names, resource)
+	}
+	sort.Strings(names)
+	for _, resource := range names {
+		m.logf("%s: %d deleted (eventstream)\n", resource, deleted[resource])
+	}
+	return nil
+}
+
+func (m *Mirror) logf(format string, args ...interface{}) {
+	log := m.Log
+	if log == nil {
+		log = ioutil.Discard
+	}
+	fmt.Fprintf(log, format, args...)
+}
+
+func lookup(name string) *resource {
+	for _, r := range resources {
+		if r.name == name {
+			return r
+		}
+	}
+	return nil
+}
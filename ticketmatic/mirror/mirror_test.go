@@ -0,0 +1,371 @@
+package mirror
+
+import (
+	"bufio"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apitest"
+)
+
+// A fake account with contacts, events, orders and the eventstream
+type fakeAccount struct {
+	*apitest.Account
+
+	mutex  sync.Mutex
+	stream []*ticketmatic.EventstreamItem
+}
+
+// Serves the eventstream, ids are positions in the stream
+func (f *fakeAccount) serveStream(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if r.URL.Path != "/api/1/"+apitest.AccountCode+"/eventstream" {
+		http.NotFound(w, r)
+		return
+	}
+	q := r.URL.Query()
+	start := len(f.stream)
+	if ts := q.Get("ts"); ts != "" {
+		for i, item := range f.stream {
+			if item.Ts >= ts {
+				start = i
+				break
+			}
+		}
+	} else {
+		start, _ = strconv.Atoi(q.Get("id"))
+	}
+	json.NewEncoder(w).Encode(&ticketmatic.EventstreamResult{
+		Nextid:  strconv.Itoa(len(f.stream)),
+		Results: f.stream[start:],
+	})
+}
+
+// The lastupdatesince parameter of each request for a resource
+func (f *fakeAccount) since(resource string) []string {
+	var result []string
+	for _, req := range f.Requests(resource) {
+		result = append(result, strings.Trim(req.Query.Get("lastupdatesince"), `"`))
+	}
+	return result
+}
+
+func setup(t *testing.T) (*fakeAccount, *ticketmatic.Client) {
+	account := &fakeAccount{
+		Account: apitest.New(map[string][]map[string]interface{}{
+			"contacts": {
+				{"id": 1, "firstname": "Alice", "lastupdatets": "2026-01-01 10:00:00"},
+				{"id": 2, "firstname": "Bob", "lastupdatets": "2026-01-02 10:00:00", "isdeleted": true},
+			},
+			"events": {
+				{"id": 10, "name": "Concert", "lastupdatets": "2026-01-01 10:00:00"},
+			},
+			"orders": {
+				{"orderid": 100, "lastupdatets": "2026-01-01 10:00:00"},
+				{"orderid": 101, "lastupdatets": "2026-01-02 10:00:00"},
+				{"orderid": 102, "lastupdatets": "2026-01-03 10:00:00"},
+			},
+		}),
+	}
+	account.Fallback = http.HandlerFunc(account.serveStream)
+	return account, account.Client(t)
+}
+
+// Reads the ids and deleted flags of a JSONL file
+func readLines(t *testing.T, path string) []string {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var result []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var l line
+		err := json.Unmarshal(s.Bytes(), &l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result = append(result, fmt.Sprintf("%d:%v", l.Id, l.Deleted))
+	}
+	return result
+}
+
+func TestJSONLSink(t *testing.T) {
+	account, c := setup(t)
+	dir := t.TempDir()
+
+	sink, err := NewJSONLSink(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &Mirror{Client: c, Sink: sink, PageSize: 2}
+	err = m.Sync()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := readLines(t, filepath.Join(dir, "orders.jsonl"))
+	if strings.Join(lines, " ") != "100:false 101:false 102:false" {
+		t.Errorf("Unexpected orders, got %#v", lines)
+	}
+	lines = readLines(t, filepath.Join(dir, "contacts.jsonl"))
+	if strings.Join(lines, " ") != "1:false 2:true" {
+		t.Errorf("Unexpected contacts, got %#v", lines)
+	}
+
+	// Marks survive a new sink
+	account.Objects["orders"][1]["lastupdatets"] = "2026-01-04 10:00:00"
+	sink, err = NewJSONLSink(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mark, err := sink.Mark("orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mark.Updated.Format("2006-01-02") != "2026-01-03" {
+		t.Errorf("Unexpected mark, got %s", mark.Updated)
+	}
+
+	m.Sink = sink
+	err = m.Sync()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines = readLines(t, filepath.Join(dir, "orders.jsonl"))
+	if strings.Join(lines, " ") != "100:false 101:false 102:false 101:false" {
+		t.Errorf("Unexpected orders, got %#v", lines)
+	}
+	since := account.since("orders")
+	expected := []string{"", "", "2026-01-03T10:00:00"}
+	if strings.Join(since, ",") != strings.Join(expected, ",") {
+		t.Errorf("Unexpected lastupdatesince, got %#v, expected %#v", since, expected)
+	}
+
+	// Deletions made before they were enabled are read from the eventstream
+	account.stream = append(account.stream,
+		&ticketmatic.EventstreamItem{Id: "0", Ts: "2026-01-03T12:00:00Z", Type: "orderdeleted", Data: map[string]interface{}{"id": 102}},
+	)
+	m.Deletions = orderDeletions
+	err = m.Sync()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines = readLines(t, filepath.Join(dir, "orders.jsonl"))
+	if lines[len(lines)-1] != "102:true" {
+		t.Errorf("Unexpected orders, got %#v", lines)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "orders.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(data), "{\"id\":102,\"deleted\":true}\n") {
+		t.Errorf("Unexpected deletion line, got %s", data)
+	}
+}
+
+func TestStreamStart(t *testing.T) {
+	account, c := setup(t)
+	sink, err := NewJSONLSink(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Deleted while the resources are synced
+	account.stream = append(account.stream, &ticketmatic.EventstreamItem{
+		Id:   "0",
+		Ts:   time.Now().Add(-2 * time.Second).UTC().Format(time.RFC3339),
+		Type: "orderdeleted",
+		Data: map[string]interface{}{"id": 100},
+	})
+	m := &Mirror{Client: c, Sink: sink, Deletions: orderDeletions}
+	err = m.Sync()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := readLines(t, filepath.Join(sink.dir, "orders.jsonl"))
+	if strings.Join(lines, " ") != "100:false 101:false 102:false 100:true" {
+		t.Errorf("Unexpected orders, got %#v", lines)
+	}
+	mark, err := sink.Mark(StreamMark)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mark.Stream != "1" {
+		t.Errorf("Unexpected eventstream mark, got %#v", mark)
+	}
+}
+
+func orderDeletions(item *ticketmatic.EventstreamItem) (string, int64, bool) {
+	if item.Type != "orderdeleted" {
+		return "", 0, false
+	}
+	return "orders", int64(item.Data["id"].(float64)), true
+}
+
+func TestSQLSink(t *testing.T) {
+	account, c := setup(t)
+
+	db, err := sql.Open("mirrortest", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	sink := NewSQLSink(db)
+	err = sink.Init()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &Mirror{
+		Client:    c,
+		Sink:      sink,
+		Deletions: orderDeletions,
+	}
+	err = m.Sync()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := fakeDatabases[t.Name()]
+	if len(rows["tm_orders"]) != 3 {
+		t.Errorf("Unexpected orders, got %#v", rows["tm_orders"])
+	}
+	if _, ok := rows["tm_contacts"]["2"]; ok {
+		t.Error("Expected deleted contact to be removed")
+	}
+	if row := rows["tm_marks"]["eventstream"]; row == nil || row[1] != "0" {
+		t.Errorf("Unexpected eventstream mark, got %#v", row)
+	}
+
+	account.stream = append(account.stream,
+		&ticketmatic.EventstreamItem{Id: "0", Type: "contactupdated", Data: map[string]interface{}{"id": 1}},
+		&ticketmatic.EventstreamItem{Id: "1", Type: "orderdeleted", Data: map[string]interface{}{"id": 100}},
+	)
+	err = m.Sync()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := rows["tm_orders"]["100"]; ok {
+		t.Error("Expected deleted order to be removed")
+	}
+	if len(rows["tm_orders"]) != 2 {
+		t.Errorf("Unexpected orders, got %#v", rows["tm_orders"])
+	}
+	mark, err := sink.Mark("orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mark.Updated.Format("2006-01-02") != "2026-01-03" {
+		t.Errorf("Unexpected mark, got %s", mark.Updated)
+	}
+
+	sink.Numbered = true
+	q := sink.query("UPDATE %s SET a = ? WHERE b = ?", "t")
+	if q != "UPDATE t SET a = $1 WHERE b = $2" {
+		t.Errorf("Unexpected query, got %#v", q)
+	}
+}
+
+// A minimal database/sql driver that understands the statements of SQLSink.
+// Rows are kept per database name and table, by key.
+var fakeDatabases = make(map[string]map[string]map[string][]driver.Value)
+
+func init() {
+	sql.Register("mirrortest", fakeDriver{})
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	if fakeDatabases[name] == nil {
+		fakeDatabases[name] = make(map[string]map[string][]driver.Value)
+	}
+	return &fakeConn{tables: fakeDatabases[name]}, nil
+}
+
+type fakeConn struct {
+	tables map[string]map[string][]driver.Value
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, fields: strings.Fields(query)}, nil
+}
+
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return c, nil }
+func (c *fakeConn) Commit() error             { return nil }
+func (c *fakeConn) Rollback() error           { return nil }
+
+type fakeStmt struct {
+	conn   *fakeConn
+	fields []string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	tables := s.conn.tables
+	switch s.fields[0] {
+	case "CREATE":
+		tables[s.fields[5]] = make(map[string][]driver.Value)
+	case "UPDATE":
+		table, key := tables[s.fields[1]], fmt.Sprint(args[2])
+		if table[key] == nil {
+			return driver.RowsAffected(0), nil
+		}
+		table[key] = args[:2]
+	case "INSERT":
+		tables[s.fields[2]][fmt.Sprint(args[0])] = args[1:]
+	case "DELETE":
+		delete(tables[s.fields[2]], fmt.Sprint(args[0]))
+	default:
+		return nil, fmt.Errorf("Unexpected statement: %s", strings.Join(s.fields, " "))
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.fields[0] != "SELECT" {
+		return nil, fmt.Errorf("Unexpected query: %s", strings.Join(s.fields, " "))
+	}
+	rows := &fakeRows{}
+	if row := s.conn.tables[s.fields[4]][fmt.Sprint(args[0])]; row != nil {
+		rows.rows = append(rows.rows, row)
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"updated", "stream"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,168 @@
+package mirror
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Columns of the record and mark tables, key first
+var (
+	recordColumns = [3]string{"id", "updated", "data"}
+	markColumns   = [3]string{"resource", "updated", "stream"}
+)
+
+// Stores records in a database/sql database, a table per resource:
+//
+//	CREATE TABLE tm_orders (id BIGINT PRIMARY KEY, updated VARCHAR(32), data TEXT)
+//
+// Each record is stored as JSON in the data column, deleted records are
+// removed. Marks are kept in the tm_marks table. Each Write runs in a
+// transaction, so records and their mark are stored together.
+//
+// Only portable SQL is used (no upserts), any driver will do.
+type SQLSink struct {
+	DB *sql.DB
+
+	// Prefix of the table names (default: "tm_")
+	Prefix string
+
+	// Use numbered placeholders ($1, $2) instead of ?, as needed by
+	// PostgreSQL
+	Numbered bool
+}
+
+// Create a sink that stores records in a database. Call Init to create
+// the tables.
+func NewSQLSink(db *sql.DB) *SQLSink {
+	return &SQLSink{DB: db}
+}
+
+// Create the tables that don't exist yet
+func (s *SQLSink) Init() error {
+	stmts := []string{
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (resource VARCHAR(64) PRIMARY KEY, updated VARCHAR(32), stream VARCHAR(255))", s.table("marks")),
+	}
+	for _, r := range resources {
+		stmts = append(stmts, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (id BIGINT PRIMARY KEY, updated VARCHAR(32), data TEXT)", s.table(r.name)))
+	}
+	for _, stmt := range stmts {
+		_, err := s.DB.Exec(stmt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SQLSink) Mark(resource string) (Mark, error) {
+	var mark Mark
+	var updated, stream string
+	err := s.DB.QueryRow(s.query("SELECT updated, stream FROM %s WHERE resource = ?", s.table("marks")), resource).Scan(&updated, &stream)
+	if err == sql.ErrNoRows {
+		return mark, nil
+	}
+	if err != nil {
+		return mark, err
+	}
+	if updated != "" {
+		mark.Updated, err = time.Parse(time.RFC3339Nano, updated)
+		if err != nil {
+			return mark, err
+		}
+	}
+	mark.Stream = stream
+	return mark, nil
+}
+
+func (s *SQLSink) Write(resource string, records []*Record, mark Mark) error {
+	tx, err := s.DB.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = s.write(tx, resource, records, mark)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *SQLSink) write(tx *sql.Tx, resource string, records []*Record, mark Mark) error {
+	table := s.table(resource)
+	for _, r := range records {
+		if r.Deleted {
+			_, err := tx.Exec(s.query("DELETE FROM %s WHERE id = ?", table), r.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		data, err := json.Marshal(r.Data)
+		if err != nil {
+			return err
+		}
+		err = s.upsert(tx, table, recordColumns, r.Id, formatTime(r.Updated), string(data))
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.upsert(tx, s.table("marks"), markColumns, resource, formatTime(mark.Updated), mark.Stream)
+}
+
+// Update a row, insert it if it doesn't exist
+func (s *SQLSink) upsert(tx *sql.Tx, table string, cols [3]string, id interface{}, a, b string) error {
+	res, err := tx.Exec(s.query("UPDATE %s SET %s = ?, %s = ? WHERE %s = ?", table, cols[1], cols[2], cols[0]), a, b, id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	_, err = tx.Exec(s.query("INSERT INTO %s (%s, %s, %s) VALUES (?, ?, ?)", table, cols[0], cols[1], cols[2]), id, a, b)
+	return err
+}
+
+func (s *SQLSink) table(name string) string {
+	prefix := s.Prefix
+	if prefix == "" {
+		prefix = "tm_"
+	}
+	return prefix + name
+}
+
+// Format a query, replacing the placeholders if needed
+func (s *SQLSink) query(format string, args ...interface{}) string {
+	q := fmt.Sprintf(format, args...)
+	if !s.Numbered {
+		return q
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, c := range q {
+		if c == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+		} else {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,583 @@
+package ticketmatic
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Number of decimals stored in a Money value
+const MoneyDecimals = 4
+
+const moneyScale = 10000
+
+// Money is an exact decimal amount, stored with MoneyDecimals decimals. Unlike
+// float64, summing Money values never accumulates rounding errors.
+//
+// Money marshals to and from a JSON number, so it is compatible with the
+// amounts in the API payloads. The float64 amounts in the existing types can be
+// converted exactly using NewMoneyFromFloat or the Money accessors (such as
+// Order.TotalamountMoney), or decoded straight from the JSON payload with
+// UnmarshalMoney.
+//
+// Money holds amounts up to about ±922 trillion. Operations whose result
+// doesn't fit panic rather than wrapping around.
+type Money struct {
+	units int64
+}
+
+// Rounding modes
+type RoundingMode int
+
+const (
+	// Round half away from zero: 1.005 becomes 1.01, -1.005 becomes -1.01
+	RoundHalfUp RoundingMode = iota
+
+	// Round half to even (banker's rounding): 1.005 becomes 1.00, 1.015 becomes 1.02
+	RoundHalfEven
+
+	// Truncate towards zero
+	RoundDown
+
+	// Round away from zero
+	RoundUp
+)
+
+// Create a Money value from an integer amount of cents.
+func NewMoneyFromCents(cents int64) Money {
+	u, ok := mulInt64(cents, moneyScale/100)
+	if !ok {
+		panic(fmt.Sprintf("Money overflow: %d cents", cents))
+	}
+	return Money{units: u}
+}
+
+// Create a Money value from a float64.
+//
+// Uses the shortest decimal representation of f, which means values decoded
+// from the API (such as Order.Totalamount) are converted exactly.
+func NewMoneyFromFloat(f float64) Money {
+	m, err := ParseMoney(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		// Only happens for NaN and Inf
+		return Money{}
+	}
+	return m
+}
+
+// Parse a decimal string such as "12.30" or "-0.5". Values with more than
+// MoneyDecimals decimals are rounded half to even.
+func ParseMoney(s string) (Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return Money{}, fmt.Errorf("Invalid amount: %q", s)
+	}
+
+	r.Mul(r, big.NewRat(moneyScale, 1))
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		// Compare 2*|rem| with the denominator to see where we are.
+		half := new(big.Int).Abs(rem)
+		half.Lsh(half, 1)
+		c := half.Cmp(r.Denom())
+		if c > 0 || (c == 0 && q.Bit(0) == 1) {
+			q.Add(q, big.NewInt(int64(r.Num().Sign())))
+		}
+	}
+	if !q.IsInt64() {
+		return Money{}, fmt.Errorf("Amount out of range: %q", s)
+	}
+	return Money{units: q.Int64()}, nil
+}
+
+// Parse a decimal string, panic if it fails
+func MustParseMoney(s string) Money {
+	m, err := ParseMoney(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Add two amounts
+func (m Money) Add(o Money) Money {
+	u := m.units + o.units
+	if (u > m.units) != (o.units > 0) {
+		panic(fmt.Sprintf("Money overflow: %s + %s", m, o))
+	}
+	return Money{units: u}
+}
+
+// Subtract an amount
+func (m Money) Sub(o Money) Money {
+	u := m.units - o.units
+	if (u < m.units) != (o.units > 0) {
+		panic(fmt.Sprintf("Money overflow: %s - %s", m, o))
+	}
+	return Money{units: u}
+}
+
+// Multiply by an integer, for instance a number of tickets
+func (m Money) Mul(n int64) Money {
+	u, ok := mulInt64(m.units, n)
+	if !ok {
+		panic(fmt.Sprintf("Money overflow: %s * %d", m, n))
+	}
+	return Money{units: u}
+}
+
+// Divide by an integer. The result is rounded to MoneyDecimals using the
+// given mode. Use Allocate to split an amount without losing cents.
+func (m Money) Div(n int64, mode RoundingMode) Money {
+	if n == -1 {
+		return m.Neg()
+	}
+	return Money{units: divRound(m.units, n, mode)}
+}
+
+// Negate the amount
+func (m Money) Neg() Money {
+	if m.units == math.MinInt64 {
+		panic(fmt.Sprintf("Money overflow: -%s", m))
+	}
+	return Money{units: -m.units}
+}
+
+// Absolute value
+func (m Money) Abs() Money {
+	if m.units < 0 {
+		return m.Neg()
+	}
+	return m
+}
+
+// Sign of the amount: -1, 0 or +1
+func (m Money) Sign() int {
+	switch {
+	case m.units < 0:
+		return -1
+	case m.units > 0:
+		return 1
+	}
+	return 0
+}
+
+// Whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.units == 0
+}
+
+// Compare two amounts: -1 if m < o, 0 if equal, +1 if m > o
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m.units < o.units:
+		return -1
+	case m.units > o.units:
+		return 1
+	}
+	return 0
+}
+
+// Whether two amounts are equal
+func (m Money) Equal(o Money) bool {
+	return m.units == o.units
+}
+
+// Round to the given number of decimals (at most MoneyDecimals).
+func (m Money) Round(decimals int, mode RoundingMode) Money {
+	if decimals >= MoneyDecimals {
+		return m
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+	f := int64(math.Pow10(MoneyDecimals - decimals))
+	u, ok := mulInt64(divRound(m.units, f, mode), f)
+	if !ok {
+		panic(fmt.Sprintf("Money overflow: rounding %s", m))
+	}
+	return Money{units: u}
+}
+
+// Split the amount in n parts that differ at most one cent and sum up exactly
+// to the rounded (to cents) amount. Earlier parts receive the extra cents.
+func (m Money) Allocate(n int) []Money {
+	if n <= 0 {
+		return nil
+	}
+	cents := divRound(m.units, moneyScale/100, RoundHalfUp)
+	base := cents / int64(n)
+	rest := cents % int64(n)
+
+	result := make([]Money, n)
+	for i := range result {
+		c := base
+		if int64(i) < rest {
+			c++
+		} else if int64(i) < -rest {
+			c--
+		}
+		result[i] = NewMoneyFromCents(c)
+	}
+	return result
+}
+
+// Convert to a float64 (for use in the API types). This may lose precision.
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.decimal(), 64)
+	return f
+}
+
+// Integer amount of cents, rounded half up
+func (m Money) Cents() int64 {
+	return divRound(m.units, moneyScale/100, RoundHalfUp)
+}
+
+// Plain decimal representation, with at least two decimals: "12.30", "-0.125"
+func (m Money) String() string {
+	s := m.fixed(MoneyDecimals)
+	for i := 0; i < MoneyDecimals-2 && strings.HasSuffix(s, "0"); i++ {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// Format as a currency amount: the amount is rounded half up to the number of
+// decimals of the currency and formatted with its symbol and separators.
+//
+//	NewMoneyFromFloat(1234.5).Format(EUR) // "€ 1.234,50"
+func (m Money) Format(c Currency) string {
+	rounded := m.Round(c.Decimals, RoundHalfUp)
+	s := rounded.Abs().fixed(c.Decimals)
+
+	intpart, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intpart, frac = s[:i], s[i+1:]
+	}
+
+	// Group the thousands
+	var b strings.Builder
+	for i, ch := range intpart {
+		if i > 0 && (len(intpart)-i)%3 == 0 {
+			b.WriteString(c.GroupSeparator)
+		}
+		b.WriteRune(ch)
+	}
+	if frac != "" {
+		b.WriteString(c.DecimalSeparator)
+		b.WriteString(frac)
+	}
+
+	sign := ""
+	if rounded.Sign() < 0 {
+		sign = "-"
+	}
+	if c.SymbolAfter {
+		return sign + b.String() + " " + c.Symbol
+	}
+	return sign + c.Symbol + " " + b.String()
+}
+
+// Marshal to a JSON number
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.decimal()), nil
+}
+
+// Unmarshal from a JSON number (or a string holding a number). The decimal
+// text is parsed directly, without passing through a float64.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	v, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// Store Money in a database column as a decimal string, which DECIMAL and
+// NUMERIC columns accept without losing precision.
+func (m Money) Value() (driver.Value, error) {
+	return m.decimal(), nil
+}
+
+// Read Money from a database column (a decimal, string, integer or float).
+// NULL is read as zero.
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = Money{}
+	case int64:
+		u, ok := mulInt64(v, moneyScale)
+		if !ok {
+			return fmt.Errorf("Amount out of range: %d", v)
+		}
+		*m = Money{units: u}
+	case float64:
+		*m = NewMoneyFromFloat(v)
+	case string:
+		return m.scanString(v)
+	case []byte:
+		return m.scanString(string(v))
+	default:
+		return fmt.Errorf("Cannot scan %T into Money", src)
+	}
+	return nil
+}
+
+func (m *Money) scanString(s string) error {
+	v, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// Decimal representation with exactly the given number of decimals (at most
+// MoneyDecimals). Extra decimals are truncated, round first if needed.
+func (m Money) fixed(decimals int) string {
+	// Unsigned, so the negation of math.MinInt64 fits
+	u := uint64(m.units)
+	sign := ""
+	if m.units < 0 {
+		sign = "-"
+		u = -u
+	}
+
+	s := fmt.Sprintf("%s%d.%04d", sign, u/moneyScale, u%moneyScale)
+	s = s[:len(s)-(MoneyDecimals-decimals)]
+	return strings.TrimSuffix(s, ".")
+}
+
+// Shortest exact decimal representation
+func (m Money) decimal() string {
+	s := m.fixed(MoneyDecimals)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+func divRound(a, b int64, mode RoundingMode) int64 {
+	if b == 0 {
+		panic(errors.New("Division by zero"))
+	}
+	q, r := a/b, a%b
+	if r == 0 {
+		return q
+	}
+
+	// Direction away from zero
+	dir := int64(1)
+	if (a < 0) != (b < 0) {
+		dir = -1
+	}
+
+	r, b = absInt64(r), absInt64(b)
+	switch mode {
+	case RoundUp:
+		return q + dir
+	case RoundDown:
+		return q
+	case RoundHalfEven:
+		if 2*r > b || (2*r == b && q%2 != 0) {
+			return q + dir
+		}
+		return q
+	default:
+		if 2*r >= b {
+			return q + dir
+		}
+		return q
+	}
+}
+
+// a * b, ok is false when the result doesn't fit in an int64
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if p/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return p, true
+}
+
+func absInt64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Currency formatting rules
+type Currency struct {
+	// ISO 4217 code
+	Code string
+
+	// Symbol used when formatting
+	Symbol string
+
+	// Number of decimals
+	Decimals int
+
+	// Separator between the integer and the decimal part
+	DecimalSeparator string
+
+	// Separator between groups of thousands
+	GroupSeparator string
+
+	// Whether the symbol is placed after the amount
+	SymbolAfter bool
+}
+
+// Commonly used currencies
+var (
+	EUR = Currency{Code: "EUR", Symbol: "€", Decimals: 2, DecimalSeparator: ",", GroupSeparator: "."}
+	USD = Currency{Code: "USD", Symbol: "$", Decimals: 2, DecimalSeparator: ".", GroupSeparator: ","}
+	GBP = Currency{Code: "GBP", Symbol: "£", Decimals: 2, DecimalSeparator: ".", GroupSeparator: ","}
+	CHF = Currency{Code: "CHF", Symbol: "CHF", Decimals: 2, DecimalSeparator: ".", GroupSeparator: "'"}
+	SEK = Currency{Code: "SEK", Symbol: "kr", Decimals: 2, DecimalSeparator: ",", GroupSeparator: " ", SymbolAfter: true}
+	JPY = Currency{Code: "JPY", Symbol: "¥", Decimals: 0, DecimalSeparator: ".", GroupSeparator: ","}
+)
+
+// Look up a currency by its ISO 4217 code.
+func LookupCurrency(code string) (Currency, bool) {
+	for _, c := range []Currency{EUR, USD, GBP, CHF, SEK, JPY} {
+		if strings.EqualFold(c.Code, code) {
+			return c, true
+		}
+	}
+	return Currency{}, false
+}
+
+// Sum a list of amounts
+func SumMoney(amounts ...Money) Money {
+	var total Money
+	for _, a := range amounts {
+		total = total.Add(a)
+	}
+	return total
+}
+
+// Total amount paid, as Money
+func (o *Order) AmountpaidMoney() Money {
+	return NewMoneyFromFloat(o.Amountpaid)
+}
+
+// Total order amount, as Money
+func (o *Order) TotalamountMoney() Money {
+	return NewMoneyFromFloat(o.Totalamount)
+}
+
+// Ticket price, as Money
+func (o *OrderTicket) PriceMoney() Money {
+	return NewMoneyFromFloat(o.Price)
+}
+
+// Service charge, as Money
+func (o *OrderTicket) ServicechargeMoney() Money {
+	return NewMoneyFromFloat(o.Servicecharge)
+}
+
+// Product price, as Money
+func (o *OrderProduct) PriceMoney() Money {
+	return NewMoneyFromFloat(o.Price)
+}
+
+// Payment amount, as Money
+func (o *Payment) AmountMoney() Money {
+	return NewMoneyFromFloat(o.Amount)
+}
+
+// Order fee amount, as Money
+func (o *Ordercost) AmountMoney() Money {
+	return NewMoneyFromFloat(o.Amount)
+}
+
+// Prices, as Money
+func (o *PricelistPrice) PricesMoney() []Money {
+	result := make([]Money, len(o.Prices))
+	for i, p := range o.Prices {
+		result[i] = NewMoneyFromFloat(p)
+	}
+	return result
+}
+
+// Decode a JSON payload into v and into amounts, a struct holding Money fields
+// for the amounts of v (such as OrderAmounts). The Money fields are filled
+// from the raw JSON numbers, without passing through a float64:
+//
+//	var order ticketmatic.Order
+//	var amounts ticketmatic.OrderAmounts
+//	err := ticketmatic.UnmarshalMoney(data, &order, &amounts)
+//
+// The raw payload of a request is available by running it with a
+// *json.RawMessage. Amounts can also be any struct with the JSON keys of v.
+func UnmarshalMoney(data []byte, v, amounts interface{}) error {
+	if v != nil {
+		err := json.Unmarshal(data, v)
+		if err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(data, amounts)
+}
+
+// Amounts of an Order. The slices are in the order of the ones in Order.
+type OrderAmounts struct {
+	Amountpaid  Money                 `json:"amountpaid"`
+	Totalamount Money                 `json:"totalamount"`
+	Tickets     []OrderTicketAmounts  `json:"tickets"`
+	Products    []OrderProductAmounts `json:"products"`
+	Payments    []PaymentAmounts      `json:"payments"`
+	Ordercosts  []OrdercostAmounts    `json:"ordercosts"`
+}
+
+// Amounts of an OrderTicket
+type OrderTicketAmounts struct {
+	Price         Money `json:"price"`
+	Servicecharge Money `json:"servicecharge"`
+}
+
+// Amounts of an OrderProduct
+type OrderProductAmounts struct {
+	Price Money `json:"price"`
+}
+
+// Amounts of a Payment
+type PaymentAmounts struct {
+	Amount Money `json:"amount"`
+}
+
+// Amounts of an Ordercost
+type OrdercostAmounts struct {
+	Amount Money `json:"amount"`
+}
+
+// Amounts of a PricelistPrice
+type PricelistPriceAmounts struct {
+	Prices []Money `json:"prices"`
+}
+
+// Amounts of a PricelistPrices. The prices are in the order of
+// PricelistPrices.Prices.
+type PricelistPricesAmounts struct {
+	Prices []PricelistPriceAmounts `json:"prices"`
+}
+
+// Amounts of a PriceList
+type PriceListAmounts struct {
+	Prices PricelistPricesAmounts `json:"prices"`
+}
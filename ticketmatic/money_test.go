@@ -0,0 +1,265 @@
+package ticketmatic
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestMoneySum(t *testing.T) {
+	var f float64
+	var m Money
+	for i := 0; i < 10000; i++ {
+		f += 0.1
+		m = m.Add(NewMoneyFromFloat(0.1))
+	}
+	if f == 1000 {
+		t.Skip("float64 summed exactly, nothing to compare")
+	}
+	if !m.Equal(MustParseMoney("1000")) {
+		t.Errorf("Unexpected sum, got %s, expected %s", m, "1000.00")
+	}
+}
+
+func TestMoneyParse(t *testing.T) {
+	testcases := []struct {
+		input    string
+		expected string
+	}{
+		{"12.3", "12.30"},
+		{"-0.5", "-0.50"},
+		{"0", "0.00"},
+		{"1e3", "1000.00"},
+		{"0.12345", "0.1234"},
+		{"0.12355", "0.1236"},
+		{"-0.00005", "0.00"},
+	}
+	for _, tc := range testcases {
+		m, err := ParseMoney(tc.input)
+		if err != nil {
+			t.Fatalf("parse '%s' failed: %+v", tc.input, err)
+		}
+		if m.String() != tc.expected {
+			t.Errorf("Unexpected value for '%s', got '%s', expected '%s'", tc.input, m, tc.expected)
+		}
+	}
+
+	_, err := ParseMoney("abc")
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestMoneyRound(t *testing.T) {
+	testcases := []struct {
+		input    string
+		mode     RoundingMode
+		expected string
+	}{
+		{"1.005", RoundHalfUp, "1.01"},
+		{"-1.005", RoundHalfUp, "-1.01"},
+		{"1.005", RoundHalfEven, "1.00"},
+		{"1.015", RoundHalfEven, "1.02"},
+		{"1.019", RoundDown, "1.01"},
+		{"-1.011", RoundUp, "-1.02"},
+	}
+	for _, tc := range testcases {
+		got := MustParseMoney(tc.input).Round(2, tc.mode)
+		if got.String() != tc.expected {
+			t.Errorf("Unexpected rounding of '%s', got '%s', expected '%s'", tc.input, got, tc.expected)
+		}
+	}
+}
+
+func TestMoneyOverflow(t *testing.T) {
+	max := MustParseMoney("922337203685477.5807")
+	min := MustParseMoney("-922337203685477.5808")
+	unit := MustParseMoney("0.0001")
+
+	// Results at the boundaries still fit
+	if got := max.Sub(unit).Add(unit); !got.Equal(max) {
+		t.Errorf("Unexpected sum, got %s, expected %s", got, max)
+	}
+	if got := min.Add(unit).Sub(unit); !got.Equal(min) {
+		t.Errorf("Unexpected difference, got %s, expected %s", got, min)
+	}
+	if got := max.Neg().Sub(unit); !got.Equal(min) {
+		t.Errorf("Unexpected difference, got %s, expected %s", got, min)
+	}
+	if got := min.Add(max).Mul(-1); !got.Equal(unit) {
+		t.Errorf("Unexpected product, got %s, expected %s", got, unit)
+	}
+	if got := min.Cmp(max); got != -1 {
+		t.Errorf("Unexpected comparison, got %d, expected %d", got, -1)
+	}
+	if got := min.String(); got != "-922337203685477.5808" {
+		t.Errorf("Unexpected string, got '%s', expected '%s'", got, "-922337203685477.5808")
+	}
+
+	testcases := []struct {
+		name string
+		op   func() Money
+	}{
+		{"max + unit", func() Money { return max.Add(unit) }},
+		{"min - unit", func() Money { return min.Sub(unit) }},
+		{"max - min", func() Money { return max.Sub(min) }},
+		{"min + min", func() Money { return min.Add(min) }},
+		{"max * 2", func() Money { return max.Mul(2) }},
+		{"min * -1", func() Money { return min.Mul(-1) }},
+		{"min / -1", func() Money { return min.Div(-1, RoundHalfUp) }},
+		{"-min", func() Money { return min.Neg() }},
+		{"|min|", func() Money { return min.Abs() }},
+		{"round max", func() Money { return max.Round(2, RoundUp) }},
+		{"max cents", func() Money { return NewMoneyFromCents(math.MaxInt64) }},
+	}
+	for _, tc := range testcases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected a panic for %s!", tc.name)
+				}
+			}()
+			tc.op()
+		}()
+	}
+
+	var m Money
+	err := m.Scan(int64(math.MaxInt64))
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	parts := MustParseMoney("10").Allocate(3)
+	expected := []string{"3.34", "3.33", "3.33"}
+	for i, p := range parts {
+		if p.String() != expected[i] {
+			t.Errorf("Unexpected parts[%d], got '%s', expected '%s'", i, p, expected[i])
+		}
+	}
+	if !SumMoney(parts...).Equal(MustParseMoney("10")) {
+		t.Errorf("Parts don't sum up: %v", parts)
+	}
+
+	neg := MustParseMoney("-10").Allocate(3)
+	if !SumMoney(neg...).Equal(MustParseMoney("-10")) {
+		t.Errorf("Parts don't sum up: %v", neg)
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	testcases := []struct {
+		input    string
+		currency Currency
+		expected string
+	}{
+		{"1234.5", EUR, "€ 1.234,50"},
+		{"-1234567.891", USD, "-$ 1,234,567.89"},
+		{"1234.5", JPY, "¥ 1,235"},
+		{"99", SEK, "99,00 kr"},
+		{"0.001", EUR, "€ 0,00"},
+	}
+	for _, tc := range testcases {
+		got := MustParseMoney(tc.input).Format(tc.currency)
+		if got != tc.expected {
+			t.Errorf("Unexpected format of '%s', got '%s', expected '%s'", tc.input, got, tc.expected)
+		}
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	var obj struct {
+		Amount Money   `json:"amount"`
+		Prices []Money `json:"prices"`
+	}
+	err := json.Unmarshal([]byte(`{"amount": 19.99, "prices": [10, "2.5", null]}`), &obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.Amount.String() != "19.99" || obj.Prices[1].String() != "2.50" {
+		t.Errorf("Unexpected values, got %s and %s", obj.Amount, obj.Prices[1])
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"amount":19.99,"prices":[10,2.5,0]}` {
+		t.Errorf("Unexpected JSON, got %s", data)
+	}
+
+	order := &Order{Totalamount: 19.99}
+	if !order.TotalamountMoney().Equal(obj.Amount) {
+		t.Errorf("Unexpected total amount, got %s", order.TotalamountMoney())
+	}
+}
+
+func TestMoneySQL(t *testing.T) {
+	v, err := MustParseMoney("19.99").Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "19.99" {
+		t.Errorf("Unexpected value, got %#v, expected %#v", v, "19.99")
+	}
+
+	for _, src := range []interface{}{"19.99", []byte("19.99"), 19.99} {
+		var m Money
+		err := m.Scan(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.String() != "19.99" {
+			t.Errorf("Unexpected amount for %#v, got %s", src, m)
+		}
+	}
+
+	var m Money
+	err = m.Scan(int64(20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.String() != "20.00" {
+		t.Errorf("Unexpected amount, got %s", m)
+	}
+	err = m.Scan(true)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestUnmarshalMoney(t *testing.T) {
+	data := []byte(`{"totalamount": 0.30000000000000004, "amountpaid": 12.5,
+		"tickets": [{"price": 10.1, "servicecharge": 0.2}], "payments": [{"amount": 12.5}]}`)
+
+	var order Order
+	var amounts OrderAmounts
+	err := UnmarshalMoney(data, &order, &amounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.Amountpaid != 12.5 || len(order.Tickets) != 1 {
+		t.Errorf("Unexpected order, got %#v", order)
+	}
+	if amounts.Totalamount.String() != "0.30" || amounts.Amountpaid.String() != "12.50" {
+		t.Errorf("Unexpected amounts, got %s and %s", amounts.Totalamount, amounts.Amountpaid)
+	}
+	if len(amounts.Tickets) != 1 || amounts.Tickets[0].Price.String() != "10.10" || amounts.Payments[0].Amount.String() != "12.50" {
+		t.Errorf("Unexpected amounts, got %#v", amounts)
+	}
+
+	var pricelist PriceListAmounts
+	err = UnmarshalMoney([]byte(`{"prices": {"prices": [{"prices": [10, 7.5]}]}}`), nil, &pricelist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := pricelist.Prices.Prices; len(p) != 1 || p[0].Prices[1].String() != "7.50" {
+		t.Errorf("Unexpected prices, got %#v", pricelist)
+	}
+
+	err = UnmarshalMoney([]byte(`{"totalamount": "abc"}`), nil, &amounts)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
@@ -0,0 +1,309 @@
+package orders
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Builder steps
+const (
+	StepCreate      = "create"
+	StepLoad        = "load"
+	StepAddTickets  = "addtickets"
+	StepAddProducts = "addproducts"
+	StepAddPayments = "addpayments"
+	StepConfirm     = "confirm"
+)
+
+// Rollback steps
+const (
+	StepDeleteTickets  = "deletetickets"
+	StepDeleteProducts = "deleteproducts"
+	StepAddRefunds     = "addrefunds"
+	StepDelete         = "delete"
+)
+
+// Outcome of a single step executed by a Builder
+type StepResult struct {
+	// Step name, one of the Step constants
+	Step string
+
+	// IDs of the tickets, products or payments affected by the step
+	Ids []int64
+
+	// Error, if the step failed
+	Err error
+}
+
+func (s *StepResult) String() string {
+	if s.Err != nil {
+		return fmt.Sprintf("%s %v: %s", s.Step, s.Ids, s.Err)
+	}
+	return fmt.Sprintf("%s %v: ok", s.Step, s.Ids)
+}
+
+// Error returned when building an order failed. It lists all executed steps,
+// including the ones that were executed to roll back the changes.
+type BuildError struct {
+	// Order ID, zero if the order wasn't created
+	Orderid int64
+
+	// The step that failed
+	Step string
+
+	// The error returned by the failed step
+	Err error
+
+	// Steps that were executed, in order
+	Steps []*StepResult
+
+	// Steps that were executed to undo the changes, in order
+	Rollback []*StepResult
+}
+
+// Whether all changes were undone successfully.
+func (e *BuildError) RolledBack() bool {
+	for _, s := range e.Rollback {
+		if s.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *BuildError) Error() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Building order %d failed in step %s: %s", e.Orderid, e.Step, e.Err)
+	for _, s := range e.Steps {
+		fmt.Fprintf(&buf, "\n  %s", s)
+	}
+	if len(e.Rollback) > 0 {
+		if e.RolledBack() {
+			buf.WriteString("\nRolled back:")
+		} else {
+			buf.WriteString("\nRollback failed:")
+		}
+		for _, s := range e.Rollback {
+			fmt.Fprintf(&buf, "\n  %s", s)
+		}
+	}
+	return buf.String()
+}
+
+// Builds an order in multiple steps: create the order, add tickets, products
+// and payments and optionally confirm it. When one of the steps fails,
+// everything that was done is undone again.
+//
+//	order, err := orders.NewBuilder(client, &ticketmatic.CreateOrder{Saleschannelid: 1}).
+//		AddTickets(&ticketmatic.AddTickets{...}).
+//		AddPayments(&ticketmatic.AddPayments{...}).
+//		Confirm().
+//		Execute()
+//
+// A failure is reported as a *BuildError.
+type Builder struct {
+	client  *ticketmatic.Client
+	create  *ticketmatic.CreateOrder
+	orderid int64
+	ops     []builderOp
+}
+
+type builderOp struct {
+	step     string
+	tickets  *ticketmatic.AddTickets
+	products *ticketmatic.AddProducts
+	payments *ticketmatic.AddPayments
+}
+
+// Start building a new order.
+func NewBuilder(client *ticketmatic.Client, data *ticketmatic.CreateOrder) *Builder {
+	return &Builder{
+		client: client,
+		create: data,
+	}
+}
+
+// Start building on an existing order. The order itself will not be deleted
+// on rollback. Loading the order is reported as StepLoad.
+func NewBuilderFor(client *ticketmatic.Client, id int64) *Builder {
+	return &Builder{
+		client:  client,
+		orderid: id,
+	}
+}
+
+// Add tickets to the order.
+func (b *Builder) AddTickets(data *ticketmatic.AddTickets) *Builder {
+	b.ops = append(b.ops, builderOp{step: StepAddTickets, tickets: data})
+	return b
+}
+
+// Add products to the order.
+func (b *Builder) AddProducts(data *ticketmatic.AddProducts) *Builder {
+	b.ops = append(b.ops, builderOp{step: StepAddProducts, products: data})
+	return b
+}
+
+// Add a payment to the order.
+func (b *Builder) AddPayments(data *ticketmatic.AddPayments) *Builder {
+	b.ops = append(b.ops, builderOp{step: StepAddPayments, payments: data})
+	return b
+}
+
+// Confirm the order once all items and payments are added.
+func (b *Builder) Confirm() *Builder {
+	b.ops = append(b.ops, builderOp{step: StepConfirm})
+	return b
+}
+
+// Execute all steps. Returns the resulting order.
+func (b *Builder) Execute() (*ticketmatic.Order, error) {
+	t := &transaction{
+		client:   b.client,
+		orderid:  b.orderid,
+		payments: make(map[int64]bool),
+	}
+
+	if b.orderid == 0 {
+		order, err := Create(b.client, b.create)
+		if err != nil {
+			return nil, t.fail(StepCreate, nil, err)
+		}
+		t.created = true
+		t.orderid = order.Orderid
+		t.done(StepCreate, []int64{order.Orderid}, order)
+	} else {
+		order, err := Get(b.client, b.orderid)
+		if err != nil {
+			return nil, t.fail(StepLoad, []int64{b.orderid}, err)
+		}
+		t.order = order
+		t.remember(order)
+	}
+
+	for _, op := range b.ops {
+		err := t.apply(op)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t.order, nil
+}
+
+// State of a single Execute run
+type transaction struct {
+	client  *ticketmatic.Client
+	orderid int64
+	created bool
+	order   *ticketmatic.Order
+
+	tickets  []int64
+	products []int64
+
+	// Payments that existed before or were refunded already
+	payments map[int64]bool
+	added    []*ticketmatic.Payment
+
+	steps []*StepResult
+}
+
+func (t *transaction) apply(op builderOp) error {
+	switch op.step {
+	case StepAddTickets:
+		res, err := Addtickets(t.client, t.orderid, op.tickets)
+		if err != nil {
+			return t.fail(op.step, nil, err)
+		}
+		t.tickets = append(t.tickets, res.Ids...)
+		if len(res.Ids) != len(op.tickets.Tickets) {
+			return t.fail(op.step, res.Ids, fmt.Errorf("Added %d of %d tickets", len(res.Ids), len(op.tickets.Tickets)))
+		}
+		t.done(op.step, res.Ids, res.Order)
+	case StepAddProducts:
+		res, err := Addproducts(t.client, t.orderid, op.products)
+		if err != nil {
+			return t.fail(op.step, nil, err)
+		}
+		t.products = append(t.products, res.Ids...)
+		if len(res.Ids) != len(op.products.Products) {
+			return t.fail(op.step, res.Ids, fmt.Errorf("Added %d of %d products", len(res.Ids), len(op.products.Products)))
+		}
+		t.done(op.step, res.Ids, res.Order)
+	case StepAddPayments:
+		order, err := Addpayments(t.client, t.orderid, op.payments)
+		if err != nil {
+			return t.fail(op.step, nil, err)
+		}
+		var ids []int64
+		for _, p := range order.Payments {
+			if !t.payments[p.Id] {
+				t.payments[p.Id] = true
+				t.added = append(t.added, p)
+				ids = append(ids, p.Id)
+			}
+		}
+		t.done(op.step, ids, order)
+	case StepConfirm:
+		order, err := Confirm(t.client, t.orderid)
+		if err != nil {
+			return t.fail(op.step, nil, err)
+		}
+		t.done(op.step, nil, order)
+	}
+	return nil
+}
+
+func (t *transaction) done(step string, ids []int64, order *ticketmatic.Order) {
+	t.steps = append(t.steps, &StepResult{Step: step, Ids: ids})
+	if order != nil {
+		t.order = order
+		t.remember(order)
+	}
+}
+
+func (t *transaction) remember(order *ticketmatic.Order) {
+	for _, p := range order.Payments {
+		t.payments[p.Id] = true
+	}
+}
+
+// Record the failure, undo all changes and build the resulting error.
+func (t *transaction) fail(step string, ids []int64, err error) error {
+	t.steps = append(t.steps, &StepResult{Step: step, Ids: ids, Err: err})
+
+	e := &BuildError{
+		Orderid: t.orderid,
+		Step:    step,
+		Err:     err,
+		Steps:   t.steps,
+	}
+	if t.orderid == 0 {
+		return e
+	}
+
+	// Undo in reverse order of dependencies: refund payments first, then
+	// remove the items, then the order itself.
+	for i := len(t.added) - 1; i >= 0; i-- {
+		p := t.added[i]
+		_, err := Addrefunds(t.client, t.orderid, &ticketmatic.AddRefunds{
+			Amount:    p.Amount,
+			Paymentid: p.Id,
+		})
+		e.Rollback = append(e.Rollback, &StepResult{Step: StepAddRefunds, Ids: []int64{p.Id}, Err: err})
+	}
+	if len(t.products) > 0 {
+		_, err := Deleteproducts(t.client, t.orderid, &ticketmatic.DeleteProducts{Products: t.products})
+		e.Rollback = append(e.Rollback, &StepResult{Step: StepDeleteProducts, Ids: t.products, Err: err})
+	}
+	if len(t.tickets) > 0 {
+		_, err := Deletetickets(t.client, t.orderid, &ticketmatic.DeleteTickets{Tickets: t.tickets})
+		e.Rollback = append(e.Rollback, &StepResult{Step: StepDeleteTickets, Ids: t.tickets, Err: err})
+	}
+	if t.created {
+		err := Delete(t.client, t.orderid)
+		e.Rollback = append(e.Rollback, &StepResult{Step: StepDelete, Ids: []int64{t.orderid}, Err: err})
+	}
+	return e
+}
@@ -0,0 +1,144 @@
+package orders
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestBuilderRollback(t *testing.T) {
+	var calls []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		order := &ticketmatic.Order{Orderid: 42}
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/1/test/orders":
+			json.NewEncoder(w).Encode(order)
+		case "POST /api/1/test/orders/42/tickets":
+			json.NewEncoder(w).Encode(&ticketmatic.AddItemsResult{Ids: []int64{1, 2}, Order: order})
+		case "POST /api/1/test/orders/42/payments":
+			order.Payments = []*ticketmatic.Payment{{Id: 7, Amount: 20}}
+			json.NewEncoder(w).Encode(order)
+		case "POST /api/1/test/orders/42/products":
+			w.WriteHeader(400)
+			w.Write([]byte(`{"code": 400, "message": "Product sold out"}`))
+		default:
+			json.NewEncoder(w).Encode(order)
+		}
+	}))
+	defer srv.Close()
+
+	server := ticketmatic.Server
+	ticketmatic.Server = srv.URL
+	defer func() { ticketmatic.Server = server }()
+
+	c := ticketmatic.NewClient("test", "key", "secret")
+	_, err := NewBuilder(c, &ticketmatic.CreateOrder{Saleschannelid: 1}).
+		AddTickets(&ticketmatic.AddTickets{Tickets: []*ticketmatic.CreateTicket{{}, {}}}).
+		AddPayments(&ticketmatic.AddPayments{Amount: 20, Paymentmethodid: 1}).
+		AddProducts(&ticketmatic.AddProducts{Products: []*ticketmatic.CreateProduct{{Productid: 3}}}).
+		Confirm().
+		Execute()
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+
+	e, ok := err.(*BuildError)
+	if !ok {
+		t.Fatalf("Unexpected error type %T", err)
+	}
+	if e.Step != StepAddProducts {
+		t.Errorf("Unexpected e.Step, got %#v, expected %#v", e.Step, StepAddProducts)
+	}
+	if !e.RolledBack() {
+		t.Errorf("Expected rollback to succeed: %s", e)
+	}
+
+	expected := []string{
+		"POST /api/1/test/orders",
+		"POST /api/1/test/orders/42/tickets",
+		"POST /api/1/test/orders/42/payments",
+		"POST /api/1/test/orders/42/products",
+		"POST /api/1/test/orders/42/refunds",
+		"DELETE /api/1/test/orders/42/tickets",
+		"DELETE /api/1/test/orders/42",
+	}
+	if len(calls) != len(expected) {
+		t.Fatalf("Unexpected calls, got %#v, expected %#v", calls, expected)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("Unexpected calls[%d], got %#v, expected %#v", i, calls[i], expected[i])
+		}
+	}
+}
+
+func TestBuilderPartialAdd(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		order := &ticketmatic.Order{Orderid: 42}
+		if r.URL.Path == "/api/1/test/orders/42/tickets" && r.Method == "POST" {
+			json.NewEncoder(w).Encode(&ticketmatic.AddItemsResult{Ids: []int64{1}, Order: order})
+			return
+		}
+		json.NewEncoder(w).Encode(order)
+	}))
+	defer srv.Close()
+
+	server := ticketmatic.Server
+	ticketmatic.Server = srv.URL
+	defer func() { ticketmatic.Server = server }()
+
+	c := ticketmatic.NewClient("test", "key", "secret")
+	_, err := NewBuilderFor(c, 42).
+		AddTickets(&ticketmatic.AddTickets{Tickets: []*ticketmatic.CreateTicket{{}, {}}}).
+		Execute()
+	e, ok := err.(*BuildError)
+	if !ok {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	if len(e.Rollback) != 1 || e.Rollback[0].Step != StepDeleteTickets {
+		t.Fatalf("Unexpected rollback %v", e.Rollback)
+	}
+	if len(e.Rollback[0].Ids) != 1 || e.Rollback[0].Ids[0] != 1 {
+		t.Errorf("Unexpected rollback ids, got %#v", e.Rollback[0].Ids)
+	}
+}
+
+func TestBuilderLoad(t *testing.T) {
+	var calls []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(404)
+		w.Write([]byte(`{"code": 404, "message": "Order not found"}`))
+	}))
+	defer srv.Close()
+
+	server := ticketmatic.Server
+	ticketmatic.Server = srv.URL
+	defer func() { ticketmatic.Server = server }()
+
+	c := ticketmatic.NewClient("test", "key", "secret")
+	_, err := NewBuilderFor(c, 42).
+		AddTickets(&ticketmatic.AddTickets{Tickets: []*ticketmatic.CreateTicket{{}}}).
+		Execute()
+	e, ok := err.(*BuildError)
+	if !ok {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	if e.Step != StepLoad {
+		t.Errorf("Unexpected e.Step, got %#v, expected %#v", e.Step, StepLoad)
+	}
+	if len(e.Steps) != 1 || e.Steps[0].Step != StepLoad || len(e.Steps[0].Ids) != 1 || e.Steps[0].Ids[0] != 42 {
+		t.Errorf("Unexpected steps %v", e.Steps)
+	}
+	if len(e.Rollback) != 0 {
+		t.Errorf("Unexpected rollback %v", e.Rollback)
+	}
+	if len(calls) != 1 || calls[0] != "GET /api/1/test/orders/42" {
+		t.Errorf("Unexpected calls, got %#v", calls)
+	}
+}
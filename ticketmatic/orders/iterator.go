@@ -0,0 +1,84 @@
+package orders
+
+import (
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Default number of orders fetched per page by an Iterator
+const DefaultPageSize = 100
+
+// Iterates over all orders matching a query, fetching them page by page.
+//
+//	it := orders.NewIterator(client, &ticketmatic.OrderQuery{Filter: "..."})
+//	for {
+//		order, err := it.Next()
+//		if err != nil {
+//			return err
+//		}
+//		if order == nil {
+//			break
+//		}
+//		...
+//	}
+type Iterator struct {
+	client *ticketmatic.Client
+	params ticketmatic.OrderQuery
+
+	page    []*ticketmatic.Order
+	pos     int
+	done    bool
+	lookups *Lookups
+}
+
+// Create a new iterator. The Limit of the query is used as page size (defaults
+// to DefaultPageSize), the Offset as starting point.
+func NewIterator(client *ticketmatic.Client, params *ticketmatic.OrderQuery) *Iterator {
+	it := &Iterator{
+		client: client,
+	}
+	if params != nil {
+		it.params = *params
+	}
+	if it.params.Limit <= 0 {
+		it.params.Limit = DefaultPageSize
+	}
+	return it
+}
+
+// Fetch the next order, returns nil when all orders have been returned.
+func (it *Iterator) Next() (*ticketmatic.Order, error) {
+	for it.pos >= len(it.page) {
+		if it.done {
+			return nil, nil
+		}
+		err := it.fetch()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	order := it.page[it.pos]
+	it.pos++
+	return order, nil
+}
+
+// Lookup data of the current page
+func (it *Iterator) Lookups() *Lookups {
+	return it.lookups
+}
+
+func (it *Iterator) fetch() error {
+	list, err := Getlist(it.client, &it.params)
+	if err != nil {
+		return err
+	}
+
+	it.page = list.Data
+	it.pos = 0
+	it.lookups = list.Lookups
+	it.params.Offset += int64(len(list.Data))
+	if int64(len(list.Data)) < it.params.Limit || (list.NbrOfResults > 0 && it.params.Offset >= int64(list.NbrOfResults)) {
+		it.done = true
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package orders
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/contacts"
+	"github.com/ticketmatic/tm-go/ticketmatic/events"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apiutil"
+	"github.com/ticketmatic/tm-go/ticketmatic/registry"
+)
+
+// An order with the objects it refers to. Fields are nil when the order
+// doesn't refer to an object or it doesn't exist (anymore).
+type OrderView struct {
+	*ticketmatic.Order
+
+	Contact          *ticketmatic.Contact
+	SalesChannel     *ticketmatic.SalesChannel
+	PaymentScenario  *ticketmatic.PaymentScenario
+	DeliveryScenario *ticketmatic.DeliveryScenario
+
+	Tickets  []*TicketView
+	Products []*ProductView
+	Payments []*PaymentView
+}
+
+// A ticket with the objects it refers to
+type TicketView struct {
+	*ticketmatic.OrderTicket
+
+	Event     *ticketmatic.Event
+	PriceType *ticketmatic.PriceType
+
+	// Sales channel of the order
+	SalesChannel *ticketmatic.SalesChannel
+
+	Ticketholder *ticketmatic.Contact
+}
+
+// A product with the objects it refers to
+type ProductView struct {
+	*ticketmatic.OrderProduct
+
+	Product *ticketmatic.Product
+	Contact *ticketmatic.Contact
+}
+
+// A payment with the objects it refers to
+type PaymentView struct {
+	*ticketmatic.Payment
+
+	PaymentMethod *ticketmatic.PaymentMethod
+}
+
+// Resolves the references of orders into views.
+//
+// Objects are taken from the lookup data of the list the orders came from.
+// Settings that are missing from it are taken from the registry, events and
+// contacts are fetched and cached. At most MaxCached events and contacts are
+// kept, the oldest ones are dropped first. Cached objects aren't refreshed:
+// use a resolver per batch of orders when they may change.
+//
+//	it := orders.NewIterator(client, query)
+//	res := orders.NewResolver(client, reg)
+//	for {
+//		order, err := it.Next()
+//		...
+//		view, err := res.Resolve(order, it.Lookups())
+//		for _, t := range view.Tickets {
+//			fmt.Println(t.Event.Name, t.PriceType.Name)
+//		}
+//	}
+//
+// A Resolver is safe for concurrent use.
+type Resolver struct {
+	// Maximum number of events and of contacts that are cached, defaults to
+	// DefaultMaxCached
+	MaxCached int
+
+	client   *ticketmatic.Client
+	registry *registry.Registry
+
+	mutex    sync.Mutex
+	events   fetchCache[ticketmatic.Event]
+	contacts fetchCache[ticketmatic.Contact]
+}
+
+// Default number of events and of contacts cached by a Resolver
+const DefaultMaxCached = 10000
+
+// Create a resolver. Uses a new registry if reg is nil.
+func NewResolver(client *ticketmatic.Client, reg *registry.Registry) *Resolver {
+	if reg == nil {
+		reg = registry.New(client)
+	}
+	return &Resolver{
+		client:   client,
+		registry: reg,
+	}
+}
+
+// Resolve the orders of a list, using its lookup data
+func (r *Resolver) ResolveList(list *List) ([]*OrderView, error) {
+	result := make([]*OrderView, 0, len(list.Data))
+	for _, order := range list.Data {
+		view, err := r.Resolve(order, list.Lookups)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, view)
+	}
+	return result, nil
+}
+
+// Resolve the references of an order. Lookups can be nil.
+func (r *Resolver) Resolve(order *ticketmatic.Order, lookups *Lookups) (*OrderView, error) {
+	if lookups == nil {
+		lookups = &Lookups{}
+	}
+
+	var err error
+	view := &OrderView{Order: order}
+	view.Contact, err = r.contact(lookups, order.Customerid)
+	if err != nil {
+		return nil, err
+	}
+	view.SalesChannel, err = lookup(lookups.Saleschannels, order.Saleschannelid, r.registry.SalesChannels)
+	if err != nil {
+		return nil, err
+	}
+	view.PaymentScenario, err = lookup(lookups.Paymentscenarios, order.Paymentscenarioid, r.registry.PaymentScenarios)
+	if err != nil {
+		return nil, err
+	}
+	view.DeliveryScenario, err = lookup(lookups.Deliveryscenarios, order.Deliveryscenarioid, r.registry.DeliveryScenarios)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range order.Tickets {
+		tv := &TicketView{
+			OrderTicket:  t,
+			SalesChannel: view.SalesChannel,
+		}
+		tv.Event, err = r.event(lookups, t.Eventid)
+		if err != nil {
+			return nil, err
+		}
+		tv.PriceType, err = lookup(lookups.Pricetypes, t.Pricetypeid, r.registry.PriceTypes)
+		if err != nil {
+			return nil, err
+		}
+		tv.Ticketholder, err = r.contact(lookups, t.Ticketholderid)
+		if err != nil {
+			return nil, err
+		}
+		view.Tickets = append(view.Tickets, tv)
+	}
+
+	for _, p := range order.Products {
+		pv := &ProductView{OrderProduct: p}
+		pv.Product, err = lookup(lookups.Products, p.Productid, r.registry.Products)
+		if err != nil {
+			return nil, err
+		}
+		pv.Contact, err = r.contact(lookups, p.Contactid)
+		if err != nil {
+			return nil, err
+		}
+		view.Products = append(view.Products, pv)
+	}
+
+	for _, p := range order.Payments {
+		pv := &PaymentView{Payment: p}
+		pv.PaymentMethod, err = lookup(lookups.Paymentmethods, p.Paymentmethodid, r.registry.PaymentMethods)
+		if err != nil {
+			return nil, err
+		}
+		view.Payments = append(view.Payments, pv)
+	}
+	return view, nil
+}
+
+// Look up a settings object in the lookup data, falling back to the registry
+func lookup[T any](m map[string]*T, id int64, cache *registry.Cache[T]) (*T, error) {
+	if id == 0 {
+		return nil, nil
+	}
+	if o, ok := m[strconv.FormatInt(id, 10)]; ok {
+		return o, nil
+	}
+	return cache.Find(id)
+}
+
+func (r *Resolver) event(lookups *Lookups, id int64) (*ticketmatic.Event, error) {
+	if id == 0 {
+		return nil, nil
+	}
+	if e, ok := lookups.Events[strconv.FormatInt(id, 10)]; ok {
+		return e, nil
+	}
+
+	r.mutex.Lock()
+	e, ok := r.events.get(id)
+	r.mutex.Unlock()
+	if ok {
+		return e, nil
+	}
+
+	e, err := events.Get(r.client, id)
+	if apiutil.IsNotFound(err) {
+		e, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.mutex.Lock()
+	r.events.put(id, e, r.maxCached())
+	r.mutex.Unlock()
+	return e, nil
+}
+
+func (r *Resolver) contact(lookups *Lookups, id int64) (*ticketmatic.Contact, error) {
+	if id == 0 {
+		return nil, nil
+	}
+	if c, ok := lookups.Contacts[strconv.FormatInt(id, 10)]; ok {
+		return c, nil
+	}
+
+	r.mutex.Lock()
+	c, ok := r.contacts.get(id)
+	r.mutex.Unlock()
+	if ok {
+		return c, nil
+	}
+
+	c, err := contacts.Get(r.client, id, nil)
+	if apiutil.IsNotFound(err) {
+		c, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.mutex.Lock()
+	r.contacts.put(id, c, r.maxCached())
+	r.mutex.Unlock()
+	return c, nil
+}
+
+func (r *Resolver) maxCached() int {
+	if r.MaxCached <= 0 {
+		return DefaultMaxCached
+	}
+	return r.MaxCached
+}
+
+// Fetched objects by id, the oldest are dropped when it is full. Missing
+// objects are cached as nil.
+type fetchCache[T any] struct {
+	items map[int64]*T
+	order []int64
+}
+
+func (c *fetchCache[T]) get(id int64) (*T, bool) {
+	o, ok := c.items[id]
+	return o, ok
+}
+
+func (c *fetchCache[T]) put(id int64, o *T, max int) {
+	if c.items == nil {
+		c.items = make(map[int64]*T)
+	}
+	if _, ok := c.items[id]; !ok {
+		for len(c.order) >= max {
+			delete(c.items, c.order[0])
+			c.order = c.order[1:]
+		}
+		c.order = append(c.order, id)
+	}
+	c.items[id] = o
+}
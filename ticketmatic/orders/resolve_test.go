@@ -0,0 +1,123 @@
+package orders
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestResolve(t *testing.T) {
+	var calls []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+
+		switch r.URL.Path {
+		case "/api/1/test/settings/ticketsales/saleschannels":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": []*ticketmatic.SalesChannel{{Id: 5, Name: "Web"}},
+			})
+		case "/api/1/test/events/11":
+			json.NewEncoder(w).Encode(&ticketmatic.Event{Id: 11, Name: "Play"})
+		default:
+			w.WriteHeader(404)
+			w.Write([]byte(`{"code": 404, "message": "Not found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := ticketmatic.NewClient("test", "key", "secret")
+	c.Server = srv.URL
+
+	list := &List{
+		Data: []*ticketmatic.Order{
+			{
+				Orderid:        1,
+				Customerid:     20,
+				Saleschannelid: 5,
+				Tickets: []*ticketmatic.OrderTicket{
+					{Id: 100, Eventid: 10, Pricetypeid: 1},
+					{Id: 101, Eventid: 11, Pricetypeid: 1},
+				},
+			},
+			{
+				Orderid:        2,
+				Saleschannelid: 5,
+				Tickets: []*ticketmatic.OrderTicket{
+					{Id: 102, Eventid: 11, Pricetypeid: 1},
+				},
+			},
+		},
+		Lookups: &Lookups{
+			Events:     map[string]*ticketmatic.Event{"10": {Id: 10, Name: "Concert"}},
+			Pricetypes: map[string]*ticketmatic.PriceType{"1": {Id: 1, Name: "Regular"}},
+		},
+	}
+
+	views, err := NewResolver(c, nil).ResolveList(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(views) != 2 {
+		t.Fatalf("Unexpected views, got %#v", views)
+	}
+
+	order := views[0]
+	if order.Contact != nil {
+		t.Errorf("Unexpected contact, got %#v, expected nil", order.Contact)
+	}
+	if order.SalesChannel == nil || order.SalesChannel.Name != "Web" {
+		t.Errorf("Unexpected sales channel, got %#v", order.SalesChannel)
+	}
+	if order.Orderid != 1 {
+		t.Errorf("Unexpected order id, got %#v, expected %#v", order.Orderid, 1)
+	}
+
+	names := []string{}
+	for _, v := range views {
+		for _, ticket := range v.Tickets {
+			names = append(names, ticket.Event.Name+"/"+ticket.PriceType.Name+"/"+ticket.SalesChannel.Name)
+		}
+	}
+	expected := []string{"Concert/Regular/Web", "Play/Regular/Web", "Play/Regular/Web"}
+	if len(names) != len(expected) || names[0] != expected[0] || names[1] != expected[1] || names[2] != expected[2] {
+		t.Errorf("Unexpected tickets, got %#v, expected %#v", names, expected)
+	}
+
+	// Fetched objects are reused
+	expectedCalls := []string{
+		"/api/1/test/contacts/20",
+		"/api/1/test/settings/ticketsales/saleschannels",
+		"/api/1/test/events/11",
+	}
+	if len(calls) != len(expectedCalls) {
+		t.Fatalf("Unexpected calls, got %#v, expected %#v", calls, expectedCalls)
+	}
+	for i := range calls {
+		if calls[i] != expectedCalls[i] {
+			t.Errorf("Unexpected calls, got %#v, expected %#v", calls, expectedCalls)
+		}
+	}
+}
+
+func TestResolveCacheLimit(t *testing.T) {
+	var c fetchCache[ticketmatic.Event]
+	for id := int64(1); id <= 5; id++ {
+		c.put(id, &ticketmatic.Event{Id: id}, 3)
+	}
+	c.put(4, nil, 3)
+	if len(c.items) != 3 || len(c.order) != 3 {
+		t.Errorf("Unexpected cache size, got %d and %d, expected 3", len(c.items), len(c.order))
+	}
+	if _, ok := c.get(2); ok {
+		t.Error("Expected event 2 to be dropped")
+	}
+	if e, ok := c.get(4); !ok || e != nil {
+		t.Errorf("Unexpected event 4, got %#v", e)
+	}
+	if e, ok := c.get(5); !ok || e.Id != 5 {
+		t.Errorf("Unexpected event 5, got %#v", e)
+	}
+}
@@ -0,0 +1,25 @@
+// Run operations across multiple accounts that share the same API keys.
+//
+// A Pool creates a client per account from a template client and runs an
+// operation for every account (or a filtered subset), with bounded
+// concurrency:
+//
+//	p := pool.New(ticketmatic.NewClient("", accesskey, secretkey))
+//	p.Concurrency = 8
+//
+//	results, err := pool.Run(p, nil, func(c *ticketmatic.Client, a *ticketmatic.AccountInfo) (*events.List, error) {
+//		return events.Getlist(c, &ticketmatic.EventQuery{
+//			Filter: "SELECT id FROM tm.event WHERE startts::date = current_date",
+//		})
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	for _, r := range results {
+//		if r.Err != nil {
+//			log.Printf("%s: %s", r.Account.Shortname, r.Err)
+//			continue
+//		}
+//		log.Printf("%s: %d events today", r.Account.Shortname, len(r.Value.Data))
+//	}
+package pool
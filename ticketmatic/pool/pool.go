@@ -0,0 +1,220 @@
+package pool
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/tools"
+)
+
+// Default number of accounts processed in parallel
+const DefaultConcurrency = 4
+
+// A set of clients, one per account, sharing the credentials of a template
+// client. Safe for concurrent use.
+type Pool struct {
+	// Maximum number of accounts processed in parallel by Run
+	Concurrency int
+
+	template *ticketmatic.Client
+
+	mutex    sync.Mutex
+	clients  map[string]*ticketmatic.Client
+	accounts []*ticketmatic.AccountInfo
+}
+
+// Create a pool. Clients are copies of the template (see Client.Clone) for
+// another account, so they share its keys (or secret provider), guard,
+// transport and other settings. Keys rotated on the template with SetKeys are
+// picked up by all clients.
+func New(template *ticketmatic.Client) *Pool {
+	return &Pool{
+		Concurrency: DefaultConcurrency,
+		template:    template,
+		clients:     make(map[string]*ticketmatic.Client),
+	}
+}
+
+// Get the client for an account. Use "" for calls that aren't bound to an
+// account, such as tools.Accounts.
+func (p *Pool) Client(accountcode string) *ticketmatic.Client {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	c, ok := p.clients[accountcode]
+	if ok {
+		return c
+	}
+
+	t := p.template
+	c = t.Clone()
+	c.AccountCode = accountcode
+	if c.Secrets == nil {
+		// Sign with the current keys of the template
+		c.Secrets = ticketmatic.SecretFunc(func(string) (*ticketmatic.Keys, error) {
+			return t.Keys()
+		})
+	}
+	p.clients[accountcode] = c
+	return c
+}
+
+// List the accounts the keys give access to, sorted by short name. The list
+// is fetched once, use Refresh to fetch it again.
+func (p *Pool) Accounts() ([]*ticketmatic.AccountInfo, error) {
+	p.mutex.Lock()
+	accounts := p.accounts
+	p.mutex.Unlock()
+	if accounts != nil {
+		return accounts, nil
+	}
+
+	accounts, err := tools.Accounts(p.Client(""))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Shortname < accounts[j].Shortname
+	})
+
+	p.mutex.Lock()
+	p.accounts = accounts
+	p.mutex.Unlock()
+	return accounts, nil
+}
+
+// Forget the cached account list
+func (p *Pool) Refresh() {
+	p.mutex.Lock()
+	p.accounts = nil
+	p.mutex.Unlock()
+}
+
+// Selects the accounts to run an operation on
+type Filter func(account *ticketmatic.AccountInfo) bool
+
+// Select accounts by short name
+func Accounts(shortnames ...string) Filter {
+	set := make(map[string]bool, len(shortnames))
+	for _, s := range shortnames {
+		set[s] = true
+	}
+	return func(a *ticketmatic.AccountInfo) bool {
+		return set[a.Shortname]
+	}
+}
+
+// Select accounts whose short name starts with the prefix, such as "qa-"
+func Prefix(prefix string) Filter {
+	return func(a *ticketmatic.AccountInfo) bool {
+		return strings.HasPrefix(a.Shortname, prefix)
+	}
+}
+
+// Skip the accounts selected by the filter
+func Exclude(f Filter) Filter {
+	return func(a *ticketmatic.AccountInfo) bool {
+		return !f(a)
+	}
+}
+
+// Outcome of an operation for a single account
+type Result[T any] struct {
+	Account *ticketmatic.AccountInfo
+
+	// Value returned by the operation, if successful
+	Value T
+
+	// Error returned by the operation
+	Err error
+}
+
+// Outcomes of an operation, one per account, in account order
+type Results[T any] []*Result[T]
+
+// Errors of the failed accounts, nil if all succeeded
+func (r Results[T]) Err() error {
+	errs := make(Errors)
+	for _, res := range r {
+		if res.Err != nil {
+			errs[res.Account.Shortname] = res.Err
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// Errors per account short name
+type Errors map[string]error
+
+func (e Errors) Error() string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Failed for %d account(s):", len(e))
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteString(";")
+		}
+		fmt.Fprintf(&buf, " %s: %s", name, e[name])
+	}
+	return buf.String()
+}
+
+// Run an operation for every account accepted by the filter (all accounts if
+// the filter is nil), with at most Concurrency accounts at a time.
+//
+// The returned error only reports failure to list the accounts: errors of the
+// operation are kept per account in the results, see Results.Err.
+func Run[T any](p *Pool, filter Filter, fn func(c *ticketmatic.Client, account *ticketmatic.AccountInfo) (T, error)) (Results[T], error) {
+	accounts, err := p.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	var results Results[T]
+	for _, a := range accounts {
+		if filter == nil || filter(a) {
+			results = append(results, &Result[T]{Account: a})
+		}
+	}
+
+	concurrency := p.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, res := range results {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(res *Result[T]) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			res.Value, res.Err = run(p, res.Account, fn)
+		}(res)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// Run the operation for one account, a panic is returned as its error
+func run[T any](p *Pool, account *ticketmatic.AccountInfo, fn func(c *ticketmatic.Client, account *ticketmatic.AccountInfo) (T, error)) (value T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Panic: %v", r)
+		}
+	}()
+	return fn(p.Client(account.Shortname), account)
+}
@@ -0,0 +1,174 @@
+package pool
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/tools"
+)
+
+func TestRun(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		current int
+		max     int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/_/tools/accounts" {
+			fmt.Fprint(w, `[{"id": 3, "shortname": "qa-c"}, {"id": 1, "shortname": "a"}, {"id": 2, "shortname": "b"}, {"id": 4, "shortname": "qa-d"}]`)
+			return
+		}
+
+		mutex.Lock()
+		current++
+		if current > max {
+			max = current
+		}
+		mutex.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		defer func() {
+			mutex.Lock()
+			current--
+			mutex.Unlock()
+		}()
+
+		account := strings.Split(r.URL.Path, "/")[3]
+		if account == "b" {
+			w.WriteHeader(403)
+			fmt.Fprint(w, `{"code": 403, "message": "No access"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"id": 1, "shortname": %q, "name": "Account %s"}`, account, account)
+	}))
+	defer srv.Close()
+
+	template := ticketmatic.NewClient("", "key", "secret")
+	template.Server = srv.URL
+	p := New(template)
+	p.Concurrency = 2
+
+	results, err := Run(p, nil, func(c *ticketmatic.Client, a *ticketmatic.AccountInfo) (string, error) {
+		info, err := tools.Account(c)
+		if err != nil {
+			return "", err
+		}
+		return info.Name, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 4 {
+		t.Fatalf("Unexpected result count, got %d, expected %d", len(results), 4)
+	}
+	expected := []string{"Account a", "", "Account qa-c", "Account qa-d"}
+	for i, r := range results {
+		if r.Value != expected[i] {
+			t.Errorf("Unexpected value for %s, got %q, expected %q", r.Account.Shortname, r.Value, expected[i])
+		}
+	}
+	if results[1].Err == nil {
+		t.Errorf("Expected an error for account b")
+	}
+	errs, ok := results.Err().(Errors)
+	if !ok || len(errs) != 1 || errs["b"] == nil {
+		t.Errorf("Unexpected errors, got %#v", results.Err())
+	}
+	if max > 2 {
+		t.Errorf("Unexpected concurrency, got %d, expected at most %d", max, 2)
+	}
+
+	results, err = Run(p, Prefix("qa-"), func(c *ticketmatic.Client, a *ticketmatic.AccountInfo) (string, error) {
+		return c.AccountCode, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].Value != "qa-c" || results[1].Value != "qa-d" || results.Err() != nil {
+		t.Errorf("Unexpected results, got %#v", results)
+	}
+
+	results, err = Run(p, Exclude(Accounts("a", "b")), func(c *ticketmatic.Client, a *ticketmatic.AccountInfo) (string, error) {
+		return c.AccountCode, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].Value != "qa-c" {
+		t.Errorf("Unexpected results, got %#v", results)
+	}
+}
+
+func TestClientKeys(t *testing.T) {
+	template := ticketmatic.NewClient("", "key", "secret")
+	p := New(template)
+
+	c := p.Client("a")
+	if c != p.Client("a") {
+		t.Errorf("Expected the same client")
+	}
+
+	template.SetKeys("key2", "secret2")
+	keys, err := c.Keys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys.AccessKey != "key2" {
+		t.Errorf("Unexpected key, got %s, expected %s", keys.AccessKey, "key2")
+	}
+}
+
+func TestClientSettings(t *testing.T) {
+	loc := time.FixedZone("Test", 3600)
+	httpClient := &http.Client{}
+	template := ticketmatic.NewClient("", "key", "secret")
+	template.Location = loc
+	template.HTTPClient = httpClient
+	template.Guard = &ticketmatic.Guard{Mode: ticketmatic.GuardModeRefuse}
+	p := New(template)
+
+	c := p.Client("a")
+	if c.AccountCode != "a" || c.Guard != template.Guard || c.Location != loc || c.HTTPClient != httpClient {
+		t.Errorf("Unexpected client, got %#v", c)
+	}
+
+	// Mutations are refused before anything is sent
+	req := c.NewRequest("DELETE", "/{accountname}/orders", "json")
+	err := req.Run(nil)
+	if _, ok := err.(*ticketmatic.GuardError); !ok {
+		t.Errorf("Unexpected error, got %#v", err)
+	}
+}
+
+func TestRunPanic(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 1, "shortname": "a"}, {"id": 2, "shortname": "b"}]`)
+	}))
+	defer srv.Close()
+
+	template := ticketmatic.NewClient("", "key", "secret")
+	template.Server = srv.URL
+	p := New(template)
+
+	results, err := Run(p, nil, func(c *ticketmatic.Client, a *ticketmatic.AccountInfo) (string, error) {
+		if a.Shortname == "b" {
+			panic("boom")
+		}
+		return a.Shortname, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Value != "a" || results[0].Err != nil {
+		t.Errorf("Unexpected result, got %#v", results[0])
+	}
+	if results[1].Err == nil || !strings.Contains(results[1].Err.Error(), "boom") {
+		t.Errorf("Unexpected error, got %#v", results[1].Err)
+	}
+}
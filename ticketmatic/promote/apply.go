@@ -0,0 +1,95 @@
+package promote
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apiutil"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/seatingplans/seatingplans"
+	"github.com/ticketmatic/tm-go/ticketmatic/snapshot"
+)
+
+// Apply the changes of a plan to the target account, in order. Stops at the
+// first failure.
+//
+// Plans with problems are refused. Created objects are added to the mapping
+// of the plan.
+func Apply(target *ticketmatic.Client, plan *Plan, opts *snapshot.ApplyOptions) error {
+	if opts == nil {
+		opts = &snapshot.ApplyOptions{}
+	}
+	log := opts.Log
+	if log == nil {
+		log = ioutil.Discard
+	}
+	if len(plan.Problems) > 0 {
+		return errors.New("Can't apply a plan with problems")
+	}
+
+	for _, c := range plan.Changes {
+		if !c.Supported() {
+			return fmt.Errorf("Can't %s %s: not supported", c.Action, apiutil.Label(c.Resource.Name, c.Id, c.Name))
+		}
+		if opts.DryRun {
+			fmt.Fprintf(log, "Would %s %s\n", c.Action, apiutil.Label(c.Resource.Name, c.Id, c.Name))
+			continue
+		}
+
+		err := plan.apply(target, c)
+		if err != nil {
+			return fmt.Errorf("Failed to %s %s: %s", c.Action, apiutil.Label(c.Resource.Name, c.Id, c.Name), err)
+		}
+		verb := "Created"
+		if c.Action == snapshot.ActionUpdate {
+			verb = "Updated"
+		}
+		fmt.Fprintf(log, "%s %s\n", verb, apiutil.Label(c.Resource.Name, c.Id, c.Name))
+	}
+	return nil
+}
+
+func (p *Plan) apply(target *ticketmatic.Client, c *Change) error {
+	desired, err := p.remap(c.source, snapshot.References[c.Resource.Name], false)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case c.Action == snapshot.ActionCreate:
+		result, err := c.Resource.Create(target, snapshot.Payload(nil, desired))
+		if err != nil {
+			return err
+		}
+		c.Id = result.ID()
+		p.Mapping.set(c.Resource.Name, c.Source, c.Id)
+	case c.changed:
+		_, err = c.Resource.Update(target, c.Id, snapshot.Payload(c.current, desired))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, z := range c.zones {
+		lp, err := p.remap(z.logicalplan, logicalPlanReferences, false)
+		if err != nil {
+			return err
+		}
+		var data ticketmatic.LogicalPlan
+		err = apiutil.Convert(lp, &data)
+		if err != nil {
+			return err
+		}
+
+		_, err = seatingplans.Savesvg(target, c.Id, z.id, z.svg)
+		if err != nil {
+			return fmt.Errorf("zone %s: %s", z.id, err)
+		}
+		_, err = seatingplans.Savelogicalplan(target, c.Id, z.id, &data)
+		if err != nil {
+			return fmt.Errorf("zone %s: %s", z.id, err)
+		}
+	}
+	return nil
+}
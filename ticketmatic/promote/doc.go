@@ -0,0 +1,27 @@
+// Promote configuration from one account to another, e.g. from a staging
+// account to production.
+//
+// Objects are matched by code (or name, for objects without a code): matched
+// objects are updated, the others are created. Ids that refer to other
+// objects (the price types of a price list, the seat ranks of a logical plan)
+// are rewritten to the ids of the matching objects in the target account.
+//
+//	plan, err := promote.MakePlan(staging, production, &promote.Options{
+//		Resources: []string{"pricetypes", "pricelists"},
+//	})
+//	fmt.Print(plan)
+//
+//	// + pricetypes "Student"
+//	// ~ pricelists/8 "Standard"
+//	//     prices.prices[1].pricetypeid: 14 -> new pricetypes "Student"
+//	// 1 to create, 1 to update
+//
+//	err = promote.Apply(production, plan, &snapshot.ApplyOptions{Log: os.Stdout})
+//
+// Seating plans are copied with the SVG and logical plan of each zone. Lock
+// templates and seat description templates are not copied.
+//
+// The resulting Mapping can be used to rewrite other objects, such as events:
+//
+//	err = plan.Mapping.RemapEvent(event)
+package promote
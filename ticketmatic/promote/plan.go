@@ -0,0 +1,387 @@
+package promote
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apiutil"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/seatingplans/seatingplans"
+	"github.com/ticketmatic/tm-go/ticketmatic/snapshot"
+)
+
+// Promotion options
+type Options struct {
+	// Resources to promote (default: DefaultResources)
+	Resources []string
+
+	// Only promote the objects for which Select returns true (default: all).
+	// Objects that aren't selected are still matched, so references to them
+	// can be rewritten.
+	Select func(resource string, o snapshot.Object) bool
+}
+
+// A change to a single object in the target account
+type Change struct {
+	*snapshot.Change
+
+	// Id of the object in the source account
+	Source int64
+
+	// Source object, references are rewritten when applied
+	source snapshot.Object
+
+	// Matched object in the target account
+	current snapshot.Object
+
+	// Whether the object itself changed, seating plans can have only
+	// changed zones
+	changed bool
+
+	// Changed zones of seating plans
+	zones []*zone
+}
+
+// SVG and logical plan of a seating plan zone
+type zone struct {
+	id          string
+	svg         string
+	logicalplan snapshot.Object
+}
+
+// Changes needed to promote objects to the target account
+type Plan struct {
+	Changes []*Change
+
+	// Target ids of the matched objects. Created objects are added when
+	// the plan is applied.
+	Mapping Mapping
+
+	// Problems that prevent applying the plan, such as references to
+	// objects that don't exist in the target account
+	Problems []string
+
+	// Objects that will be created, per resource and source id
+	pending map[string]map[int64]string
+
+	// Names of the source objects, for messages
+	names map[string]map[int64]string
+}
+
+// Whether the target account already matches
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0 && len(p.Problems) == 0
+}
+
+// Human-readable report, one change per line with changed fields indented,
+// followed by the problems
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "No changes\n"
+	}
+
+	var b strings.Builder
+	counts := make(map[snapshot.Action]int)
+	for _, c := range p.Changes {
+		b.WriteString(c.String())
+		b.WriteString("\n")
+		counts[c.Action]++
+	}
+	fmt.Fprintf(&b, "%d to create, %d to update\n", counts[snapshot.ActionCreate], counts[snapshot.ActionUpdate])
+	for _, problem := range p.Problems {
+		fmt.Fprintf(&b, "! %s\n", problem)
+	}
+	return b.String()
+}
+
+// Compare the selected objects of the source account with the target account
+// and plan the changes needed to promote them.
+func MakePlan(source, target *ticketmatic.Client, opts *Options) (*Plan, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	res, err := selectResources(opts.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{
+		Mapping: make(Mapping),
+		pending: make(map[string]map[int64]string),
+		names:   make(map[string]map[int64]string),
+	}
+
+	// Referenced resources are matched but not promoted
+	involved := append([]*snapshot.Resource{}, res...)
+	for _, r := range res {
+		for _, name := range referenced(r.Name) {
+			if !contains(involved, name) {
+				involved = append(involved, snapshot.Lookup(name))
+			}
+		}
+	}
+
+	objects := make(map[string][]snapshot.Object)
+	matches := make(map[string]map[int64][]snapshot.Object)
+	for _, r := range involved {
+		src, err := r.List(source)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name, err)
+		}
+		dst, err := r.List(target)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name, err)
+		}
+
+		index := make(map[string][]snapshot.Object)
+		for _, o := range dst {
+			index[matchKey(o)] = append(index[matchKey(o)], o)
+		}
+		objects[r.Name] = src
+		matches[r.Name] = make(map[int64][]snapshot.Object)
+		plan.names[r.Name] = make(map[int64]string)
+		for _, o := range src {
+			plan.names[r.Name][o.ID()] = o.Name()
+			matches[r.Name][o.ID()] = index[matchKey(o)]
+			if m := index[matchKey(o)]; len(m) == 1 {
+				plan.Mapping.set(r.Name, o.ID(), m[0].ID())
+			}
+		}
+	}
+
+	selected := func(r *snapshot.Resource) []snapshot.Object {
+		var result []snapshot.Object
+		for _, o := range objects[r.Name] {
+			if opts.Select == nil || opts.Select(r.Name, o) {
+				result = append(result, o)
+			}
+		}
+		return result
+	}
+
+	// Know what will be created before rewriting references
+	for _, r := range res {
+		plan.pending[r.Name] = make(map[int64]string)
+		for _, o := range selected(r) {
+			if len(matches[r.Name][o.ID()]) == 0 {
+				plan.pending[r.Name][o.ID()] = o.Name()
+			}
+		}
+	}
+
+	for _, r := range res {
+		for _, o := range selected(r) {
+			m := matches[r.Name][o.ID()]
+			if len(m) > 1 {
+				plan.problem(r.Name, o, fmt.Errorf("%d objects in the target account match", len(m)))
+				continue
+			}
+
+			c := &Change{
+				Change: &snapshot.Change{
+					Resource: r,
+					Action:   snapshot.ActionCreate,
+					Name:     o.Name(),
+				},
+				Source:  o.ID(),
+				source:  o,
+				changed: true,
+			}
+			desired, err := plan.remap(o, snapshot.References[r.Name], true)
+			if err != nil {
+				plan.problem(r.Name, o, err)
+			}
+			if len(m) == 1 {
+				c.Action = snapshot.ActionUpdate
+				c.Id = m[0].ID()
+				c.current = m[0]
+				c.Fields = snapshot.Diff(m[0], desired)
+				c.changed = len(c.Fields) > 0
+			}
+			if r.Name == "seatingplans" {
+				err := plan.planZones(source, target, c)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %s", apiutil.Label(r.Name, o.ID(), o.Name()), err)
+				}
+			}
+
+			if c.Action == snapshot.ActionCreate || len(c.Fields) > 0 {
+				plan.Changes = append(plan.Changes, c)
+			}
+		}
+	}
+	return plan, nil
+}
+
+// Compare the SVG and logical plan of each zone of a seating plan
+func (p *Plan) planZones(source, target *ticketmatic.Client, c *Change) error {
+	for _, id := range zoneIds(c.source) {
+		z, err := getZone(source, c.Source, id)
+		if err != nil {
+			return err
+		}
+		desired, err := p.remap(z.logicalplan, logicalPlanReferences, true)
+		if err != nil {
+			p.problem(c.Resource.Name, c.source, fmt.Errorf("zone %s: %s", id, err))
+		}
+
+		current := &zone{}
+		if c.Action == snapshot.ActionUpdate {
+			current, err = getZone(target, c.Id, id)
+			if apiutil.IsNotFound(err) {
+				current, err = &zone{}, nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		changed := false
+		if current.svg != z.svg {
+			c.Fields = append(c.Fields, &snapshot.FieldChange{
+				Path: fmt.Sprintf("svg[%s]", id),
+				Old:  nilIfEmpty(current.svg),
+				New:  z.svg,
+			})
+			changed = true
+		}
+		if !reflect.DeepEqual(current.logicalplan["rows"], desired["rows"]) {
+			c.Fields = append(c.Fields, &snapshot.FieldChange{
+				Path: fmt.Sprintf("logicalplan[%s].rows", id),
+				Old:  current.logicalplan["rows"],
+				New:  desired["rows"],
+			})
+			changed = true
+		}
+		if changed {
+			c.zones = append(c.zones, z)
+		}
+	}
+	return nil
+}
+
+func getZone(client *ticketmatic.Client, id int64, zoneid string) (*zone, error) {
+	svg, err := seatingplans.Getsvg(client, id, zoneid)
+	if err != nil {
+		return nil, err
+	}
+	lp, err := seatingplans.Getlogicalplan(client, id, zoneid)
+	if err != nil {
+		return nil, err
+	}
+	z := &zone{id: zoneid, svg: svg.String()}
+	err = apiutil.Convert(lp, &z.logicalplan)
+	if err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Zones of a seating plan, zone 0 for plans without zones
+func zoneIds(o snapshot.Object) []string {
+	zones, _ := o["zones"].([]interface{})
+	if len(zones) == 0 {
+		return []string{"0"}
+	}
+	result := make([]string, 0, len(zones))
+	for _, z := range zones {
+		id, _ := z.(float64)
+		result = append(result, strconv.FormatInt(int64(id), 10))
+	}
+	return result
+}
+
+// Copy of an object with its references rewritten to the target account.
+// While planning, references to objects that will be created are replaced
+// by a description.
+func (p *Plan) remap(o snapshot.Object, refs []snapshot.Reference, planning bool) (snapshot.Object, error) {
+	var result snapshot.Object
+	err := apiutil.Convert(o, &result)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		resource := ref.Resource
+		err = ref.Rewrite(result, func(id int64) (interface{}, error) {
+			if target, ok := p.Mapping.ID(resource, id); ok {
+				return float64(target), nil
+			}
+			if name, ok := p.pending[resource][id]; ok && planning {
+				return "new " + apiutil.Label(resource, 0, name), nil
+			}
+			if name := p.names[resource][id]; name != "" {
+				return nil, fmt.Errorf("%s %d %q doesn't exist in the target account", resource, id, name)
+			}
+			return nil, fmt.Errorf("%s %d doesn't exist in the target account", resource, id)
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func (p *Plan) problem(resource string, o snapshot.Object, err error) {
+	p.Problems = append(p.Problems, fmt.Sprintf("%s: %s", apiutil.Label(resource, 0, o.Name()), err))
+}
+
+// Resolve and order resources
+func selectResources(names []string) ([]*snapshot.Resource, error) {
+	if len(names) == 0 {
+		names = DefaultResources
+	}
+	var result []*snapshot.Resource
+	for _, n := range DefaultResources {
+		for _, name := range names {
+			if name == n {
+				result = append(result, snapshot.Lookup(n))
+			}
+		}
+	}
+	for _, r := range snapshot.Resources {
+		for _, name := range names {
+			if name == r.Name && !contains(result, name) {
+				result = append(result, r)
+			}
+		}
+	}
+	if len(result) != len(names) {
+		for _, n := range names {
+			if snapshot.Lookup(n) == nil {
+				return nil, fmt.Errorf("Unknown resource: %s", n)
+			}
+		}
+	}
+	return result, nil
+}
+
+// Resources referenced by a resource
+func referenced(resource string) []string {
+	refs := snapshot.References[resource]
+	if resource == "seatingplans" {
+		refs = logicalPlanReferences
+	}
+	var result []string
+	for _, ref := range refs {
+		result = append(result, ref.Resource)
+	}
+	return result
+}
+
+func contains(res []*snapshot.Resource, name string) bool {
+	for _, r := range res {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func nilIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,116 @@
+package promote
+
+import (
+	"fmt"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/snapshot"
+)
+
+// Resources promoted by default, in the order they are promoted: referenced
+// objects come before the objects referring to them.
+var DefaultResources = []string{
+	"eventlocations",
+	"pricetypes",
+	"seatranks",
+	"ticketfees",
+	"pricelists",
+	"seatingplans",
+	"ticketlayouts",
+	"webskins",
+}
+
+// References in the logical plans of seating plans
+var logicalPlanReferences = []snapshot.Reference{
+	{Path: "rows[].seats[].seatrankid", Resource: "seatranks"},
+}
+
+// Ids of the objects in the target account, per resource and id in the
+// source account
+type Mapping map[string]map[int64]int64
+
+// Target id of an object, false if not mapped
+func (m Mapping) ID(resource string, id int64) (int64, bool) {
+	target, ok := m[resource][id]
+	return target, ok
+}
+
+func (m Mapping) set(resource string, id, target int64) {
+	if m[resource] == nil {
+		m[resource] = make(map[int64]int64)
+	}
+	m[resource][id] = target
+}
+
+// Rewrite the location, price list, ticket fee, seating plan, ticket layout,
+// sales channel and event specific price references of an event to the target
+// account.
+//
+// Other references (such as the opt-in set, revenue split and upsell) have no
+// settings resource to promote. They're rewritten when the caller adds them to
+// the mapping (e.g. "optinsets"), otherwise a set reference is an error.
+func (m Mapping) RemapEvent(e *ticketmatic.Event) error {
+	var err error
+	remap := func(resource string, id *int64) {
+		if err != nil || *id == 0 {
+			return
+		}
+		target, ok := m.ID(resource, *id)
+		if !ok {
+			err = fmt.Errorf("No %s %d in the mapping", resource, *id)
+			return
+		}
+		*id = target
+	}
+	prices := func(p *ticketmatic.PricelistPrices) {
+		if p == nil {
+			return
+		}
+		for _, price := range p.Prices {
+			remap("pricetypes", &price.Pricetypeid)
+			for i := range price.Saleschannels {
+				remap("saleschannels", &price.Saleschannels[i])
+			}
+		}
+		for i := range p.Seatrankids {
+			remap("seatranks", &p.Seatrankids[i])
+		}
+	}
+
+	remap("eventlocations", &e.Locationid)
+	remap("ticketfees", &e.Ticketfeeid)
+	remap("ticketlayouts", &e.Ticketlayoutid)
+	remap("seatingplans", &e.Seatingplanid)
+	remap("pricelists", &e.Seatingplanpricelistid)
+	prices(e.Seatingplaneventspecificprices)
+	for _, c := range e.Contingents {
+		remap("pricelists", &c.Pricelistid)
+		prices(c.Eventspecificprices)
+	}
+	for _, c := range e.SeatedContingents {
+		remap("pricelists", &c.Pricelistid)
+		prices(c.Eventspecificprices)
+	}
+	for _, sc := range e.Saleschannels {
+		remap("saleschannels", &sc.Saleschannelid)
+	}
+
+	remap("optinsets", &e.Optinsetid)
+	remap("productions", &e.Productionid)
+	remap("revenuesplits", &e.Revenuesplitid)
+	remap("salestatusmessages", &e.Salestatusmessagesid)
+	remap("ticketinfos", &e.Ticketinfoid)
+	remap("upsells", &e.Upsellid)
+	for i := range e.Servicemailids {
+		remap("servicemails", &e.Servicemailids[i])
+	}
+	return err
+}
+
+// Key used to match objects between accounts
+func matchKey(o snapshot.Object) string {
+	if code, ok := o["code"].(string); ok && code != "" {
+		return "code:" + code
+	}
+	return "name:" + o.Name()
+}
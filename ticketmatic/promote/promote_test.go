@@ -0,0 +1,281 @@
+package promote
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apitest"
+	"github.com/ticketmatic/tm-go/ticketmatic/snapshot"
+)
+
+// A fake account with seating plan zones
+type fakeAccount struct {
+	*apitest.Account
+
+	mutex        sync.Mutex
+	svgs         map[string]string
+	logicalplans map[string]interface{}
+}
+
+// Paths of the resources in the fake accounts
+var fakePaths = map[string]string{
+	"pricetypes":    "settings/pricing/pricetypes",
+	"pricelists":    "settings/pricing/pricelists",
+	"seatranks":     "settings/seatingplans/seatranks",
+	"seatingplans":  "settings/seatingplans/seatingplans",
+	"saleschannels": "settings/ticketsales/saleschannels",
+}
+
+// Serves the svg and logical plan of seating plan zones:
+// settings/seatingplans/seatingplans/{id}/{svg,logicalplan}/{zone}
+func (f *fakeAccount) serveZone(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/1/"+apitest.AccountCode+"/"+fakePaths["seatingplans"]+"/")
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 {
+		http.NotFound(w, r)
+		return
+	}
+	key := parts[0] + "/" + parts[2]
+	switch {
+	case parts[1] == "svg" && r.Method == "GET":
+		svg, ok := f.svgs[key]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(svg))
+	case parts[1] == "svg" && r.Method == "POST":
+		data, _ := ioutil.ReadAll(r.Body)
+		f.svgs[key] = string(data)
+	case parts[1] == "logicalplan" && r.Method == "GET":
+		lp, ok := f.logicalplans[key]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(lp)
+	case parts[1] == "logicalplan" && r.Method == "POST":
+		var lp interface{}
+		json.NewDecoder(r.Body).Decode(&lp)
+		f.logicalplans[key] = lp
+		json.NewEncoder(w).Encode(lp)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// Create an account holding objects, given as JSON by resource and id
+func newAccount(t *testing.T, objects string, lastid int64) (*fakeAccount, *ticketmatic.Client) {
+	var byID map[string]map[string]map[string]interface{}
+	err := json.Unmarshal([]byte(objects), &byID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := &fakeAccount{
+		Account:      apitest.New(nil),
+		svgs:         make(map[string]string),
+		logicalplans: make(map[string]interface{}),
+	}
+	account.LastID = lastid
+	account.Fallback = http.HandlerFunc(account.serveZone)
+	for name, path := range fakePaths {
+		var ids []int64
+		for id := range byID[name] {
+			n, _ := strconv.ParseInt(id, 10, 64)
+			ids = append(ids, n)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		list := []map[string]interface{}{}
+		for _, id := range ids {
+			list = append(list, byID[name][strconv.FormatInt(id, 10)])
+		}
+		account.Objects[path] = list
+	}
+	return account, account.Client(t)
+}
+
+func setup(t *testing.T) (*fakeAccount, *ticketmatic.Client, *fakeAccount, *ticketmatic.Client) {
+	src, source := newAccount(t, `{
+		"pricetypes": {"1": {"id": 1, "name": "Regular", "typeid": 2301}, "2": {"id": 2, "name": "Student", "typeid": 2302}},
+		"seatranks": {"1": {"id": 1, "name": "Rank A"}},
+		"saleschannels": {"1": {"id": 1, "name": "Web"}},
+		"pricelists": {"1": {"id": 1, "name": "Standard", "prices": {
+			"prices": [{"pricetypeid": 1, "saleschannels": [1]}, {"pricetypeid": 2, "saleschannels": [1]}],
+			"seatrankids": [1]
+		}}},
+		"seatingplans": {"1": {"id": 1, "name": "Hall", "zones": []}}
+	}`, 100)
+	src.svgs["1/0"] = "<svg/>"
+	src.logicalplans["1/0"] = map[string]interface{}{
+		"id":   0,
+		"name": "Hall",
+		"rows": []interface{}{map[string]interface{}{
+			"name":  "1",
+			"seats": []interface{}{map[string]interface{}{"id": "s1", "name": "1", "seatrankid": 1}},
+		}},
+	}
+
+	dst, target := newAccount(t, `{
+		"pricetypes": {"10": {"id": 10, "name": "Regular", "typeid": 2301}},
+		"seatranks": {"20": {"id": 20, "name": "Rank A"}},
+		"saleschannels": {"30": {"id": 30, "name": "Web"}},
+		"pricelists": {"40": {"id": 40, "name": "Standard", "prices": {
+			"prices": [{"pricetypeid": 10, "saleschannels": [30]}, {"pricetypeid": 10, "saleschannels": [30]}],
+			"seatrankids": [20]
+		}}}
+	}`, 100)
+	return src, source, dst, target
+}
+
+func TestPromote(t *testing.T) {
+	_, source, account, target := setup(t)
+
+	opts := &Options{Resources: []string{"pricelists", "seatingplans", "pricetypes", "seatranks"}}
+	plan, err := MakePlan(source, target, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `+ pricetypes "Student"
+~ pricelists/40 "Standard"
+    prices.prices[1].pricetypeid: 10 -> "new pricetypes \"Student\""
++ seatingplans "Hall"
+    svg[0]: null -> "<svg/>"
+    logicalplan[0].rows: null -> [{"coord":0,"name":"1","seats":[{"center":null,"coord":0,"id":"s1","name":"1"...
+2 to create, 1 to update
+`
+	if plan.String() != expected {
+		t.Errorf("Unexpected plan, got:\n%s\nexpected:\n%s", plan, expected)
+	}
+	if id, _ := plan.Mapping.ID("saleschannels", 1); id != 30 {
+		t.Errorf("Unexpected mapping, got %d, expected %d", id, 30)
+	}
+
+	err = Apply(target, plan, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prices := account.Object(fakePaths["pricelists"], 40)["prices"].(map[string]interface{})["prices"].([]interface{})
+	if id := prices[1].(map[string]interface{})["pricetypeid"]; id != float64(101) {
+		t.Errorf("Unexpected price type, got %v, expected %v", id, 101)
+	}
+	if svg := account.svgs["102/0"]; svg != "<svg/>" {
+		t.Errorf("Unexpected svg, got %q", svg)
+	}
+	rows, _ := json.Marshal(account.logicalplans["102/0"].(map[string]interface{})["rows"])
+	if !strings.Contains(string(rows), `"seatrankid":20`) {
+		t.Errorf("Unexpected logical plan, got %s", rows)
+	}
+
+	// Idempotent
+	plan, err = MakePlan(source, target, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() {
+		t.Errorf("Expected an empty plan, got %s", plan)
+	}
+}
+
+func TestPromoteMissingReference(t *testing.T) {
+	_, source, _, target := setup(t)
+
+	plan, err := MakePlan(source, target, &Options{
+		Resources: []string{"pricetypes", "pricelists"},
+		Select: func(resource string, o snapshot.Object) bool {
+			return resource == "pricelists"
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{`pricelists "Standard": pricetypes 2 "Student" doesn't exist in the target account`}
+	if len(plan.Problems) != 1 || plan.Problems[0] != expected[0] {
+		t.Errorf("Unexpected problems, got %#v, expected %#v", plan.Problems, expected)
+	}
+
+	err = Apply(target, plan, nil)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestRemapEvent(t *testing.T) {
+	m := Mapping{}
+	m.set("ticketfees", 1, 11)
+	m.set("pricelists", 2, 12)
+	m.set("pricetypes", 3, 13)
+	m.set("eventlocations", 4, 14)
+	m.set("saleschannels", 6, 16)
+
+	e := &ticketmatic.Event{
+		Ticketfeeid: 1,
+		Locationid:  4,
+		Saleschannels: []*ticketmatic.EventSalesChannel{
+			{Saleschannelid: 6},
+		},
+		Contingents: []*ticketmatic.EventContingent{
+			{
+				Pricelistid: 2,
+				Eventspecificprices: &ticketmatic.PricelistPrices{
+					Prices: []*ticketmatic.PricelistPrice{{Pricetypeid: 3}},
+				},
+			},
+		},
+	}
+	err := m.RemapEvent(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Ticketfeeid != 11 {
+		t.Errorf("Unexpected ticket fee, got %d, expected %d", e.Ticketfeeid, 11)
+	}
+	if e.Contingents[0].Pricelistid != 12 {
+		t.Errorf("Unexpected price list, got %d, expected %d", e.Contingents[0].Pricelistid, 12)
+	}
+	if id := e.Contingents[0].Eventspecificprices.Prices[0].Pricetypeid; id != 13 {
+		t.Errorf("Unexpected price type, got %d, expected %d", id, 13)
+	}
+	if e.Locationid != 14 {
+		t.Errorf("Unexpected location, got %d, expected %d", e.Locationid, 14)
+	}
+	if id := e.Saleschannels[0].Saleschannelid; id != 16 {
+		t.Errorf("Unexpected sales channel, got %d, expected %d", id, 16)
+	}
+
+	unmapped := []*ticketmatic.Event{
+		{Seatingplanid: 5},
+		{Locationid: 5},
+		{Optinsetid: 5},
+		{Revenuesplitid: 5},
+		{Servicemailids: []int64{5}},
+	}
+	for _, e := range unmapped {
+		err = m.RemapEvent(e)
+		if err == nil {
+			t.Fatal("Expected an error!")
+		}
+	}
+
+	m.set("optinsets", 7, 17)
+	e = &ticketmatic.Event{Optinsetid: 7}
+	err = m.RemapEvent(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Optinsetid != 17 {
+		t.Errorf("Unexpected opt-in set, got %d, expected %d", e.Optinsetid, 17)
+	}
+}
@@ -0,0 +1,17 @@
+// Order balance and reconciliation helpers.
+//
+// Compute breaks down what an order owes: tickets plus service charges,
+// products and order fees, minus the payments and their refunds. The result is
+// checked against the Totalamount, Amountpaid and Paymentstatus reported by
+// Ticketmatic.
+//
+// Run does the same for all orders returned by an orders.Iterator and produces
+// an exceptions report:
+//
+//	report, err := reconcile.Run(orders.NewIterator(client, &ticketmatic.OrderQuery{
+//		Simplefilter: &ticketmatic.OrderFilter{Status: int64(ticketmatic.OrderStatusConfirmed)},
+//	}))
+//	for _, b := range report.Exceptions {
+//		fmt.Println(b.Orderid, b.Issues)
+//	}
+package reconcile
@@ -0,0 +1,220 @@
+package reconcile
+
+import (
+	"fmt"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/orders"
+)
+
+// Issue codes
+const (
+	// Totalamount differs from the computed gross amount
+	IssueTotalamount = "totalamount"
+
+	// Amountpaid differs from the computed net paid amount
+	IssueAmountpaid = "amountpaid"
+
+	// Paymentstatus doesn't match the outstanding amount
+	IssuePaymentstatus = "paymentstatus"
+
+	// A refund refers to a payment that isn't part of the order
+	IssueRefundUnknown = "refundunknown"
+
+	// More was refunded than was paid by the original payment
+	IssueRefundExceeds = "refundexceeds"
+)
+
+// An inconsistency found in an order
+type Issue struct {
+	// Issue code, one of the Issue constants
+	Code string
+
+	// Human-readable description
+	Message string
+
+	// Expected (computed) amount, if applicable
+	Expected ticketmatic.Money
+
+	// Actual (reported) amount, if applicable
+	Actual ticketmatic.Money
+}
+
+func (i *Issue) String() string {
+	return fmt.Sprintf("%s: %s", i.Code, i.Message)
+}
+
+// Breakdown of what an order owes
+type Balance struct {
+	// Order ID
+	Orderid int64
+
+	// Sum of the ticket prices
+	Tickets ticketmatic.Money
+
+	// Sum of the ticket service charges
+	Servicecharges ticketmatic.Money
+
+	// Sum of the product prices
+	Products ticketmatic.Money
+
+	// Sum of the order fees
+	Ordercosts ticketmatic.Money
+
+	// Everything the customer has to pay: tickets, service charges, products and
+	// order fees
+	Gross ticketmatic.Money
+
+	// Fees included in Gross: service charges and order fees
+	Fees ticketmatic.Money
+
+	// Sum of the payments, excluding refunds
+	Paid ticketmatic.Money
+
+	// Sum of the refunds, as a positive amount
+	Refunded ticketmatic.Money
+
+	// Paid minus Refunded
+	Netpaid ticketmatic.Money
+
+	// Gross minus Netpaid: negative when the order is overpaid
+	Outstanding ticketmatic.Money
+
+	// Inconsistencies with the amounts reported by Ticketmatic
+	Issues []*Issue
+}
+
+// Whether the order is consistent
+func (b *Balance) Ok() bool {
+	return len(b.Issues) == 0
+}
+
+// Compute the balance of an order and check it against the reported
+// Totalamount, Amountpaid and Paymentstatus.
+func Compute(order *ticketmatic.Order) *Balance {
+	b := &Balance{
+		Orderid: order.Orderid,
+	}
+
+	for _, t := range order.Tickets {
+		b.Tickets = b.Tickets.Add(t.PriceMoney())
+		b.Servicecharges = b.Servicecharges.Add(t.ServicechargeMoney())
+	}
+	for _, p := range order.Products {
+		b.Products = b.Products.Add(p.PriceMoney())
+	}
+	for _, c := range order.Ordercosts {
+		b.Ordercosts = b.Ordercosts.Add(c.AmountMoney())
+	}
+	b.Fees = b.Servicecharges.Add(b.Ordercosts)
+	b.Gross = ticketmatic.SumMoney(b.Tickets, b.Servicecharges, b.Products, b.Ordercosts)
+
+	payments := make(map[int64]*ticketmatic.Payment)
+	for _, p := range order.Payments {
+		if p.Refundpaymentid == 0 {
+			payments[p.Id] = p
+		}
+	}
+
+	refunded := make(map[int64]ticketmatic.Money)
+	for _, p := range order.Payments {
+		if p.Refundpaymentid == 0 {
+			b.Paid = b.Paid.Add(p.AmountMoney())
+			continue
+		}
+
+		amount := p.AmountMoney().Abs()
+		b.Refunded = b.Refunded.Add(amount)
+
+		orig, ok := payments[p.Refundpaymentid]
+		if !ok {
+			b.issue(IssueRefundUnknown, fmt.Sprintf("Refund %d refers to unknown payment %d", p.Id, p.Refundpaymentid), ticketmatic.Money{}, amount)
+			continue
+		}
+		refunded[orig.Id] = refunded[orig.Id].Add(amount)
+		if refunded[orig.Id].Cmp(orig.AmountMoney()) > 0 {
+			b.issue(IssueRefundExceeds, fmt.Sprintf("Payment %d refunded for more than its amount", orig.Id), orig.AmountMoney(), refunded[orig.Id])
+		}
+	}
+	b.Netpaid = b.Paid.Sub(b.Refunded)
+	b.Outstanding = b.Gross.Sub(b.Netpaid)
+
+	if total := order.TotalamountMoney(); !total.Equal(b.Gross) {
+		b.issue(IssueTotalamount, fmt.Sprintf("Total amount %s, computed %s", total, b.Gross), b.Gross, total)
+	}
+	if paid := order.AmountpaidMoney(); !paid.Equal(b.Netpaid) {
+		b.issue(IssueAmountpaid, fmt.Sprintf("Amount paid %s, computed %s", paid, b.Netpaid), b.Netpaid, paid)
+	}
+
+	expected := ticketmatic.PaymentStatusFullyPaid
+	switch b.Outstanding.Sign() {
+	case 1:
+		expected = ticketmatic.PaymentStatusIncomplete
+	case -1:
+		expected = ticketmatic.PaymentStatusOverpaid
+	}
+	if status := ticketmatic.PaymentStatus(order.Paymentstatus); status != expected {
+		b.issue(IssuePaymentstatus, fmt.Sprintf("Payment status %s, expected %s with %s outstanding", status, expected, b.Outstanding), ticketmatic.Money{}, ticketmatic.Money{})
+	}
+
+	return b
+}
+
+func (b *Balance) issue(code, msg string, expected, actual ticketmatic.Money) {
+	b.Issues = append(b.Issues, &Issue{
+		Code:     code,
+		Message:  msg,
+		Expected: expected,
+		Actual:   actual,
+	})
+}
+
+// Exceptions report for a set of orders
+type Report struct {
+	// Number of orders checked
+	Checked int
+
+	// Balances of the orders that have issues
+	Exceptions []*Balance
+
+	// Sum of the balances of all checked orders
+	Totals Balance
+}
+
+// Add an order to the report.
+func (r *Report) Add(order *ticketmatic.Order) *Balance {
+	b := Compute(order)
+
+	r.Checked++
+	r.Totals.Tickets = r.Totals.Tickets.Add(b.Tickets)
+	r.Totals.Servicecharges = r.Totals.Servicecharges.Add(b.Servicecharges)
+	r.Totals.Products = r.Totals.Products.Add(b.Products)
+	r.Totals.Ordercosts = r.Totals.Ordercosts.Add(b.Ordercosts)
+	r.Totals.Gross = r.Totals.Gross.Add(b.Gross)
+	r.Totals.Fees = r.Totals.Fees.Add(b.Fees)
+	r.Totals.Paid = r.Totals.Paid.Add(b.Paid)
+	r.Totals.Refunded = r.Totals.Refunded.Add(b.Refunded)
+	r.Totals.Netpaid = r.Totals.Netpaid.Add(b.Netpaid)
+	r.Totals.Outstanding = r.Totals.Outstanding.Add(b.Outstanding)
+
+	if !b.Ok() {
+		r.Exceptions = append(r.Exceptions, b)
+	}
+	return b
+}
+
+// Check all orders returned by the iterator.
+func Run(it *orders.Iterator) (*Report, error) {
+	r := &Report{}
+	for {
+		order, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if order == nil {
+			break
+		}
+		r.Add(order)
+	}
+	return r, nil
+}
@@ -0,0 +1,115 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/orders"
+)
+
+func testOrder() *ticketmatic.Order {
+	return &ticketmatic.Order{
+		Orderid: 1,
+		Tickets: []*ticketmatic.OrderTicket{
+			{Price: 10.1, Servicecharge: 0.7},
+			{Price: 10.1, Servicecharge: 0.7},
+		},
+		Products:   []*ticketmatic.OrderProduct{{Price: 5}},
+		Ordercosts: []*ticketmatic.Ordercost{{Amount: 1.5}},
+		Payments: []*ticketmatic.Payment{
+			{Id: 1, Amount: 30},
+			{Id: 2, Amount: -2, Refundpaymentid: 1},
+		},
+		Totalamount:   28.1,
+		Amountpaid:    28,
+		Paymentstatus: int64(ticketmatic.PaymentStatusIncomplete),
+	}
+}
+
+func TestCompute(t *testing.T) {
+	b := Compute(testOrder())
+
+	expected := map[string]string{
+		"gross":       "28.10",
+		"fees":        "2.90",
+		"paid":        "30.00",
+		"refunded":    "2.00",
+		"outstanding": "0.10",
+	}
+	got := map[string]string{
+		"gross":       b.Gross.String(),
+		"fees":        b.Fees.String(),
+		"paid":        b.Paid.String(),
+		"refunded":    b.Refunded.String(),
+		"outstanding": b.Outstanding.String(),
+	}
+	for k, v := range expected {
+		if got[k] != v {
+			t.Errorf("Unexpected %s, got %#v, expected %#v", k, got[k], v)
+		}
+	}
+	if !b.Ok() {
+		t.Errorf("Unexpected issues: %v", b.Issues)
+	}
+}
+
+func TestComputeIssues(t *testing.T) {
+	order := testOrder()
+	order.Totalamount = 28
+	order.Paymentstatus = int64(ticketmatic.PaymentStatusFullyPaid)
+	order.Payments = append(order.Payments,
+		&ticketmatic.Payment{Id: 3, Amount: -29, Refundpaymentid: 1},
+		&ticketmatic.Payment{Id: 4, Amount: -1, Refundpaymentid: 99},
+	)
+
+	b := Compute(order)
+	codes := make(map[string]bool)
+	for _, i := range b.Issues {
+		codes[i.Code] = true
+	}
+	for _, c := range []string{IssueTotalamount, IssueAmountpaid, IssuePaymentstatus, IssueRefundExceeds, IssueRefundUnknown} {
+		if !codes[c] {
+			t.Errorf("Expected issue %s, got %v", c, b.Issues)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		list := &orders.List{NbrOfResults: 3}
+		for i := offset; i < 3 && i < offset+2; i++ {
+			o := testOrder()
+			o.Orderid = int64(i + 1)
+			if i == 2 {
+				o.Amountpaid = 0
+			}
+			list.Data = append(list.Data, o)
+		}
+		json.NewEncoder(w).Encode(list)
+	}))
+	defer srv.Close()
+
+	server := ticketmatic.Server
+	ticketmatic.Server = srv.URL
+	defer func() { ticketmatic.Server = server }()
+
+	c := ticketmatic.NewClient("test", "key", "secret")
+	report, err := Run(orders.NewIterator(c, &ticketmatic.OrderQuery{Limit: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Checked != 3 {
+		t.Errorf("Unexpected report.Checked, got %#v, expected %#v", report.Checked, 3)
+	}
+	if len(report.Exceptions) != 1 || report.Exceptions[0].Orderid != 3 {
+		t.Errorf("Unexpected report.Exceptions, got %v", report.Exceptions)
+	}
+	if report.Totals.Gross.String() != "84.30" {
+		t.Errorf("Unexpected report.Totals.Gross, got %s, expected %s", report.Totals.Gross, "84.30")
+	}
+}
@@ -0,0 +1,195 @@
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Cached settings objects of one type, keyed by id
+type Cache[T any] struct {
+	// Name of the objects, used in errors: "price type"
+	name string
+
+	// Eventstream item types that invalidate the cache, as prefixes.
+	// Defaults to the object type: "pricetype".
+	EventTypes []string
+
+	fetch   func(c *ticketmatic.Client, since ticketmatic.Time) ([]*T, error)
+	id      func(*T) int64
+	updated func(*T) time.Time
+
+	registry *Registry
+
+	// Held while refreshing, so concurrent lookups share a single refresh
+	refreshing sync.Mutex
+
+	mutex     sync.RWMutex
+	items     map[int64]*T
+	since     time.Time
+	refreshed time.Time
+	stale     bool
+}
+
+func newCache[T any](r *Registry, name string, eventTypes []string,
+	fetch func(*ticketmatic.Client, ticketmatic.Time) ([]*T, error),
+	id func(*T) int64,
+	updated func(*T) time.Time) *Cache[T] {
+
+	return &Cache[T]{
+		name:       name,
+		EventTypes: eventTypes,
+		fetch:      fetch,
+		id:         id,
+		updated:    updated,
+		registry:   r,
+	}
+}
+
+// Look up an object by id. Refreshes the cache when it's stale, so objects
+// created after the last refresh are found once the TTL expires or the cache
+// is invalidated.
+func (c *Cache[T]) Get(id int64) (*T, error) {
+	item, err := c.Find(id)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, fmt.Errorf("No %s with id %d", c.name, id)
+	}
+	return item, nil
+}
+
+// Look up an object by id like Get, but returns nil when it doesn't exist
+func (c *Cache[T]) Find(id int64) (*T, error) {
+	c.mutex.RLock()
+	item := c.items[id]
+	fresh := c.fresh()
+	c.mutex.RUnlock()
+
+	if !fresh {
+		err := c.ensure()
+		if err != nil {
+			return nil, err
+		}
+		c.mutex.RLock()
+		item = c.items[id]
+		c.mutex.RUnlock()
+	}
+	return item, nil
+}
+
+// All cached objects, sorted by id. Refreshes the cache when it's stale.
+func (c *Cache[T]) All() ([]*T, error) {
+	c.mutex.RLock()
+	fresh := c.fresh()
+	c.mutex.RUnlock()
+	if !fresh {
+		err := c.ensure()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	result := make([]*T, 0, len(c.items))
+	for _, item := range c.items {
+		result = append(result, item)
+	}
+	sort.Slice(result, func(i, j int) bool { return c.id(result[i]) < c.id(result[j]) })
+	return result, nil
+}
+
+// Request the objects that changed since the last refresh, or all objects
+// when the cache is empty
+func (c *Cache[T]) Refresh() error {
+	c.refreshing.Lock()
+	defer c.refreshing.Unlock()
+	return c.refresh()
+}
+
+// Refresh on the next lookup
+func (c *Cache[T]) Invalidate() {
+	c.mutex.Lock()
+	c.stale = true
+	c.mutex.Unlock()
+}
+
+// Drop all objects, the next lookup loads them again. Use this to get rid
+// of deleted objects, which an incremental refresh doesn't notice.
+func (c *Cache[T]) Reset() {
+	c.mutex.Lock()
+	c.items = nil
+	c.since = time.Time{}
+	c.stale = false
+	c.mutex.Unlock()
+}
+
+// Refresh unless another goroutine just did
+func (c *Cache[T]) ensure() error {
+	c.refreshing.Lock()
+	defer c.refreshing.Unlock()
+
+	c.mutex.RLock()
+	fresh := c.fresh()
+	c.mutex.RUnlock()
+	if fresh {
+		return nil
+	}
+	return c.refresh()
+}
+
+func (c *Cache[T]) refresh() error {
+	c.mutex.RLock()
+	var since ticketmatic.Time
+	if c.items != nil {
+		since = ticketmatic.NewTime(c.since)
+	}
+	c.mutex.RUnlock()
+
+	now := c.registry.now()
+	items, err := c.fetch(c.registry.Client, since)
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.items == nil {
+		c.items = make(map[int64]*T, len(items))
+	}
+	for _, item := range items {
+		c.items[c.id(item)] = item
+		if t := c.updated(item); t.After(c.since) {
+			c.since = t
+		}
+	}
+	c.refreshed = now
+	c.stale = false
+	return nil
+}
+
+// Whether the cache can be used without refreshing. Must hold the mutex.
+func (c *Cache[T]) fresh() bool {
+	return c.items != nil && !c.stale && !c.expired()
+}
+
+// Whether the last refresh is older than the TTL. Must hold the mutex.
+func (c *Cache[T]) expired() bool {
+	return c.registry.now().Sub(c.refreshed) >= c.registry.ttl()
+}
+
+// Whether an eventstream item type concerns this cache
+func (c *Cache[T]) matches(itemType string) bool {
+	for _, prefix := range c.EventTypes {
+		if strings.HasPrefix(itemType, prefix) {
+			return true
+		}
+	}
+	return false
+}
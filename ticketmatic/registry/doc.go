@@ -0,0 +1,20 @@
+// In-memory registry of settings objects, for looking up the names of price
+// types, sales channels and other settings without requesting them for
+// every order.
+//
+//	reg := registry.New(client)
+//	name := reg.PriceTypeName(ticket.Pricetypeid)
+//
+// Each type of settings object is cached separately. The first lookup loads
+// all objects (including archived ones), later refreshes only request the
+// objects that changed since, using the Lastupdatesince query field. Cached
+// objects are refreshed once they are older than the TTL, or when they are
+// invalidated.
+//
+// Follow polls the eventstream and invalidates caches as soon as matching
+// items arrive:
+//
+//	go reg.Follow(ctx, 10*time.Second)
+//
+// A Registry is safe for concurrent use.
+package registry
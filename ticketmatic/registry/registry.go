@@ -0,0 +1,228 @@
+package registry
+
+import (
+	"context"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/eventstream"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/pricetypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/products"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/deliveryscenarios"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/paymentmethods"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/paymentscenarios"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/saleschannels"
+)
+
+// Time after which cached objects are refreshed, unless set on the Registry
+const DefaultTTL = 5 * time.Minute
+
+// Cached settings objects of an account
+type Registry struct {
+	Client *ticketmatic.Client
+
+	// Time after which cached objects are refreshed (default: DefaultTTL)
+	TTL time.Duration
+
+	PriceTypes        *Cache[ticketmatic.PriceType]
+	SalesChannels     *Cache[ticketmatic.SalesChannel]
+	PaymentMethods    *Cache[ticketmatic.PaymentMethod]
+	PaymentScenarios  *Cache[ticketmatic.PaymentScenario]
+	DeliveryScenarios *Cache[ticketmatic.DeliveryScenario]
+	Products          *Cache[ticketmatic.Product]
+
+	// Current time, replaced in tests
+	clock func() time.Time
+}
+
+// Create a registry, objects are loaded on the first lookup
+func New(client *ticketmatic.Client) *Registry {
+	r := &Registry{
+		Client: client,
+		clock:  time.Now,
+	}
+
+	r.PriceTypes = newCache(r, "price type", []string{"pricetype"}, func(c *ticketmatic.Client, since ticketmatic.Time) ([]*ticketmatic.PriceType, error) {
+		l, err := pricetypes.Getlist(c, &ticketmatic.PriceTypeQuery{Includearchived: true, Lastupdatesince: since})
+		if err != nil {
+			return nil, err
+		}
+		return l.Data, nil
+	}, func(o *ticketmatic.PriceType) int64 { return o.Id }, func(o *ticketmatic.PriceType) time.Time { return o.Lastupdatets.Time() })
+
+	r.SalesChannels = newCache(r, "sales channel", []string{"saleschannel"}, func(c *ticketmatic.Client, since ticketmatic.Time) ([]*ticketmatic.SalesChannel, error) {
+		l, err := saleschannels.Getlist(c, &ticketmatic.SalesChannelQuery{Includearchived: true, Lastupdatesince: since})
+		if err != nil {
+			return nil, err
+		}
+		return l.Data, nil
+	}, func(o *ticketmatic.SalesChannel) int64 { return o.Id }, func(o *ticketmatic.SalesChannel) time.Time { return o.Lastupdatets.Time() })
+
+	r.PaymentMethods = newCache(r, "payment method", []string{"paymentmethod"}, func(c *ticketmatic.Client, since ticketmatic.Time) ([]*ticketmatic.PaymentMethod, error) {
+		l, err := paymentmethods.Getlist(c, &ticketmatic.PaymentMethodQuery{Includearchived: true, Lastupdatesince: since})
+		if err != nil {
+			return nil, err
+		}
+		return l.Data, nil
+	}, func(o *ticketmatic.PaymentMethod) int64 { return o.Id }, func(o *ticketmatic.PaymentMethod) time.Time { return o.Lastupdatets.Time() })
+
+	r.PaymentScenarios = newCache(r, "payment scenario", []string{"paymentscenario"}, func(c *ticketmatic.Client, since ticketmatic.Time) ([]*ticketmatic.PaymentScenario, error) {
+		l, err := paymentscenarios.Getlist(c, &ticketmatic.PaymentScenarioQuery{Includearchived: true, Lastupdatesince: since})
+		if err != nil {
+			return nil, err
+		}
+		return l.Data, nil
+	}, func(o *ticketmatic.PaymentScenario) int64 { return o.Id }, func(o *ticketmatic.PaymentScenario) time.Time { return o.Lastupdatets.Time() })
+
+	r.DeliveryScenarios = newCache(r, "delivery scenario", []string{"deliveryscenario"}, func(c *ticketmatic.Client, since ticketmatic.Time) ([]*ticketmatic.DeliveryScenario, error) {
+		l, err := deliveryscenarios.Getlist(c, &ticketmatic.DeliveryScenarioQuery{Includearchived: true, Lastupdatesince: since})
+		if err != nil {
+			return nil, err
+		}
+		return l.Data, nil
+	}, func(o *ticketmatic.DeliveryScenario) int64 { return o.Id }, func(o *ticketmatic.DeliveryScenario) time.Time { return o.Lastupdatets.Time() })
+
+	r.Products = newCache(r, "product", []string{"product"}, func(c *ticketmatic.Client, since ticketmatic.Time) ([]*ticketmatic.Product, error) {
+		l, err := products.Getlist(c, &ticketmatic.ProductQuery{Includearchived: true, Lastupdatesince: since})
+		if err != nil {
+			return nil, err
+		}
+		return l.Data, nil
+	}, func(o *ticketmatic.Product) int64 { return o.Id }, func(o *ticketmatic.Product) time.Time { return o.Lastupdatets.Time() })
+
+	return r
+}
+
+func (r *Registry) now() time.Time {
+	return r.clock()
+}
+
+func (r *Registry) ttl() time.Duration {
+	if r.TTL == 0 {
+		return DefaultTTL
+	}
+	return r.TTL
+}
+
+type cache interface {
+	matches(itemType string) bool
+	Refresh() error
+	Invalidate()
+}
+
+func (r *Registry) caches() []cache {
+	return []cache{r.PriceTypes, r.SalesChannels, r.PaymentMethods, r.PaymentScenarios, r.DeliveryScenarios, r.Products}
+}
+
+// Refresh all caches
+func (r *Registry) Refresh() error {
+	for _, c := range r.caches() {
+		err := c.Refresh()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Invalidate all caches
+func (r *Registry) Invalidate() {
+	for _, c := range r.caches() {
+		c.Invalidate()
+	}
+}
+
+// Invalidate the caches that an eventstream item concerns
+func (r *Registry) Handle(item *ticketmatic.EventstreamItem) {
+	for _, c := range r.caches() {
+		if c.matches(item.Type) {
+			c.Invalidate()
+		}
+	}
+}
+
+// Poll the eventstream, starting now, and handle its items until ctx is
+// done. Waits interval between polls once caught up.
+func (r *Registry) Follow(ctx context.Context, interval time.Duration) error {
+	req := &ticketmatic.EventstreamRequest{
+		Ts: r.now().UTC().Format(time.RFC3339),
+	}
+	for {
+		res, err := eventstream.Eventstream(r.Client, req)
+		if err != nil {
+			return err
+		}
+		for _, item := range res.Results {
+			r.Handle(item)
+		}
+
+		// Continue from the next id, not the starting timestamp
+		if res.Nextid != "" {
+			req.Id = res.Nextid
+			req.Ts = ""
+		}
+		if !res.Moreresults {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+		} else if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// Name of a price type, empty when it can't be looked up
+func (r *Registry) PriceTypeName(id int64) string {
+	o, err := r.PriceTypes.Get(id)
+	if err != nil {
+		return ""
+	}
+	return o.Name
+}
+
+// Name of a sales channel, empty when it can't be looked up
+func (r *Registry) SalesChannelName(id int64) string {
+	o, err := r.SalesChannels.Get(id)
+	if err != nil {
+		return ""
+	}
+	return o.Name
+}
+
+// Name of a payment method, empty when it can't be looked up
+func (r *Registry) PaymentMethodName(id int64) string {
+	o, err := r.PaymentMethods.Get(id)
+	if err != nil {
+		return ""
+	}
+	return o.Name
+}
+
+// Name of a payment scenario, empty when it can't be looked up
+func (r *Registry) PaymentScenarioName(id int64) string {
+	o, err := r.PaymentScenarios.Get(id)
+	if err != nil {
+		return ""
+	}
+	return o.Name
+}
+
+// Name of a delivery scenario, empty when it can't be looked up
+func (r *Registry) DeliveryScenarioName(id int64) string {
+	o, err := r.DeliveryScenarios.Get(id)
+	if err != nil {
+		return ""
+	}
+	return o.Name
+}
+
+// Name of a product, empty when it can't be looked up
+func (r *Registry) ProductName(id int64) string {
+	o, err := r.Products.Get(id)
+	if err != nil {
+		return ""
+	}
+	return o.Name
+}
@@ -0,0 +1,119 @@
+package registry
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apitest"
+)
+
+const pricetypesPath = "settings/pricing/pricetypes"
+
+func setup(t *testing.T) (*apitest.Account, *Registry, *time.Time) {
+	account := apitest.New(map[string][]map[string]interface{}{
+		pricetypesPath: {
+			{"id": 1, "name": "Regular", "lastupdatets": "2026-01-01 10:00:00"},
+			{"id": 2, "name": "Student", "lastupdatets": "2026-01-02 10:00:00", "isarchived": true},
+		},
+	})
+
+	now := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	r := New(account.Client(t))
+	r.TTL = time.Minute
+	r.clock = func() time.Time { return now }
+	return account, r, &now
+}
+
+// The lastupdatesince parameter of each request for the price types, which
+// must all include the archived ones
+func requests(t *testing.T, account *apitest.Account) []string {
+	var result []string
+	for _, req := range account.Requests(pricetypesPath) {
+		if req.Query.Get("includearchived") != "true" {
+			t.Errorf("Expected includearchived, got %s", req.Query.Encode())
+		}
+		result = append(result, strings.Trim(req.Query.Get("lastupdatesince"), `"`))
+	}
+	return result
+}
+
+func TestRegistry(t *testing.T) {
+	account, r, now := setup(t)
+
+	if name := r.PriceTypeName(2); name != "Student" {
+		t.Errorf("Unexpected name, got %#v, expected %#v", name, "Student")
+	}
+	if name := r.PriceTypeName(1); name != "Regular" {
+		t.Errorf("Unexpected name, got %#v, expected %#v", name, "Regular")
+	}
+	if name := r.PriceTypeName(3); name != "" {
+		t.Errorf("Unexpected name, got %#v, expected %#v", name, "")
+	}
+	_, err := r.PriceTypes.Get(3)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+	if since := requests(t, account); len(since) != 1 || since[0] != "" {
+		t.Errorf("Unexpected requests, got %#v", since)
+	}
+
+	// Incremental refresh after the TTL
+	pricetypes := account.Objects[pricetypesPath]
+	account.Objects[pricetypesPath] = append(pricetypes, map[string]interface{}{"id": 3, "name": "Senior", "lastupdatets": "2026-02-01 11:00:00"})
+	pricetypes[0]["name"] = "Regular price"
+	pricetypes[0]["lastupdatets"] = "2026-02-01 11:00:00"
+	*now = now.Add(2 * time.Minute)
+
+	if name := r.PriceTypeName(3); name != "Senior" {
+		t.Errorf("Unexpected name, got %#v, expected %#v", name, "Senior")
+	}
+	if name := r.PriceTypeName(1); name != "Regular price" {
+		t.Errorf("Unexpected name, got %#v, expected %#v", name, "Regular price")
+	}
+	if since := requests(t, account); len(since) != 2 || since[1] != "2026-01-02T10:00:00" {
+		t.Errorf("Unexpected requests, got %#v", since)
+	}
+
+	all, err := r.PriceTypes.All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 || all[0].Id != 1 || all[2].Id != 3 {
+		t.Errorf("Unexpected price types, got %#v", all)
+	}
+
+	// Eventstream items invalidate matching caches only
+	r.Handle(&ticketmatic.EventstreamItem{Type: "saleschannel.updated"})
+	r.PriceTypeName(1)
+	if since := requests(t, account); len(since) != 2 {
+		t.Errorf("Unexpected requests, got %#v", since)
+	}
+	r.Handle(&ticketmatic.EventstreamItem{Type: "pricetype.updated"})
+	r.PriceTypeName(1)
+	if since := requests(t, account); len(since) != 3 {
+		t.Errorf("Unexpected requests, got %#v", since)
+	}
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	account, r, _ := setup(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if name := r.PriceTypeName(1); name != "Regular" {
+				t.Errorf("Unexpected name, got %#v, expected %#v", name, "Regular")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if since := requests(t, account); len(since) != 1 {
+		t.Errorf("Unexpected requests, got %#v", since)
+	}
+}
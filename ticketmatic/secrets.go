@@ -0,0 +1,118 @@
+package ticketmatic
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// A pair of API keys
+type Keys struct {
+	AccessKey string
+	SecretKey string
+}
+
+// Supplies the API keys of an account, for instance from a secrets manager.
+// Set as Client.Secrets to look up the keys for each request.
+//
+// Implementations must be safe for concurrent use. Lookups that are slow or
+// rate limited should be wrapped in CachedSecrets.
+type SecretProvider interface {
+	Keys(accountcode string) (*Keys, error)
+}
+
+// Adapts a function to a SecretProvider
+type SecretFunc func(accountcode string) (*Keys, error)
+
+func (f SecretFunc) Keys(accountcode string) (*Keys, error) {
+	return f(accountcode)
+}
+
+// Get the API keys to sign a request with: those from the SecretProvider if
+// set, those set with SetKeys otherwise, and AccessKey and SecretKey if
+// neither is used.
+func (c *Client) Keys() (*Keys, error) {
+	if c.Secrets != nil {
+		k, err := c.Secrets.Keys(c.AccountCode)
+		if err != nil {
+			return nil, err
+		}
+		if k == nil || k.AccessKey == "" || k.SecretKey == "" {
+			return nil, errors.New("No API keys for account " + c.AccountCode)
+		}
+		return k, nil
+	}
+	if k := c.keys.Load(); k != nil {
+		return k, nil
+	}
+	return &Keys{
+		AccessKey: c.AccessKey,
+		SecretKey: c.SecretKey,
+	}, nil
+}
+
+// Replace the API keys of the client atomically.
+//
+// Safe to call while requests are in flight: every request is signed with
+// either the old or the new pair, never a mix of both. Once called, the
+// AccessKey and SecretKey fields are no longer used.
+func (c *Client) SetKeys(accesskey, secretkey string) {
+	c.keys.Store(&Keys{
+		AccessKey: accesskey,
+		SecretKey: secretkey,
+	})
+}
+
+// Cached keys of an account. The mutex is held during lookups, so concurrent
+// requests for the account wait for a single lookup.
+type cachedKeys struct {
+	mutex   sync.Mutex
+	keys    *Keys
+	expires time.Time
+}
+
+type cachedSecrets struct {
+	provider SecretProvider
+	ttl      time.Duration
+
+	mutex sync.Mutex
+	cache map[string]*cachedKeys
+}
+
+// Cache the keys returned by a provider for the given duration, per account.
+// Rotated keys are picked up once the cached ones expire.
+//
+// Lookups of different accounts run concurrently, concurrent requests for the
+// same account share a single lookup.
+func CachedSecrets(provider SecretProvider, ttl time.Duration) SecretProvider {
+	return &cachedSecrets{
+		provider: provider,
+		ttl:      ttl,
+		cache:    make(map[string]*cachedKeys),
+	}
+}
+
+func (s *cachedSecrets) Keys(accountcode string) (*Keys, error) {
+	s.mutex.Lock()
+	c, ok := s.cache[accountcode]
+	if !ok {
+		c = &cachedKeys{}
+		s.cache[accountcode] = c
+	}
+	s.mutex.Unlock()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.keys != nil && time.Now().Before(c.expires) {
+		return c.keys, nil
+	}
+
+	k, err := s.provider.Keys(accountcode)
+	if err != nil {
+		return nil, err
+	}
+	c.keys = k
+	c.expires = time.Now().Add(s.ttl)
+	return k, nil
+}
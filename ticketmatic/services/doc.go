@@ -0,0 +1,23 @@
+// Service interfaces for the operations of the API packages, so they can be
+// replaced in tests.
+//
+// Each API package has a service with the same operations, taking a context
+// instead of the client:
+//
+//	api := services.New(client)
+//	order, err := api.Orders().Get(ctx, 1)
+//
+// Code that depends on the services (or on the API interface) can be tested
+// with the mocks in the mock package:
+//
+//	m := mock.New()
+//	m.OrdersMock.GetFunc = func(ctx context.Context, id int64) (*ticketmatic.Order, error) {
+//		return &ticketmatic.Order{Orderid: id}, nil
+//	}
+//	process(m)
+//
+// The functions of the API packages stay as they are, the services call them.
+// The interfaces and mocks are generated from them with go generate.
+package services
+
+//go:generate go run gen.go
@@ -0,0 +1,352 @@
+//go:build ignore
+
+// Generates the service interfaces, their implementation and the mocks from
+// the operations of the API packages.
+//
+//	go generate ./ticketmatic/services
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path"
+	"sort"
+	"strings"
+)
+
+const module = "github.com/ticketmatic/tm-go/ticketmatic"
+
+// API packages (relative to the ticketmatic directory) and their service names
+var packages = []struct {
+	Dir  string
+	Name string
+}{
+	{"orders", "Orders"},
+	{"events", "Events"},
+	{"contacts", "Contacts"},
+	{"jobs", "Jobs"},
+	{"subscribers", "Subscribers"},
+	{"eventstream", "Eventstream"},
+	{"tools", "Tools"},
+	{"diagnostics", "Diagnostics"},
+	{"sales/waitinglistrequests", "WaitingListRequests"},
+	{"settings/accountparameters", "AccountParameters"},
+	{"settings/communicationanddesign/documents", "Documents"},
+	{"settings/communicationanddesign/ordermails", "OrderMails"},
+	{"settings/communicationanddesign/ticketlayouts", "TicketLayouts"},
+	{"settings/communicationanddesign/ticketlayouttemplates", "TicketLayoutTemplates"},
+	{"settings/communicationanddesign/webskins", "WebSkins"},
+	{"settings/events/eventlocations", "EventLocations"},
+	{"settings/pricing/orderfeedefinitions", "OrderFeeDefinitions"},
+	{"settings/pricing/pricelists", "PriceLists"},
+	{"settings/pricing/pricetypes", "PriceTypes"},
+	{"settings/pricing/ticketfees", "TicketFees"},
+	{"settings/productcategories", "ProductCategories"},
+	{"settings/products", "Products"},
+	{"settings/seatingplans/seatingplans", "SeatingPlans"},
+	{"settings/seatingplans/seatranks", "SeatRanks"},
+	{"settings/system/contactaddresstypes", "ContactAddressTypes"},
+	{"settings/system/contactfields", "ContactFields"},
+	{"settings/system/contacttitles", "ContactTitles"},
+	{"settings/system/customfields", "CustomFields"},
+	{"settings/system/customfieldvalues", "CustomFieldValues"},
+	{"settings/system/dupedetectrules", "DupeDetectRules"},
+	{"settings/system/fielddefinitions", "FieldDefinitions"},
+	{"settings/system/filterdefinitions", "FilterDefinitions"},
+	{"settings/system/optins", "OptIns"},
+	{"settings/system/phonenumbertypes", "PhoneNumberTypes"},
+	{"settings/system/relationtypes", "RelationTypes"},
+	{"settings/system/reports", "Reports"},
+	{"settings/system/ticketsalesflows", "TicketSalesFlows"},
+	{"settings/system/ticketsalessetups", "TicketSalesSetups"},
+	{"settings/system/views", "Views"},
+	{"settings/ticketsales/deliveryscenarios", "DeliveryScenarios"},
+	{"settings/ticketsales/locktypes", "LockTypes"},
+	{"settings/ticketsales/orderfees", "OrderFees"},
+	{"settings/ticketsales/paymentmethods", "PaymentMethods"},
+	{"settings/ticketsales/paymentscenarios", "PaymentScenarios"},
+	{"settings/ticketsales/saleschannels", "SalesChannels"},
+	{"settings/vouchers", "Vouchers"},
+}
+
+type param struct {
+	Name string
+	Type string
+}
+
+type operation struct {
+	Name    string
+	Doc     string
+	Params  []param
+	Results []string
+}
+
+type service struct {
+	Name       string
+	Pkg        string
+	Path       string
+	Operations []*operation
+}
+
+func main() {
+	var services []*service
+	imports := map[string]bool{
+		"context": true,
+		module:    true,
+	}
+	mockImports := map[string]bool{
+		"context":            true,
+		"errors":             true,
+		module:               true,
+		module + "/services": true,
+	}
+	for _, p := range packages {
+		s, used := parse(p.Dir, p.Name)
+		services = append(services, s)
+		imports[s.Path] = true
+		for i := range used {
+			imports[i] = true
+			mockImports[i] = true
+		}
+	}
+
+	write("services_gen.go", "services", imports, services, genServices)
+	write("mock/mock_gen.go", "mock", mockImports, services, genMocks)
+}
+
+// Parse the operations of a package. Returns the imports used by their
+// signatures.
+func parse(dir, name string) (*service, map[string]bool) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path.Join("..", dir, "operations.go"), nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fileImports := make(map[string]string)
+	for _, spec := range f.Imports {
+		p := strings.Trim(spec.Path.Value, `"`)
+		fileImports[path.Base(p)] = p
+	}
+
+	s := &service{
+		Name: name,
+		Pkg:  f.Name.Name,
+		Path: module + "/" + dir,
+	}
+	used := make(map[string]bool)
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !fn.Name.IsExported() {
+			continue
+		}
+		params := fn.Type.Params.List
+		if len(params) == 0 || typeString(params[0].Type, s.Pkg, nil) != "*ticketmatic.Client" {
+			continue
+		}
+
+		op := &operation{Name: fn.Name.Name, Doc: summary(fn.Doc)}
+		for _, field := range params[1:] {
+			for _, n := range field.Names {
+				if n.Name == "ctx" {
+					log.Fatalf("%s.%s: parameter named ctx", s.Pkg, op.Name)
+				}
+				op.Params = append(op.Params, param{n.Name, typeString(field.Type, s.Pkg, used)})
+			}
+		}
+		for _, field := range fn.Type.Results.List {
+			op.Results = append(op.Results, typeString(field.Type, s.Pkg, used))
+		}
+		s.Operations = append(s.Operations, op)
+	}
+
+	// Imports needed by the signatures, including the package itself when
+	// they use its types
+	paths := make(map[string]bool)
+	for pkg := range used {
+		if pkg == s.Pkg {
+			paths[s.Path] = true
+		} else {
+			paths[fileImports[pkg]] = true
+		}
+	}
+	return s, paths
+}
+
+// First paragraph of a doc comment
+func summary(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	text := strings.TrimSpace(doc.Text())
+	if i := strings.Index(text, "\n\n"); i >= 0 {
+		text = text[:i]
+	}
+	return text
+}
+
+// Print a type, qualifying the types of the package itself
+func typeString(expr ast.Expr, pkg string, used map[string]bool) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			if used != nil {
+				used[pkg] = true
+			}
+			return pkg + "." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		x := t.X.(*ast.Ident).Name
+		if used != nil && x != "ticketmatic" {
+			used[x] = true
+		}
+		return x + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X, pkg, used)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt, pkg, used)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key, pkg, used) + "]" + typeString(t.Value, pkg, used)
+	case *ast.InterfaceType:
+		return "interface{}"
+	}
+	log.Fatalf("Unsupported type %T", expr)
+	return ""
+}
+
+func write(file, pkg string, imports map[string]bool, services []*service, gen func(*bytes.Buffer, []*service)) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen.go; DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg)
+	var paths []string
+	for p := range imports {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	std := true
+	for _, p := range paths {
+		// Standard library first
+		if std && strings.Contains(strings.Split(p, "/")[0], ".") {
+			std = false
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "\t%q\n", p)
+	}
+	b.WriteString(")\n")
+	gen(&b, services)
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("%s: %s\n%s", file, err, b.Bytes())
+	}
+	err = ioutil.WriteFile(file, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func comment(b *bytes.Buffer, indent, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(b, "%s// %s\n", indent, line)
+	}
+}
+
+func (op *operation) signature() string {
+	params := []string{"ctx context.Context"}
+	for _, p := range op.Params {
+		params = append(params, p.Name+" "+p.Type)
+	}
+	results := strings.Join(op.Results, ", ")
+	if len(op.Results) > 1 {
+		results = "(" + results + ")"
+	}
+	return fmt.Sprintf("%s(%s) %s", op.Name, strings.Join(params, ", "), results)
+}
+
+func (op *operation) args() []string {
+	var args []string
+	for _, p := range op.Params {
+		args = append(args, p.Name)
+	}
+	return args
+}
+
+func genServices(b *bytes.Buffer, services []*service) {
+	b.WriteString("\n// All services\ntype API interface {\n")
+	for _, s := range services {
+		fmt.Fprintf(b, "\t%s() %sService\n", s.Name, s.Name)
+	}
+	b.WriteString("}\n")
+
+	for _, s := range services {
+		fmt.Fprintf(b, "\n// Operations of the %s package\ntype %sService interface {\n", s.Pkg, s.Name)
+		for i, op := range s.Operations {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			comment(b, "\t", op.Doc)
+			fmt.Fprintf(b, "\t%s\n", op.signature())
+		}
+		b.WriteString("}\n")
+
+		impl := strings.ToLower(s.Name[:1]) + s.Name[1:] + "Service"
+		fmt.Fprintf(b, "\ntype %s struct {\n\tclient *ticketmatic.Client\n}\n", impl)
+		fmt.Fprintf(b, "\nfunc (c *Client) %s() %sService {\n\treturn &%s{c.client}\n}\n", s.Name, s.Name, impl)
+		for _, op := range s.Operations {
+			args := append([]string{"s.client.WithContext(ctx)"}, op.args()...)
+			fmt.Fprintf(b, "\nfunc (s *%s) %s {\n\treturn %s.%s(%s)\n}\n", impl, op.signature(), s.Pkg, op.Name, strings.Join(args, ", "))
+		}
+	}
+}
+
+func genMocks(b *bytes.Buffer, services []*service) {
+	b.WriteString("\n// Mocks of all services\ntype API struct {\n")
+	for _, s := range services {
+		fmt.Fprintf(b, "\t%sMock *%s\n", s.Name, s.Name)
+	}
+	b.WriteString("}\n\n// Create mocks of all services\nfunc New() *API {\n\treturn &API{\n")
+	for _, s := range services {
+		fmt.Fprintf(b, "\t\t%sMock: &%s{},\n", s.Name, s.Name)
+	}
+	b.WriteString("\t}\n}\n\nvar _ services.API = (*API)(nil)\n")
+	for _, s := range services {
+		fmt.Fprintf(b, "\nfunc (m *API) %s() services.%sService {\n\treturn m.%sMock\n}\n", s.Name, s.Name, s.Name)
+	}
+
+	for _, s := range services {
+		fmt.Fprintf(b, "\n// Mock of services.%sService. Operations call the function set in the\n// matching field, or fail when it isn't set.\ntype %s struct {\n\tRecorder\n\n", s.Name, s.Name)
+		for _, op := range s.Operations {
+			sig := op.signature()
+			fmt.Fprintf(b, "\t%sFunc func%s\n", op.Name, sig[len(op.Name):])
+		}
+		fmt.Fprintf(b, "}\n\nvar _ services.%sService = (*%s)(nil)\n", s.Name, s.Name)
+
+		for _, op := range s.Operations {
+			// Named results, to return zero values
+			var results []string
+			for i, r := range op.Results {
+				name := fmt.Sprintf("r%d", i)
+				if i == len(op.Results)-1 {
+					name = "err"
+				}
+				results = append(results, name+" "+r)
+			}
+			params := []string{"ctx context.Context"}
+			for _, p := range op.Params {
+				params = append(params, p.Name+" "+p.Type)
+			}
+			args := append([]string{"ctx"}, op.args()...)
+
+			fmt.Fprintf(b, "\nfunc (m *%s) %s(%s) (%s) {\n", s.Name, op.Name, strings.Join(params, ", "), strings.Join(results, ", "))
+			fmt.Fprintf(b, "\tm.record(%s)\n", strings.Join(append([]string{fmt.Sprintf("%q", op.Name)}, op.args()...), ", "))
+			fmt.Fprintf(b, "\tif m.%sFunc == nil {\n\t\terr = errors.New(\"mock: %s.%s not set\")\n\t\treturn\n\t}\n", op.Name, s.Name, op.Name)
+			fmt.Fprintf(b, "\treturn m.%sFunc(%s)\n}\n", op.Name, strings.Join(args, ", "))
+		}
+	}
+}
@@ -0,0 +1,12 @@
+// Mocks of the service interfaces, for unit tests without an API account.
+//
+// Each mock has a function field per operation (GetFunc for Get) and records
+// the calls made to it. Operations whose function isn't set return an error.
+//
+//	m := mock.New()
+//	m.OrdersMock.GetFunc = func(ctx context.Context, id int64) (*ticketmatic.Order, error) {
+//		return &ticketmatic.Order{Orderid: id}, nil
+//	}
+//	...
+//	calls := m.OrdersMock.CallsTo("Get")
+package mock
@@ -0,0 +1,3895 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package mock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/contacts"
+	"github.com/ticketmatic/tm-go/ticketmatic/events"
+	"github.com/ticketmatic/tm-go/ticketmatic/orders"
+	"github.com/ticketmatic/tm-go/ticketmatic/sales/waitinglistrequests"
+	"github.com/ticketmatic/tm-go/ticketmatic/services"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/documents"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/ordermails"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/ticketlayouts"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/ticketlayouttemplates"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/webskins"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/events/eventlocations"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/orderfeedefinitions"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/pricelists"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/pricetypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/ticketfees"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/productcategories"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/products"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/seatingplans/seatingplans"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/seatingplans/seatranks"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/contactaddresstypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/contactfields"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/contacttitles"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/customfields"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/customfieldvalues"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/dupedetectrules"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/fielddefinitions"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/filterdefinitions"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/optins"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/phonenumbertypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/relationtypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/reports"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/ticketsalesflows"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/ticketsalessetups"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/views"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/deliveryscenarios"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/locktypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/orderfees"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/paymentmethods"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/paymentscenarios"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/saleschannels"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/vouchers"
+	"github.com/ticketmatic/tm-go/ticketmatic/tools"
+)
+
+// Mocks of all services
+type API struct {
+	OrdersMock                *Orders
+	EventsMock                *Events
+	ContactsMock              *Contacts
+	JobsMock                  *Jobs
+	SubscribersMock           *Subscribers
+	EventstreamMock           *Eventstream
+	ToolsMock                 *Tools
+	DiagnosticsMock           *Diagnostics
+	WaitingListRequestsMock   *WaitingListRequests
+	AccountParametersMock     *AccountParameters
+	DocumentsMock             *Documents
+	OrderMailsMock            *OrderMails
+	TicketLayoutsMock         *TicketLayouts
+	TicketLayoutTemplatesMock *TicketLayoutTemplates
+	WebSkinsMock              *WebSkins
+	EventLocationsMock        *EventLocations
+	OrderFeeDefinitionsMock   *OrderFeeDefinitions
+	PriceListsMock            *PriceLists
+	PriceTypesMock            *PriceTypes
+	TicketFeesMock            *TicketFees
+	ProductCategoriesMock     *ProductCategories
+	ProductsMock              *Products
+	SeatingPlansMock          *SeatingPlans
+	SeatRanksMock             *SeatRanks
+	ContactAddressTypesMock   *ContactAddressTypes
+	ContactFieldsMock         *ContactFields
+	ContactTitlesMock         *ContactTitles
+	CustomFieldsMock          *CustomFields
+	CustomFieldValuesMock     *CustomFieldValues
+	DupeDetectRulesMock       *DupeDetectRules
+	FieldDefinitionsMock      *FieldDefinitions
+	FilterDefinitionsMock     *FilterDefinitions
+	OptInsMock                *OptIns
+	PhoneNumberTypesMock      *PhoneNumberTypes
+	RelationTypesMock         *RelationTypes
+	ReportsMock               *Reports
+	TicketSalesFlowsMock      *TicketSalesFlows
+	TicketSalesSetupsMock     *TicketSalesSetups
+	ViewsMock                 *Views
+	DeliveryScenariosMock     *DeliveryScenarios
+	LockTypesMock             *LockTypes
+	OrderFeesMock             *OrderFees
+	PaymentMethodsMock        *PaymentMethods
+	PaymentScenariosMock      *PaymentScenarios
+	SalesChannelsMock         *SalesChannels
+	VouchersMock              *Vouchers
+}
+
+// Create mocks of all services
+func New() *API {
+	return &API{
+		OrdersMock:                &Orders{},
+		EventsMock:                &Events{},
+		ContactsMock:              &Contacts{},
+		JobsMock:                  &Jobs{},
+		SubscribersMock:           &Subscribers{},
+		EventstreamMock:           &Eventstream{},
+		ToolsMock:                 &Tools{},
+		DiagnosticsMock:           &Diagnostics{},
+		WaitingListRequestsMock:   &WaitingListRequests{},
+		AccountParametersMock:     &AccountParameters{},
+		DocumentsMock:             &Documents{},
+		OrderMailsMock:            &OrderMails{},
+		TicketLayoutsMock:         &TicketLayouts{},
+		TicketLayoutTemplatesMock: &TicketLayoutTemplates{},
+		WebSkinsMock:              &WebSkins{},
+		EventLocationsMock:        &EventLocations{},
+		OrderFeeDefinitionsMock:   &OrderFeeDefinitions{},
+		PriceListsMock:            &PriceLists{},
+		PriceTypesMock:            &PriceTypes{},
+		TicketFeesMock:            &TicketFees{},
+		ProductCategoriesMock:     &ProductCategories{},
+		ProductsMock:              &Products{},
+		SeatingPlansMock:          &SeatingPlans{},
+		SeatRanksMock:             &SeatRanks{},
+		ContactAddressTypesMock:   &ContactAddressTypes{},
+		ContactFieldsMock:         &ContactFields{},
+		ContactTitlesMock:         &ContactTitles{},
+		CustomFieldsMock:          &CustomFields{},
+		CustomFieldValuesMock:     &CustomFieldValues{},
+		DupeDetectRulesMock:       &DupeDetectRules{},
+		FieldDefinitionsMock:      &FieldDefinitions{},
+		FilterDefinitionsMock:     &FilterDefinitions{},
+		OptInsMock:                &OptIns{},
+		PhoneNumberTypesMock:      &PhoneNumberTypes{},
+		RelationTypesMock:         &RelationTypes{},
+		ReportsMock:               &Reports{},
+		TicketSalesFlowsMock:      &TicketSalesFlows{},
+		TicketSalesSetupsMock:     &TicketSalesSetups{},
+		ViewsMock:                 &Views{},
+		DeliveryScenariosMock:     &DeliveryScenarios{},
+		LockTypesMock:             &LockTypes{},
+		OrderFeesMock:             &OrderFees{},
+		PaymentMethodsMock:        &PaymentMethods{},
+		PaymentScenariosMock:      &PaymentScenarios{},
+		SalesChannelsMock:         &SalesChannels{},
+		VouchersMock:              &Vouchers{},
+	}
+}
+
+var _ services.API = (*API)(nil)
+
+func (m *API) Orders() services.OrdersService {
+	return m.OrdersMock
+}
+
+func (m *API) Events() services.EventsService {
+	return m.EventsMock
+}
+
+func (m *API) Contacts() services.ContactsService {
+	return m.ContactsMock
+}
+
+func (m *API) Jobs() services.JobsService {
+	return m.JobsMock
+}
+
+func (m *API) Subscribers() services.SubscribersService {
+	return m.SubscribersMock
+}
+
+func (m *API) Eventstream() services.EventstreamService {
+	return m.EventstreamMock
+}
+
+func (m *API) Tools() services.ToolsService {
+	return m.ToolsMock
+}
+
+func (m *API) Diagnostics() services.DiagnosticsService {
+	return m.DiagnosticsMock
+}
+
+func (m *API) WaitingListRequests() services.WaitingListRequestsService {
+	return m.WaitingListRequestsMock
+}
+
+func (m *API) AccountParameters() services.AccountParametersService {
+	return m.AccountParametersMock
+}
+
+func (m *API) Documents() services.DocumentsService {
+	return m.DocumentsMock
+}
+
+func (m *API) OrderMails() services.OrderMailsService {
+	return m.OrderMailsMock
+}
+
+func (m *API) TicketLayouts() services.TicketLayoutsService {
+	return m.TicketLayoutsMock
+}
+
+func (m *API) TicketLayoutTemplates() services.TicketLayoutTemplatesService {
+	return m.TicketLayoutTemplatesMock
+}
+
+func (m *API) WebSkins() services.WebSkinsService {
+	return m.WebSkinsMock
+}
+
+func (m *API) EventLocations() services.EventLocationsService {
+	return m.EventLocationsMock
+}
+
+func (m *API) OrderFeeDefinitions() services.OrderFeeDefinitionsService {
+	return m.OrderFeeDefinitionsMock
+}
+
+func (m *API) PriceLists() services.PriceListsService {
+	return m.PriceListsMock
+}
+
+func (m *API) PriceTypes() services.PriceTypesService {
+	return m.PriceTypesMock
+}
+
+func (m *API) TicketFees() services.TicketFeesService {
+	return m.TicketFeesMock
+}
+
+func (m *API) ProductCategories() services.ProductCategoriesService {
+	return m.ProductCategoriesMock
+}
+
+func (m *API) Products() services.ProductsService {
+	return m.ProductsMock
+}
+
+func (m *API) SeatingPlans() services.SeatingPlansService {
+	return m.SeatingPlansMock
+}
+
+func (m *API) SeatRanks() services.SeatRanksService {
+	return m.SeatRanksMock
+}
+
+func (m *API) ContactAddressTypes() services.ContactAddressTypesService {
+	return m.ContactAddressTypesMock
+}
+
+func (m *API) ContactFields() services.ContactFieldsService {
+	return m.ContactFieldsMock
+}
+
+func (m *API) ContactTitles() services.ContactTitlesService {
+	return m.ContactTitlesMock
+}
+
+func (m *API) CustomFields() services.CustomFieldsService {
+	return m.CustomFieldsMock
+}
+
+func (m *API) CustomFieldValues() services.CustomFieldValuesService {
+	return m.CustomFieldValuesMock
+}
+
+func (m *API) DupeDetectRules() services.DupeDetectRulesService {
+	return m.DupeDetectRulesMock
+}
+
+func (m *API) FieldDefinitions() services.FieldDefinitionsService {
+	return m.FieldDefinitionsMock
+}
+
+func (m *API) FilterDefinitions() services.FilterDefinitionsService {
+	return m.FilterDefinitionsMock
+}
+
+func (m *API) OptIns() services.OptInsService {
+	return m.OptInsMock
+}
+
+func (m *API) PhoneNumberTypes() services.PhoneNumberTypesService {
+	return m.PhoneNumberTypesMock
+}
+
+func (m *API) RelationTypes() services.RelationTypesService {
+	return m.RelationTypesMock
+}
+
+func (m *API) Reports() services.ReportsService {
+	return m.ReportsMock
+}
+
+func (m *API) TicketSalesFlows() services.TicketSalesFlowsService {
+	return m.TicketSalesFlowsMock
+}
+
+func (m *API) TicketSalesSetups() services.TicketSalesSetupsService {
+	return m.TicketSalesSetupsMock
+}
+
+func (m *API) Views() services.ViewsService {
+	return m.ViewsMock
+}
+
+func (m *API) DeliveryScenarios() services.DeliveryScenariosService {
+	return m.DeliveryScenariosMock
+}
+
+func (m *API) LockTypes() services.LockTypesService {
+	return m.LockTypesMock
+}
+
+func (m *API) OrderFees() services.OrderFeesService {
+	return m.OrderFeesMock
+}
+
+func (m *API) PaymentMethods() services.PaymentMethodsService {
+	return m.PaymentMethodsMock
+}
+
+func (m *API) PaymentScenarios() services.PaymentScenariosService {
+	return m.PaymentScenariosMock
+}
+
+func (m *API) SalesChannels() services.SalesChannelsService {
+	return m.SalesChannelsMock
+}
+
+func (m *API) Vouchers() services.VouchersService {
+	return m.VouchersMock
+}
+
+// Mock of services.OrdersService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Orders struct {
+	Recorder
+
+	GetlistFunc                            func(ctx context.Context, params *ticketmatic.OrderQuery) (*orders.List, error)
+	GetFunc                                func(ctx context.Context, id int64) (*ticketmatic.Order, error)
+	CreateFunc                             func(ctx context.Context, data *ticketmatic.CreateOrder) (*ticketmatic.Order, error)
+	UpdateFunc                             func(ctx context.Context, id int64, data *ticketmatic.UpdateOrder) (*ticketmatic.Order, error)
+	DeleteFunc                             func(ctx context.Context, id int64) error
+	BatchFunc                              func(ctx context.Context, data *ticketmatic.BatchOrderOperation) error
+	DeletebatchFunc                        func(ctx context.Context, data []int64) (*ticketmatic.BatchResult, error)
+	ConfirmFunc                            func(ctx context.Context, id int64) (*ticketmatic.Order, error)
+	SplitFunc                              func(ctx context.Context, id int64, data *ticketmatic.SplitOrder) (*ticketmatic.Order, error)
+	AddticketsFunc                         func(ctx context.Context, id int64, data *ticketmatic.AddTickets) (*ticketmatic.AddItemsResult, error)
+	UpdateticketsFunc                      func(ctx context.Context, id int64, data *ticketmatic.UpdateTickets) (*ticketmatic.Order, error)
+	DeleteticketsFunc                      func(ctx context.Context, id int64, data *ticketmatic.DeleteTickets) (*ticketmatic.Order, error)
+	AddproductsFunc                        func(ctx context.Context, id int64, data *ticketmatic.AddProducts) (*ticketmatic.AddItemsResult, error)
+	UpdateproductsFunc                     func(ctx context.Context, id int64, data *ticketmatic.UpdateProducts) (*ticketmatic.Order, error)
+	DeleteproductsFunc                     func(ctx context.Context, id int64, data *ticketmatic.DeleteProducts) (*ticketmatic.Order, error)
+	AddpaymentsFunc                        func(ctx context.Context, id int64, data *ticketmatic.AddPayments) (*ticketmatic.Order, error)
+	AddrefundsFunc                         func(ctx context.Context, id int64, data *ticketmatic.AddRefunds) (*ticketmatic.Order, error)
+	GetlogsFunc                            func(ctx context.Context, id int64) ([]*ticketmatic.LogItem, error)
+	PostticketspdfFunc                     func(ctx context.Context, id int64, data *ticketmatic.TicketsPdfRequest) (*ticketmatic.Url, error)
+	PostpdfFunc                            func(ctx context.Context, id int64, data *ticketmatic.TicketsPdfRequest) (*ticketmatic.Url, error)
+	PostticketsemaildeliveryFunc           func(ctx context.Context, id int64, data *ticketmatic.TicketsEmaildeliveryRequest) (*ticketmatic.Order, error)
+	PostticketsemailpaymentinstructionFunc func(ctx context.Context, id int64) (*ticketmatic.Order, error)
+	PostpaymentrequestFunc                 func(ctx context.Context, id int64, data *ticketmatic.PaymentRequest) (*ticketmatic.Url, error)
+	CancelpaymentrequestFunc               func(ctx context.Context, id int64) error
+	GetdocumentFunc                        func(ctx context.Context, id int64, documentid string, language string) (*ticketmatic.Url, error)
+	ImportFunc                             func(ctx context.Context, data []*ticketmatic.ImportOrder) ([]*ticketmatic.OrderImportStatus, error)
+	ReserveFunc                            func(ctx context.Context, data *ticketmatic.OrderIdReservation) (*ticketmatic.OrderIdReservation, error)
+	PurgeFunc                              func(ctx context.Context, params *ticketmatic.PurgeOrdersRequest) (string, error)
+}
+
+var _ services.OrdersService = (*Orders)(nil)
+
+func (m *Orders) Getlist(ctx context.Context, params *ticketmatic.OrderQuery) (r0 *orders.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: Orders.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *Orders) Get(ctx context.Context, id int64) (r0 *ticketmatic.Order, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: Orders.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *Orders) Create(ctx context.Context, data *ticketmatic.CreateOrder) (r0 *ticketmatic.Order, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: Orders.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *Orders) Update(ctx context.Context, id int64, data *ticketmatic.UpdateOrder) (r0 *ticketmatic.Order, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: Orders.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *Orders) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: Orders.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *Orders) Batch(ctx context.Context, data *ticketmatic.BatchOrderOperation) (err error) {
+	m.record("Batch", data)
+	if m.BatchFunc == nil {
+		err = errors.New("mock: Orders.Batch not set")
+		return
+	}
+	return m.BatchFunc(ctx, data)
+}
+
+func (m *Orders) Deletebatch(ctx context.Context, data []int64) (r0 *ticketmatic.BatchResult, err error) {
+	m.record("Deletebatch", data)
+	if m.DeletebatchFunc == nil {
+		err = errors.New("mock: Orders.Deletebatch not set")
+		return
+	}
+	return m.DeletebatchFunc(ctx, data)
+}
+
+func (m *Orders) Confirm(ctx context.Context, id int64) (r0 *ticketmatic.Order, err error) {
+	m.record("Confirm", id)
+	if m.ConfirmFunc == nil {
+		err = errors.New("mock: Orders.Confirm not set")
+		return
+	}
+	return m.ConfirmFunc(ctx, id)
+}
+
+func (m *Orders) Split(ctx context.Context, id int64, data *ticketmatic.SplitOrder) (r0 *ticketmatic.Order, err error) {
+	m.record("Split", id, data)
+	if m.SplitFunc == nil {
+		err = errors.New("mock: Orders.Split not set")
+		return
+	}
+	return m.SplitFunc(ctx, id, data)
+}
+
+func (m *Orders) Addtickets(ctx context.Context, id int64, data *ticketmatic.AddTickets) (r0 *ticketmatic.AddItemsResult, err error) {
+	m.record("Addtickets", id, data)
+	if m.AddticketsFunc == nil {
+		err = errors.New("mock: Orders.Addtickets not set")
+		return
+	}
+	return m.AddticketsFunc(ctx, id, data)
+}
+
+func (m *Orders) Updatetickets(ctx context.Context, id int64, data *ticketmatic.UpdateTickets) (r0 *ticketmatic.Order, err error) {
+	m.record("Updatetickets", id, data)
+	if m.UpdateticketsFunc == nil {
+		err = errors.New("mock: Orders.Updatetickets not set")
+		return
+	}
+	return m.UpdateticketsFunc(ctx, id, data)
+}
+
+func (m *Orders) Deletetickets(ctx context.Context, id int64, data *ticketmatic.DeleteTickets) (r0 *ticketmatic.Order, err error) {
+	m.record("Deletetickets", id, data)
+	if m.DeleteticketsFunc == nil {
+		err = errors.New("mock: Orders.Deletetickets not set")
+		return
+	}
+	return m.DeleteticketsFunc(ctx, id, data)
+}
+
+func (m *Orders) Addproducts(ctx context.Context, id int64, data *ticketmatic.AddProducts) (r0 *ticketmatic.AddItemsResult, err error) {
+	m.record("Addproducts", id, data)
+	if m.AddproductsFunc == nil {
+		err = errors.New("mock: Orders.Addproducts not set")
+		return
+	}
+	return m.AddproductsFunc(ctx, id, data)
+}
+
+func (m *Orders) Updateproducts(ctx context.Context, id int64, data *ticketmatic.UpdateProducts) (r0 *ticketmatic.Order, err error) {
+	m.record("Updateproducts", id, data)
+	if m.UpdateproductsFunc == nil {
+		err = errors.New("mock: Orders.Updateproducts not set")
+		return
+	}
+	return m.UpdateproductsFunc(ctx, id, data)
+}
+
+func (m *Orders) Deleteproducts(ctx context.Context, id int64, data *ticketmatic.DeleteProducts) (r0 *ticketmatic.Order, err error) {
+	m.record("Deleteproducts", id, data)
+	if m.DeleteproductsFunc == nil {
+		err = errors.New("mock: Orders.Deleteproducts not set")
+		return
+	}
+	return m.DeleteproductsFunc(ctx, id, data)
+}
+
+func (m *Orders) Addpayments(ctx context.Context, id int64, data *ticketmatic.AddPayments) (r0 *ticketmatic.Order, err error) {
+	m.record("Addpayments", id, data)
+	if m.AddpaymentsFunc == nil {
+		err = errors.New("mock: Orders.Addpayments not set")
+		return
+	}
+	return m.AddpaymentsFunc(ctx, id, data)
+}
+
+func (m *Orders) Addrefunds(ctx context.Context, id int64, data *ticketmatic.AddRefunds) (r0 *ticketmatic.Order, err error) {
+	m.record("Addrefunds", id, data)
+	if m.AddrefundsFunc == nil {
+		err = errors.New("mock: Orders.Addrefunds not set")
+		return
+	}
+	return m.AddrefundsFunc(ctx, id, data)
+}
+
+func (m *Orders) Getlogs(ctx context.Context, id int64) (r0 []*ticketmatic.LogItem, err error) {
+	m.record("Getlogs", id)
+	if m.GetlogsFunc == nil {
+		err = errors.New("mock: Orders.Getlogs not set")
+		return
+	}
+	return m.GetlogsFunc(ctx, id)
+}
+
+func (m *Orders) Postticketspdf(ctx context.Context, id int64, data *ticketmatic.TicketsPdfRequest) (r0 *ticketmatic.Url, err error) {
+	m.record("Postticketspdf", id, data)
+	if m.PostticketspdfFunc == nil {
+		err = errors.New("mock: Orders.Postticketspdf not set")
+		return
+	}
+	return m.PostticketspdfFunc(ctx, id, data)
+}
+
+func (m *Orders) Postpdf(ctx context.Context, id int64, data *ticketmatic.TicketsPdfRequest) (r0 *ticketmatic.Url, err error) {
+	m.record("Postpdf", id, data)
+	if m.PostpdfFunc == nil {
+		err = errors.New("mock: Orders.Postpdf not set")
+		return
+	}
+	return m.PostpdfFunc(ctx, id, data)
+}
+
+func (m *Orders) Postticketsemaildelivery(ctx context.Context, id int64, data *ticketmatic.TicketsEmaildeliveryRequest) (r0 *ticketmatic.Order, err error) {
+	m.record("Postticketsemaildelivery", id, data)
+	if m.PostticketsemaildeliveryFunc == nil {
+		err = errors.New("mock: Orders.Postticketsemaildelivery not set")
+		return
+	}
+	return m.PostticketsemaildeliveryFunc(ctx, id, data)
+}
+
+func (m *Orders) Postticketsemailpaymentinstruction(ctx context.Context, id int64) (r0 *ticketmatic.Order, err error) {
+	m.record("Postticketsemailpaymentinstruction", id)
+	if m.PostticketsemailpaymentinstructionFunc == nil {
+		err = errors.New("mock: Orders.Postticketsemailpaymentinstruction not set")
+		return
+	}
+	return m.PostticketsemailpaymentinstructionFunc(ctx, id)
+}
+
+func (m *Orders) Postpaymentrequest(ctx context.Context, id int64, data *ticketmatic.PaymentRequest) (r0 *ticketmatic.Url, err error) {
+	m.record("Postpaymentrequest", id, data)
+	if m.PostpaymentrequestFunc == nil {
+		err = errors.New("mock: Orders.Postpaymentrequest not set")
+		return
+	}
+	return m.PostpaymentrequestFunc(ctx, id, data)
+}
+
+func (m *Orders) Cancelpaymentrequest(ctx context.Context, id int64) (err error) {
+	m.record("Cancelpaymentrequest", id)
+	if m.CancelpaymentrequestFunc == nil {
+		err = errors.New("mock: Orders.Cancelpaymentrequest not set")
+		return
+	}
+	return m.CancelpaymentrequestFunc(ctx, id)
+}
+
+func (m *Orders) Getdocument(ctx context.Context, id int64, documentid string, language string) (r0 *ticketmatic.Url, err error) {
+	m.record("Getdocument", id, documentid, language)
+	if m.GetdocumentFunc == nil {
+		err = errors.New("mock: Orders.Getdocument not set")
+		return
+	}
+	return m.GetdocumentFunc(ctx, id, documentid, language)
+}
+
+func (m *Orders) Import(ctx context.Context, data []*ticketmatic.ImportOrder) (r0 []*ticketmatic.OrderImportStatus, err error) {
+	m.record("Import", data)
+	if m.ImportFunc == nil {
+		err = errors.New("mock: Orders.Import not set")
+		return
+	}
+	return m.ImportFunc(ctx, data)
+}
+
+func (m *Orders) Reserve(ctx context.Context, data *ticketmatic.OrderIdReservation) (r0 *ticketmatic.OrderIdReservation, err error) {
+	m.record("Reserve", data)
+	if m.ReserveFunc == nil {
+		err = errors.New("mock: Orders.Reserve not set")
+		return
+	}
+	return m.ReserveFunc(ctx, data)
+}
+
+func (m *Orders) Purge(ctx context.Context, params *ticketmatic.PurgeOrdersRequest) (r0 string, err error) {
+	m.record("Purge", params)
+	if m.PurgeFunc == nil {
+		err = errors.New("mock: Orders.Purge not set")
+		return
+	}
+	return m.PurgeFunc(ctx, params)
+}
+
+// Mock of services.EventsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Events struct {
+	Recorder
+
+	GetlistFunc                  func(ctx context.Context, params *ticketmatic.EventQuery) (*events.List, error)
+	GetFunc                      func(ctx context.Context, id int64) (*ticketmatic.Event, error)
+	CreateFunc                   func(ctx context.Context, data *ticketmatic.Event) (*ticketmatic.Event, error)
+	UpdateFunc                   func(ctx context.Context, id int64, data *ticketmatic.Event) (*ticketmatic.Event, error)
+	BatchFunc                    func(ctx context.Context, data *ticketmatic.BatchEventOperation) error
+	DeleteFunc                   func(ctx context.Context, id int64) error
+	GetticketsFunc               func(ctx context.Context, id int64, params *ticketmatic.EventTicketQuery) (*events.TicketStream, error)
+	BatchupdateticketsFunc       func(ctx context.Context, id int64, data []*ticketmatic.EventTicket) error
+	LockticketsFunc              func(ctx context.Context, id int64, data *ticketmatic.EventLockTickets) error
+	UnlockticketsFunc            func(ctx context.Context, id int64, data *ticketmatic.EventUnlockTickets) error
+	UpdateseatrankforticketsFunc func(ctx context.Context, id int64, data *ticketmatic.EventUpdateSeatRankForTickets) error
+	ScanticketsoutFunc           func(ctx context.Context, id int64, data *ticketmatic.EventScanTicketsOut) ([]int64, error)
+	TranslationsFunc             func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc                func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+	PurgeFunc                    func(ctx context.Context, id int64) error
+	SaveimageFunc                func(ctx context.Context, id int64, data string) (string, error)
+	DeleteimageFunc              func(ctx context.Context, id int64) error
+}
+
+var _ services.EventsService = (*Events)(nil)
+
+func (m *Events) Getlist(ctx context.Context, params *ticketmatic.EventQuery) (r0 *events.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: Events.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *Events) Get(ctx context.Context, id int64) (r0 *ticketmatic.Event, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: Events.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *Events) Create(ctx context.Context, data *ticketmatic.Event) (r0 *ticketmatic.Event, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: Events.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *Events) Update(ctx context.Context, id int64, data *ticketmatic.Event) (r0 *ticketmatic.Event, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: Events.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *Events) Batch(ctx context.Context, data *ticketmatic.BatchEventOperation) (err error) {
+	m.record("Batch", data)
+	if m.BatchFunc == nil {
+		err = errors.New("mock: Events.Batch not set")
+		return
+	}
+	return m.BatchFunc(ctx, data)
+}
+
+func (m *Events) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: Events.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *Events) Gettickets(ctx context.Context, id int64, params *ticketmatic.EventTicketQuery) (r0 *events.TicketStream, err error) {
+	m.record("Gettickets", id, params)
+	if m.GetticketsFunc == nil {
+		err = errors.New("mock: Events.Gettickets not set")
+		return
+	}
+	return m.GetticketsFunc(ctx, id, params)
+}
+
+func (m *Events) Batchupdatetickets(ctx context.Context, id int64, data []*ticketmatic.EventTicket) (err error) {
+	m.record("Batchupdatetickets", id, data)
+	if m.BatchupdateticketsFunc == nil {
+		err = errors.New("mock: Events.Batchupdatetickets not set")
+		return
+	}
+	return m.BatchupdateticketsFunc(ctx, id, data)
+}
+
+func (m *Events) Locktickets(ctx context.Context, id int64, data *ticketmatic.EventLockTickets) (err error) {
+	m.record("Locktickets", id, data)
+	if m.LockticketsFunc == nil {
+		err = errors.New("mock: Events.Locktickets not set")
+		return
+	}
+	return m.LockticketsFunc(ctx, id, data)
+}
+
+func (m *Events) Unlocktickets(ctx context.Context, id int64, data *ticketmatic.EventUnlockTickets) (err error) {
+	m.record("Unlocktickets", id, data)
+	if m.UnlockticketsFunc == nil {
+		err = errors.New("mock: Events.Unlocktickets not set")
+		return
+	}
+	return m.UnlockticketsFunc(ctx, id, data)
+}
+
+func (m *Events) Updateseatrankfortickets(ctx context.Context, id int64, data *ticketmatic.EventUpdateSeatRankForTickets) (err error) {
+	m.record("Updateseatrankfortickets", id, data)
+	if m.UpdateseatrankforticketsFunc == nil {
+		err = errors.New("mock: Events.Updateseatrankfortickets not set")
+		return
+	}
+	return m.UpdateseatrankforticketsFunc(ctx, id, data)
+}
+
+func (m *Events) Scanticketsout(ctx context.Context, id int64, data *ticketmatic.EventScanTicketsOut) (r0 []int64, err error) {
+	m.record("Scanticketsout", id, data)
+	if m.ScanticketsoutFunc == nil {
+		err = errors.New("mock: Events.Scanticketsout not set")
+		return
+	}
+	return m.ScanticketsoutFunc(ctx, id, data)
+}
+
+func (m *Events) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: Events.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *Events) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: Events.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+func (m *Events) Purge(ctx context.Context, id int64) (err error) {
+	m.record("Purge", id)
+	if m.PurgeFunc == nil {
+		err = errors.New("mock: Events.Purge not set")
+		return
+	}
+	return m.PurgeFunc(ctx, id)
+}
+
+func (m *Events) Saveimage(ctx context.Context, id int64, data string) (r0 string, err error) {
+	m.record("Saveimage", id, data)
+	if m.SaveimageFunc == nil {
+		err = errors.New("mock: Events.Saveimage not set")
+		return
+	}
+	return m.SaveimageFunc(ctx, id, data)
+}
+
+func (m *Events) Deleteimage(ctx context.Context, id int64) (err error) {
+	m.record("Deleteimage", id)
+	if m.DeleteimageFunc == nil {
+		err = errors.New("mock: Events.Deleteimage not set")
+		return
+	}
+	return m.DeleteimageFunc(ctx, id)
+}
+
+// Mock of services.ContactsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Contacts struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.ContactQuery) (*contacts.List, error)
+	GetFunc          func(ctx context.Context, id int64, params *ticketmatic.ContactGetQuery) (*ticketmatic.Contact, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.Contact) (*ticketmatic.Contact, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.Contact) (*ticketmatic.Contact, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	BatchFunc        func(ctx context.Context, data *ticketmatic.BatchContactOperation) error
+	ImportFunc       func(ctx context.Context, data []*ticketmatic.Contact) ([]*ticketmatic.ContactImportStatus, error)
+	ReserveFunc      func(ctx context.Context, data *ticketmatic.ContactIdReservation) (*ticketmatic.ContactIdReservation, error)
+	GetremarkFunc    func(ctx context.Context, id int64, remarkid string) (*ticketmatic.ContactRemark, error)
+	CreateremarkFunc func(ctx context.Context, id int64, data *ticketmatic.ContactRemark) (*ticketmatic.ContactRemark, error)
+	UpdateremarkFunc func(ctx context.Context, id int64, remarkid string, data *ticketmatic.ContactRemark) (*ticketmatic.ContactRemark, error)
+	DeleteremarkFunc func(ctx context.Context, id int64, remarkid string) error
+}
+
+var _ services.ContactsService = (*Contacts)(nil)
+
+func (m *Contacts) Getlist(ctx context.Context, params *ticketmatic.ContactQuery) (r0 *contacts.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: Contacts.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *Contacts) Get(ctx context.Context, id int64, params *ticketmatic.ContactGetQuery) (r0 *ticketmatic.Contact, err error) {
+	m.record("Get", id, params)
+	if m.GetFunc == nil {
+		err = errors.New("mock: Contacts.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id, params)
+}
+
+func (m *Contacts) Create(ctx context.Context, data *ticketmatic.Contact) (r0 *ticketmatic.Contact, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: Contacts.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *Contacts) Update(ctx context.Context, id int64, data *ticketmatic.Contact) (r0 *ticketmatic.Contact, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: Contacts.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *Contacts) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: Contacts.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *Contacts) Batch(ctx context.Context, data *ticketmatic.BatchContactOperation) (err error) {
+	m.record("Batch", data)
+	if m.BatchFunc == nil {
+		err = errors.New("mock: Contacts.Batch not set")
+		return
+	}
+	return m.BatchFunc(ctx, data)
+}
+
+func (m *Contacts) Import(ctx context.Context, data []*ticketmatic.Contact) (r0 []*ticketmatic.ContactImportStatus, err error) {
+	m.record("Import", data)
+	if m.ImportFunc == nil {
+		err = errors.New("mock: Contacts.Import not set")
+		return
+	}
+	return m.ImportFunc(ctx, data)
+}
+
+func (m *Contacts) Reserve(ctx context.Context, data *ticketmatic.ContactIdReservation) (r0 *ticketmatic.ContactIdReservation, err error) {
+	m.record("Reserve", data)
+	if m.ReserveFunc == nil {
+		err = errors.New("mock: Contacts.Reserve not set")
+		return
+	}
+	return m.ReserveFunc(ctx, data)
+}
+
+func (m *Contacts) Getremark(ctx context.Context, id int64, remarkid string) (r0 *ticketmatic.ContactRemark, err error) {
+	m.record("Getremark", id, remarkid)
+	if m.GetremarkFunc == nil {
+		err = errors.New("mock: Contacts.Getremark not set")
+		return
+	}
+	return m.GetremarkFunc(ctx, id, remarkid)
+}
+
+func (m *Contacts) Createremark(ctx context.Context, id int64, data *ticketmatic.ContactRemark) (r0 *ticketmatic.ContactRemark, err error) {
+	m.record("Createremark", id, data)
+	if m.CreateremarkFunc == nil {
+		err = errors.New("mock: Contacts.Createremark not set")
+		return
+	}
+	return m.CreateremarkFunc(ctx, id, data)
+}
+
+func (m *Contacts) Updateremark(ctx context.Context, id int64, remarkid string, data *ticketmatic.ContactRemark) (r0 *ticketmatic.ContactRemark, err error) {
+	m.record("Updateremark", id, remarkid, data)
+	if m.UpdateremarkFunc == nil {
+		err = errors.New("mock: Contacts.Updateremark not set")
+		return
+	}
+	return m.UpdateremarkFunc(ctx, id, remarkid, data)
+}
+
+func (m *Contacts) Deleteremark(ctx context.Context, id int64, remarkid string) (err error) {
+	m.record("Deleteremark", id, remarkid)
+	if m.DeleteremarkFunc == nil {
+		err = errors.New("mock: Contacts.Deleteremark not set")
+		return
+	}
+	return m.DeleteremarkFunc(ctx, id, remarkid)
+}
+
+// Mock of services.JobsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Jobs struct {
+	Recorder
+
+	GetFunc func(ctx context.Context, id int64) (*ticketmatic.JobResult, error)
+}
+
+var _ services.JobsService = (*Jobs)(nil)
+
+func (m *Jobs) Get(ctx context.Context, id int64) (r0 *ticketmatic.JobResult, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: Jobs.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// Mock of services.SubscribersService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Subscribers struct {
+	Recorder
+
+	SyncFunc           func(ctx context.Context, data []*ticketmatic.SubscriberSync) error
+	CommunicationsFunc func(ctx context.Context, data *ticketmatic.SubscriberCommunication) error
+}
+
+var _ services.SubscribersService = (*Subscribers)(nil)
+
+func (m *Subscribers) Sync(ctx context.Context, data []*ticketmatic.SubscriberSync) (err error) {
+	m.record("Sync", data)
+	if m.SyncFunc == nil {
+		err = errors.New("mock: Subscribers.Sync not set")
+		return
+	}
+	return m.SyncFunc(ctx, data)
+}
+
+func (m *Subscribers) Communications(ctx context.Context, data *ticketmatic.SubscriberCommunication) (err error) {
+	m.record("Communications", data)
+	if m.CommunicationsFunc == nil {
+		err = errors.New("mock: Subscribers.Communications not set")
+		return
+	}
+	return m.CommunicationsFunc(ctx, data)
+}
+
+// Mock of services.EventstreamService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Eventstream struct {
+	Recorder
+
+	EventstreamFunc func(ctx context.Context, params *ticketmatic.EventstreamRequest) (*ticketmatic.EventstreamResult, error)
+}
+
+var _ services.EventstreamService = (*Eventstream)(nil)
+
+func (m *Eventstream) Eventstream(ctx context.Context, params *ticketmatic.EventstreamRequest) (r0 *ticketmatic.EventstreamResult, err error) {
+	m.record("Eventstream", params)
+	if m.EventstreamFunc == nil {
+		err = errors.New("mock: Eventstream.Eventstream not set")
+		return
+	}
+	return m.EventstreamFunc(ctx, params)
+}
+
+// Mock of services.ToolsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Tools struct {
+	Recorder
+
+	AccountFunc                    func(ctx context.Context) (*ticketmatic.AccountInfo, error)
+	AccountsFunc                   func(ctx context.Context) ([]*ticketmatic.AccountInfo, error)
+	QueriesFunc                    func(ctx context.Context, data *ticketmatic.QueryRequest) (*ticketmatic.QueryResult, error)
+	ExportFunc                     func(ctx context.Context, data *ticketmatic.QueryRequest) (*tools.QueryStream, error)
+	TicketsprocessedstatisticsFunc func(ctx context.Context, params *ticketmatic.TicketsprocessedRequest) ([]*ticketmatic.TicketsprocessedStatistics, error)
+}
+
+var _ services.ToolsService = (*Tools)(nil)
+
+func (m *Tools) Account(ctx context.Context) (r0 *ticketmatic.AccountInfo, err error) {
+	m.record("Account")
+	if m.AccountFunc == nil {
+		err = errors.New("mock: Tools.Account not set")
+		return
+	}
+	return m.AccountFunc(ctx)
+}
+
+func (m *Tools) Accounts(ctx context.Context) (r0 []*ticketmatic.AccountInfo, err error) {
+	m.record("Accounts")
+	if m.AccountsFunc == nil {
+		err = errors.New("mock: Tools.Accounts not set")
+		return
+	}
+	return m.AccountsFunc(ctx)
+}
+
+func (m *Tools) Queries(ctx context.Context, data *ticketmatic.QueryRequest) (r0 *ticketmatic.QueryResult, err error) {
+	m.record("Queries", data)
+	if m.QueriesFunc == nil {
+		err = errors.New("mock: Tools.Queries not set")
+		return
+	}
+	return m.QueriesFunc(ctx, data)
+}
+
+func (m *Tools) Export(ctx context.Context, data *ticketmatic.QueryRequest) (r0 *tools.QueryStream, err error) {
+	m.record("Export", data)
+	if m.ExportFunc == nil {
+		err = errors.New("mock: Tools.Export not set")
+		return
+	}
+	return m.ExportFunc(ctx, data)
+}
+
+func (m *Tools) Ticketsprocessedstatistics(ctx context.Context, params *ticketmatic.TicketsprocessedRequest) (r0 []*ticketmatic.TicketsprocessedStatistics, err error) {
+	m.record("Ticketsprocessedstatistics", params)
+	if m.TicketsprocessedstatisticsFunc == nil {
+		err = errors.New("mock: Tools.Ticketsprocessedstatistics not set")
+		return
+	}
+	return m.TicketsprocessedstatisticsFunc(ctx, params)
+}
+
+// Mock of services.DiagnosticsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Diagnostics struct {
+	Recorder
+
+	TimeFunc func(ctx context.Context) (*ticketmatic.Timestamp, error)
+}
+
+var _ services.DiagnosticsService = (*Diagnostics)(nil)
+
+func (m *Diagnostics) Time(ctx context.Context) (r0 *ticketmatic.Timestamp, err error) {
+	m.record("Time")
+	if m.TimeFunc == nil {
+		err = errors.New("mock: Diagnostics.Time not set")
+		return
+	}
+	return m.TimeFunc(ctx)
+}
+
+// Mock of services.WaitingListRequestsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type WaitingListRequests struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.WaitingListRequestQuery) (*waitinglistrequests.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.WaitingListRequest, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.WaitingListRequest) (*ticketmatic.WaitingListRequest, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.WaitingListRequest) (*ticketmatic.WaitingListRequest, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.WaitingListRequestsService = (*WaitingListRequests)(nil)
+
+func (m *WaitingListRequests) Getlist(ctx context.Context, params *ticketmatic.WaitingListRequestQuery) (r0 *waitinglistrequests.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: WaitingListRequests.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *WaitingListRequests) Get(ctx context.Context, id int64) (r0 *ticketmatic.WaitingListRequest, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: WaitingListRequests.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *WaitingListRequests) Create(ctx context.Context, data *ticketmatic.WaitingListRequest) (r0 *ticketmatic.WaitingListRequest, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: WaitingListRequests.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *WaitingListRequests) Update(ctx context.Context, id int64, data *ticketmatic.WaitingListRequest) (r0 *ticketmatic.WaitingListRequest, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: WaitingListRequests.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *WaitingListRequests) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: WaitingListRequests.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.AccountParametersService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type AccountParameters struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context) ([]*ticketmatic.AccountParameter, error)
+	GetFunc     func(ctx context.Context, name string) (*ticketmatic.AccountParameter, error)
+	SetFunc     func(ctx context.Context, data *ticketmatic.AccountParameter) (*ticketmatic.AccountParameter, error)
+}
+
+var _ services.AccountParametersService = (*AccountParameters)(nil)
+
+func (m *AccountParameters) Getlist(ctx context.Context) (r0 []*ticketmatic.AccountParameter, err error) {
+	m.record("Getlist")
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: AccountParameters.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx)
+}
+
+func (m *AccountParameters) Get(ctx context.Context, name string) (r0 *ticketmatic.AccountParameter, err error) {
+	m.record("Get", name)
+	if m.GetFunc == nil {
+		err = errors.New("mock: AccountParameters.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, name)
+}
+
+func (m *AccountParameters) Set(ctx context.Context, data *ticketmatic.AccountParameter) (r0 *ticketmatic.AccountParameter, err error) {
+	m.record("Set", data)
+	if m.SetFunc == nil {
+		err = errors.New("mock: AccountParameters.Set not set")
+		return
+	}
+	return m.SetFunc(ctx, data)
+}
+
+// Mock of services.DocumentsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Documents struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.DocumentQuery) (*documents.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.Document, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.Document) (*ticketmatic.Document, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.Document) (*ticketmatic.Document, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.DocumentsService = (*Documents)(nil)
+
+func (m *Documents) Getlist(ctx context.Context, params *ticketmatic.DocumentQuery) (r0 *documents.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: Documents.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *Documents) Get(ctx context.Context, id int64) (r0 *ticketmatic.Document, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: Documents.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *Documents) Create(ctx context.Context, data *ticketmatic.Document) (r0 *ticketmatic.Document, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: Documents.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *Documents) Update(ctx context.Context, id int64, data *ticketmatic.Document) (r0 *ticketmatic.Document, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: Documents.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *Documents) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: Documents.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *Documents) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: Documents.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *Documents) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: Documents.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.OrderMailsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type OrderMails struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.OrderMailTemplateQuery) (*ordermails.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.OrderMailTemplate, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.OrderMailTemplate) (*ticketmatic.OrderMailTemplate, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.OrderMailTemplate) (*ticketmatic.OrderMailTemplate, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.OrderMailsService = (*OrderMails)(nil)
+
+func (m *OrderMails) Getlist(ctx context.Context, params *ticketmatic.OrderMailTemplateQuery) (r0 *ordermails.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: OrderMails.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *OrderMails) Get(ctx context.Context, id int64) (r0 *ticketmatic.OrderMailTemplate, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: OrderMails.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *OrderMails) Create(ctx context.Context, data *ticketmatic.OrderMailTemplate) (r0 *ticketmatic.OrderMailTemplate, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: OrderMails.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *OrderMails) Update(ctx context.Context, id int64, data *ticketmatic.OrderMailTemplate) (r0 *ticketmatic.OrderMailTemplate, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: OrderMails.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *OrderMails) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: OrderMails.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.TicketLayoutsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type TicketLayouts struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.TicketLayoutQuery) (*ticketlayouts.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.TicketLayout, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.TicketLayout) (*ticketmatic.TicketLayout, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.TicketLayout) (*ticketmatic.TicketLayout, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.TicketLayoutsService = (*TicketLayouts)(nil)
+
+func (m *TicketLayouts) Getlist(ctx context.Context, params *ticketmatic.TicketLayoutQuery) (r0 *ticketlayouts.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: TicketLayouts.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *TicketLayouts) Get(ctx context.Context, id int64) (r0 *ticketmatic.TicketLayout, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: TicketLayouts.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *TicketLayouts) Create(ctx context.Context, data *ticketmatic.TicketLayout) (r0 *ticketmatic.TicketLayout, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: TicketLayouts.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *TicketLayouts) Update(ctx context.Context, id int64, data *ticketmatic.TicketLayout) (r0 *ticketmatic.TicketLayout, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: TicketLayouts.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *TicketLayouts) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: TicketLayouts.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.TicketLayoutTemplatesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type TicketLayoutTemplates struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.TicketLayoutTemplateQuery) (*ticketlayouttemplates.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.TicketLayoutTemplate, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.TicketLayoutTemplate) (*ticketmatic.TicketLayoutTemplate, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.TicketLayoutTemplate) (*ticketmatic.TicketLayoutTemplate, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.TicketLayoutTemplatesService = (*TicketLayoutTemplates)(nil)
+
+func (m *TicketLayoutTemplates) Getlist(ctx context.Context, params *ticketmatic.TicketLayoutTemplateQuery) (r0 *ticketlayouttemplates.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: TicketLayoutTemplates.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *TicketLayoutTemplates) Get(ctx context.Context, id int64) (r0 *ticketmatic.TicketLayoutTemplate, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: TicketLayoutTemplates.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *TicketLayoutTemplates) Create(ctx context.Context, data *ticketmatic.TicketLayoutTemplate) (r0 *ticketmatic.TicketLayoutTemplate, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: TicketLayoutTemplates.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *TicketLayoutTemplates) Update(ctx context.Context, id int64, data *ticketmatic.TicketLayoutTemplate) (r0 *ticketmatic.TicketLayoutTemplate, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: TicketLayoutTemplates.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *TicketLayoutTemplates) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: TicketLayoutTemplates.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.WebSkinsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type WebSkins struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.WebSalesSkinQuery) (*webskins.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.WebSalesSkin, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.WebSalesSkin) (*ticketmatic.WebSalesSkin, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.WebSalesSkin) (*ticketmatic.WebSalesSkin, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.WebSkinsService = (*WebSkins)(nil)
+
+func (m *WebSkins) Getlist(ctx context.Context, params *ticketmatic.WebSalesSkinQuery) (r0 *webskins.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: WebSkins.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *WebSkins) Get(ctx context.Context, id int64) (r0 *ticketmatic.WebSalesSkin, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: WebSkins.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *WebSkins) Create(ctx context.Context, data *ticketmatic.WebSalesSkin) (r0 *ticketmatic.WebSalesSkin, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: WebSkins.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *WebSkins) Update(ctx context.Context, id int64, data *ticketmatic.WebSalesSkin) (r0 *ticketmatic.WebSalesSkin, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: WebSkins.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *WebSkins) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: WebSkins.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.EventLocationsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type EventLocations struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.EventLocationQuery) (*eventlocations.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.EventLocation, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.EventLocation) (*ticketmatic.EventLocation, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.EventLocation) (*ticketmatic.EventLocation, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.EventLocationsService = (*EventLocations)(nil)
+
+func (m *EventLocations) Getlist(ctx context.Context, params *ticketmatic.EventLocationQuery) (r0 *eventlocations.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: EventLocations.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *EventLocations) Get(ctx context.Context, id int64) (r0 *ticketmatic.EventLocation, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: EventLocations.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *EventLocations) Create(ctx context.Context, data *ticketmatic.EventLocation) (r0 *ticketmatic.EventLocation, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: EventLocations.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *EventLocations) Update(ctx context.Context, id int64, data *ticketmatic.EventLocation) (r0 *ticketmatic.EventLocation, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: EventLocations.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *EventLocations) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: EventLocations.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *EventLocations) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: EventLocations.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *EventLocations) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: EventLocations.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.OrderFeeDefinitionsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type OrderFeeDefinitions struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.OrderFeeDefinitionQuery) (*orderfeedefinitions.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.OrderFeeDefinition, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.OrderFeeDefinition) (*ticketmatic.OrderFeeDefinition, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.OrderFeeDefinitionsService = (*OrderFeeDefinitions)(nil)
+
+func (m *OrderFeeDefinitions) Getlist(ctx context.Context, params *ticketmatic.OrderFeeDefinitionQuery) (r0 *orderfeedefinitions.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: OrderFeeDefinitions.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *OrderFeeDefinitions) Get(ctx context.Context, id int64) (r0 *ticketmatic.OrderFeeDefinition, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: OrderFeeDefinitions.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *OrderFeeDefinitions) Create(ctx context.Context, data *ticketmatic.OrderFeeDefinition) (r0 *ticketmatic.OrderFeeDefinition, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: OrderFeeDefinitions.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *OrderFeeDefinitions) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: OrderFeeDefinitions.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *OrderFeeDefinitions) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: OrderFeeDefinitions.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *OrderFeeDefinitions) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: OrderFeeDefinitions.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.PriceListsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type PriceLists struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.PriceListQuery) (*pricelists.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.PriceList, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.PriceList) (*ticketmatic.PriceList, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.PriceList) (*ticketmatic.PriceList, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.PriceListsService = (*PriceLists)(nil)
+
+func (m *PriceLists) Getlist(ctx context.Context, params *ticketmatic.PriceListQuery) (r0 *pricelists.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: PriceLists.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *PriceLists) Get(ctx context.Context, id int64) (r0 *ticketmatic.PriceList, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: PriceLists.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *PriceLists) Create(ctx context.Context, data *ticketmatic.PriceList) (r0 *ticketmatic.PriceList, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: PriceLists.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *PriceLists) Update(ctx context.Context, id int64, data *ticketmatic.PriceList) (r0 *ticketmatic.PriceList, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: PriceLists.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *PriceLists) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: PriceLists.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.PriceTypesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type PriceTypes struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.PriceTypeQuery) (*pricetypes.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.PriceType, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.PriceType) (*ticketmatic.PriceType, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.PriceType) (*ticketmatic.PriceType, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.PriceTypesService = (*PriceTypes)(nil)
+
+func (m *PriceTypes) Getlist(ctx context.Context, params *ticketmatic.PriceTypeQuery) (r0 *pricetypes.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: PriceTypes.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *PriceTypes) Get(ctx context.Context, id int64) (r0 *ticketmatic.PriceType, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: PriceTypes.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *PriceTypes) Create(ctx context.Context, data *ticketmatic.PriceType) (r0 *ticketmatic.PriceType, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: PriceTypes.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *PriceTypes) Update(ctx context.Context, id int64, data *ticketmatic.PriceType) (r0 *ticketmatic.PriceType, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: PriceTypes.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *PriceTypes) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: PriceTypes.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *PriceTypes) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: PriceTypes.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *PriceTypes) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: PriceTypes.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.TicketFeesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type TicketFees struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.TicketFeeQuery) (*ticketfees.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.TicketFee, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.TicketFee) (*ticketmatic.TicketFee, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.TicketFee) (*ticketmatic.TicketFee, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.TicketFeesService = (*TicketFees)(nil)
+
+func (m *TicketFees) Getlist(ctx context.Context, params *ticketmatic.TicketFeeQuery) (r0 *ticketfees.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: TicketFees.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *TicketFees) Get(ctx context.Context, id int64) (r0 *ticketmatic.TicketFee, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: TicketFees.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *TicketFees) Create(ctx context.Context, data *ticketmatic.TicketFee) (r0 *ticketmatic.TicketFee, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: TicketFees.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *TicketFees) Update(ctx context.Context, id int64, data *ticketmatic.TicketFee) (r0 *ticketmatic.TicketFee, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: TicketFees.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *TicketFees) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: TicketFees.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.ProductCategoriesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type ProductCategories struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.ProductCategoryQuery) (*productcategories.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.ProductCategory, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.ProductCategory) (*ticketmatic.ProductCategory, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.ProductCategory) (*ticketmatic.ProductCategory, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.ProductCategoriesService = (*ProductCategories)(nil)
+
+func (m *ProductCategories) Getlist(ctx context.Context, params *ticketmatic.ProductCategoryQuery) (r0 *productcategories.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: ProductCategories.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *ProductCategories) Get(ctx context.Context, id int64) (r0 *ticketmatic.ProductCategory, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: ProductCategories.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *ProductCategories) Create(ctx context.Context, data *ticketmatic.ProductCategory) (r0 *ticketmatic.ProductCategory, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: ProductCategories.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *ProductCategories) Update(ctx context.Context, id int64, data *ticketmatic.ProductCategory) (r0 *ticketmatic.ProductCategory, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: ProductCategories.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *ProductCategories) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: ProductCategories.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *ProductCategories) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: ProductCategories.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *ProductCategories) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: ProductCategories.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.ProductsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Products struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.ProductQuery) (*products.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.Product, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.Product) (*ticketmatic.Product, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.Product) (*ticketmatic.Product, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.ProductsService = (*Products)(nil)
+
+func (m *Products) Getlist(ctx context.Context, params *ticketmatic.ProductQuery) (r0 *products.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: Products.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *Products) Get(ctx context.Context, id int64) (r0 *ticketmatic.Product, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: Products.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *Products) Create(ctx context.Context, data *ticketmatic.Product) (r0 *ticketmatic.Product, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: Products.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *Products) Update(ctx context.Context, id int64, data *ticketmatic.Product) (r0 *ticketmatic.Product, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: Products.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *Products) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: Products.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *Products) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: Products.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *Products) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: Products.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.SeatingPlansService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type SeatingPlans struct {
+	Recorder
+
+	GetlistFunc                      func(ctx context.Context, params *ticketmatic.SeatingPlanQuery) (*seatingplans.List, error)
+	GetFunc                          func(ctx context.Context, id int64) (*ticketmatic.SeatingPlan, error)
+	CreateFunc                       func(ctx context.Context, data *ticketmatic.SeatingPlan) (*ticketmatic.SeatingPlan, error)
+	UpdateFunc                       func(ctx context.Context, id int64, data *ticketmatic.SeatingPlan) (*ticketmatic.SeatingPlan, error)
+	DeleteFunc                       func(ctx context.Context, id int64) error
+	GetsvgFunc                       func(ctx context.Context, id int64, zoneid string) (*bytes.Buffer, error)
+	SavesvgFunc                      func(ctx context.Context, id int64, zoneid string, data string) (*bytes.Buffer, error)
+	GetlocktemplatesFunc             func(ctx context.Context, id int64) ([]*ticketmatic.LockTemplate, error)
+	SavelocktemplatesFunc            func(ctx context.Context, id int64, data []*ticketmatic.LockTemplate) ([]*ticketmatic.LockTemplate, error)
+	GetseatdescriptiontemplatesFunc  func(ctx context.Context, id int64) ([]*ticketmatic.SeatDescriptionTemplate, error)
+	SaveseatdescriptiontemplatesFunc func(ctx context.Context, id int64, data []*ticketmatic.SeatDescriptionTemplate) ([]*ticketmatic.SeatDescriptionTemplate, error)
+	GetlogicalplanFunc               func(ctx context.Context, id int64, zoneid string) (*ticketmatic.LogicalPlan, error)
+	SavelogicalplanFunc              func(ctx context.Context, id int64, zoneid string, data *ticketmatic.LogicalPlan) (*ticketmatic.LogicalPlan, error)
+	PurgeFunc                        func(ctx context.Context, id int64) error
+}
+
+var _ services.SeatingPlansService = (*SeatingPlans)(nil)
+
+func (m *SeatingPlans) Getlist(ctx context.Context, params *ticketmatic.SeatingPlanQuery) (r0 *seatingplans.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: SeatingPlans.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *SeatingPlans) Get(ctx context.Context, id int64) (r0 *ticketmatic.SeatingPlan, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: SeatingPlans.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *SeatingPlans) Create(ctx context.Context, data *ticketmatic.SeatingPlan) (r0 *ticketmatic.SeatingPlan, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: SeatingPlans.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *SeatingPlans) Update(ctx context.Context, id int64, data *ticketmatic.SeatingPlan) (r0 *ticketmatic.SeatingPlan, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: SeatingPlans.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *SeatingPlans) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: SeatingPlans.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *SeatingPlans) Getsvg(ctx context.Context, id int64, zoneid string) (r0 *bytes.Buffer, err error) {
+	m.record("Getsvg", id, zoneid)
+	if m.GetsvgFunc == nil {
+		err = errors.New("mock: SeatingPlans.Getsvg not set")
+		return
+	}
+	return m.GetsvgFunc(ctx, id, zoneid)
+}
+
+func (m *SeatingPlans) Savesvg(ctx context.Context, id int64, zoneid string, data string) (r0 *bytes.Buffer, err error) {
+	m.record("Savesvg", id, zoneid, data)
+	if m.SavesvgFunc == nil {
+		err = errors.New("mock: SeatingPlans.Savesvg not set")
+		return
+	}
+	return m.SavesvgFunc(ctx, id, zoneid, data)
+}
+
+func (m *SeatingPlans) Getlocktemplates(ctx context.Context, id int64) (r0 []*ticketmatic.LockTemplate, err error) {
+	m.record("Getlocktemplates", id)
+	if m.GetlocktemplatesFunc == nil {
+		err = errors.New("mock: SeatingPlans.Getlocktemplates not set")
+		return
+	}
+	return m.GetlocktemplatesFunc(ctx, id)
+}
+
+func (m *SeatingPlans) Savelocktemplates(ctx context.Context, id int64, data []*ticketmatic.LockTemplate) (r0 []*ticketmatic.LockTemplate, err error) {
+	m.record("Savelocktemplates", id, data)
+	if m.SavelocktemplatesFunc == nil {
+		err = errors.New("mock: SeatingPlans.Savelocktemplates not set")
+		return
+	}
+	return m.SavelocktemplatesFunc(ctx, id, data)
+}
+
+func (m *SeatingPlans) Getseatdescriptiontemplates(ctx context.Context, id int64) (r0 []*ticketmatic.SeatDescriptionTemplate, err error) {
+	m.record("Getseatdescriptiontemplates", id)
+	if m.GetseatdescriptiontemplatesFunc == nil {
+		err = errors.New("mock: SeatingPlans.Getseatdescriptiontemplates not set")
+		return
+	}
+	return m.GetseatdescriptiontemplatesFunc(ctx, id)
+}
+
+func (m *SeatingPlans) Saveseatdescriptiontemplates(ctx context.Context, id int64, data []*ticketmatic.SeatDescriptionTemplate) (r0 []*ticketmatic.SeatDescriptionTemplate, err error) {
+	m.record("Saveseatdescriptiontemplates", id, data)
+	if m.SaveseatdescriptiontemplatesFunc == nil {
+		err = errors.New("mock: SeatingPlans.Saveseatdescriptiontemplates not set")
+		return
+	}
+	return m.SaveseatdescriptiontemplatesFunc(ctx, id, data)
+}
+
+func (m *SeatingPlans) Getlogicalplan(ctx context.Context, id int64, zoneid string) (r0 *ticketmatic.LogicalPlan, err error) {
+	m.record("Getlogicalplan", id, zoneid)
+	if m.GetlogicalplanFunc == nil {
+		err = errors.New("mock: SeatingPlans.Getlogicalplan not set")
+		return
+	}
+	return m.GetlogicalplanFunc(ctx, id, zoneid)
+}
+
+func (m *SeatingPlans) Savelogicalplan(ctx context.Context, id int64, zoneid string, data *ticketmatic.LogicalPlan) (r0 *ticketmatic.LogicalPlan, err error) {
+	m.record("Savelogicalplan", id, zoneid, data)
+	if m.SavelogicalplanFunc == nil {
+		err = errors.New("mock: SeatingPlans.Savelogicalplan not set")
+		return
+	}
+	return m.SavelogicalplanFunc(ctx, id, zoneid, data)
+}
+
+func (m *SeatingPlans) Purge(ctx context.Context, id int64) (err error) {
+	m.record("Purge", id)
+	if m.PurgeFunc == nil {
+		err = errors.New("mock: SeatingPlans.Purge not set")
+		return
+	}
+	return m.PurgeFunc(ctx, id)
+}
+
+// Mock of services.SeatRanksService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type SeatRanks struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.SeatRankQuery) (*seatranks.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.SeatRank, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.SeatRank) (*ticketmatic.SeatRank, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.SeatRank) (*ticketmatic.SeatRank, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.SeatRanksService = (*SeatRanks)(nil)
+
+func (m *SeatRanks) Getlist(ctx context.Context, params *ticketmatic.SeatRankQuery) (r0 *seatranks.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: SeatRanks.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *SeatRanks) Get(ctx context.Context, id int64) (r0 *ticketmatic.SeatRank, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: SeatRanks.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *SeatRanks) Create(ctx context.Context, data *ticketmatic.SeatRank) (r0 *ticketmatic.SeatRank, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: SeatRanks.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *SeatRanks) Update(ctx context.Context, id int64, data *ticketmatic.SeatRank) (r0 *ticketmatic.SeatRank, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: SeatRanks.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *SeatRanks) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: SeatRanks.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *SeatRanks) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: SeatRanks.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *SeatRanks) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: SeatRanks.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.ContactAddressTypesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type ContactAddressTypes struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.ContactAddressTypeQuery) (*contactaddresstypes.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.ContactAddressType, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.ContactAddressType) (*ticketmatic.ContactAddressType, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.ContactAddressType) (*ticketmatic.ContactAddressType, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.ContactAddressTypesService = (*ContactAddressTypes)(nil)
+
+func (m *ContactAddressTypes) Getlist(ctx context.Context, params *ticketmatic.ContactAddressTypeQuery) (r0 *contactaddresstypes.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: ContactAddressTypes.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *ContactAddressTypes) Get(ctx context.Context, id int64) (r0 *ticketmatic.ContactAddressType, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: ContactAddressTypes.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *ContactAddressTypes) Create(ctx context.Context, data *ticketmatic.ContactAddressType) (r0 *ticketmatic.ContactAddressType, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: ContactAddressTypes.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *ContactAddressTypes) Update(ctx context.Context, id int64, data *ticketmatic.ContactAddressType) (r0 *ticketmatic.ContactAddressType, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: ContactAddressTypes.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *ContactAddressTypes) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: ContactAddressTypes.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *ContactAddressTypes) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: ContactAddressTypes.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *ContactAddressTypes) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: ContactAddressTypes.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.ContactFieldsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type ContactFields struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context) (*contactfields.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.ContactField, error)
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.ContactFieldsService = (*ContactFields)(nil)
+
+func (m *ContactFields) Getlist(ctx context.Context) (r0 *contactfields.List, err error) {
+	m.record("Getlist")
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: ContactFields.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx)
+}
+
+func (m *ContactFields) Get(ctx context.Context, id int64) (r0 *ticketmatic.ContactField, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: ContactFields.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *ContactFields) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: ContactFields.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *ContactFields) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: ContactFields.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.ContactTitlesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type ContactTitles struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.ContactTitleQuery) (*contacttitles.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.ContactTitle, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.ContactTitle) (*ticketmatic.ContactTitle, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.ContactTitle) (*ticketmatic.ContactTitle, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.ContactTitlesService = (*ContactTitles)(nil)
+
+func (m *ContactTitles) Getlist(ctx context.Context, params *ticketmatic.ContactTitleQuery) (r0 *contacttitles.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: ContactTitles.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *ContactTitles) Get(ctx context.Context, id int64) (r0 *ticketmatic.ContactTitle, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: ContactTitles.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *ContactTitles) Create(ctx context.Context, data *ticketmatic.ContactTitle) (r0 *ticketmatic.ContactTitle, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: ContactTitles.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *ContactTitles) Update(ctx context.Context, id int64, data *ticketmatic.ContactTitle) (r0 *ticketmatic.ContactTitle, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: ContactTitles.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *ContactTitles) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: ContactTitles.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.CustomFieldsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type CustomFields struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.CustomFieldQuery) (*customfields.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.CustomField, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.CustomField) (*ticketmatic.CustomField, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.CustomField) (*ticketmatic.CustomField, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.CustomFieldsService = (*CustomFields)(nil)
+
+func (m *CustomFields) Getlist(ctx context.Context, params *ticketmatic.CustomFieldQuery) (r0 *customfields.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: CustomFields.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *CustomFields) Get(ctx context.Context, id int64) (r0 *ticketmatic.CustomField, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: CustomFields.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *CustomFields) Create(ctx context.Context, data *ticketmatic.CustomField) (r0 *ticketmatic.CustomField, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: CustomFields.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *CustomFields) Update(ctx context.Context, id int64, data *ticketmatic.CustomField) (r0 *ticketmatic.CustomField, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: CustomFields.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *CustomFields) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: CustomFields.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *CustomFields) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: CustomFields.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *CustomFields) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: CustomFields.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.CustomFieldValuesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type CustomFieldValues struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.CustomFieldValueQuery) (*customfieldvalues.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.CustomFieldValue, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.CustomFieldValue) (*ticketmatic.CustomFieldValue, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.CustomFieldValue) (*ticketmatic.CustomFieldValue, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.CustomFieldValuesService = (*CustomFieldValues)(nil)
+
+func (m *CustomFieldValues) Getlist(ctx context.Context, params *ticketmatic.CustomFieldValueQuery) (r0 *customfieldvalues.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: CustomFieldValues.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *CustomFieldValues) Get(ctx context.Context, id int64) (r0 *ticketmatic.CustomFieldValue, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: CustomFieldValues.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *CustomFieldValues) Create(ctx context.Context, data *ticketmatic.CustomFieldValue) (r0 *ticketmatic.CustomFieldValue, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: CustomFieldValues.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *CustomFieldValues) Update(ctx context.Context, id int64, data *ticketmatic.CustomFieldValue) (r0 *ticketmatic.CustomFieldValue, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: CustomFieldValues.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *CustomFieldValues) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: CustomFieldValues.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *CustomFieldValues) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: CustomFieldValues.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *CustomFieldValues) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: CustomFieldValues.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.DupeDetectRulesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type DupeDetectRules struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.DupeDetectRuleQuery) (*dupedetectrules.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.DupeDetectRule, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.DupeDetectRule) (*ticketmatic.DupeDetectRule, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.DupeDetectRule) (*ticketmatic.DupeDetectRule, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.DupeDetectRulesService = (*DupeDetectRules)(nil)
+
+func (m *DupeDetectRules) Getlist(ctx context.Context, params *ticketmatic.DupeDetectRuleQuery) (r0 *dupedetectrules.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: DupeDetectRules.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *DupeDetectRules) Get(ctx context.Context, id int64) (r0 *ticketmatic.DupeDetectRule, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: DupeDetectRules.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *DupeDetectRules) Create(ctx context.Context, data *ticketmatic.DupeDetectRule) (r0 *ticketmatic.DupeDetectRule, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: DupeDetectRules.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *DupeDetectRules) Update(ctx context.Context, id int64, data *ticketmatic.DupeDetectRule) (r0 *ticketmatic.DupeDetectRule, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: DupeDetectRules.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *DupeDetectRules) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: DupeDetectRules.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.FieldDefinitionsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type FieldDefinitions struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.FieldDefinitionQuery) (*fielddefinitions.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.FieldDefinition, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.FieldDefinition) (*ticketmatic.FieldDefinition, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.FieldDefinition) (*ticketmatic.FieldDefinition, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+	GetdataFunc      func(ctx context.Context, data *ticketmatic.FielddefinitionsDataRequest) ([]*ticketmatic.FielddefinitionsDataResult, error)
+}
+
+var _ services.FieldDefinitionsService = (*FieldDefinitions)(nil)
+
+func (m *FieldDefinitions) Getlist(ctx context.Context, params *ticketmatic.FieldDefinitionQuery) (r0 *fielddefinitions.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: FieldDefinitions.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *FieldDefinitions) Get(ctx context.Context, id int64) (r0 *ticketmatic.FieldDefinition, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: FieldDefinitions.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *FieldDefinitions) Create(ctx context.Context, data *ticketmatic.FieldDefinition) (r0 *ticketmatic.FieldDefinition, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: FieldDefinitions.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *FieldDefinitions) Update(ctx context.Context, id int64, data *ticketmatic.FieldDefinition) (r0 *ticketmatic.FieldDefinition, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: FieldDefinitions.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *FieldDefinitions) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: FieldDefinitions.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *FieldDefinitions) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: FieldDefinitions.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *FieldDefinitions) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: FieldDefinitions.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+func (m *FieldDefinitions) Getdata(ctx context.Context, data *ticketmatic.FielddefinitionsDataRequest) (r0 []*ticketmatic.FielddefinitionsDataResult, err error) {
+	m.record("Getdata", data)
+	if m.GetdataFunc == nil {
+		err = errors.New("mock: FieldDefinitions.Getdata not set")
+		return
+	}
+	return m.GetdataFunc(ctx, data)
+}
+
+// Mock of services.FilterDefinitionsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type FilterDefinitions struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.FilterDefinitionQuery) (*filterdefinitions.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.FilterDefinition, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.FilterDefinition) (*ticketmatic.FilterDefinition, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.FilterDefinition) (*ticketmatic.FilterDefinition, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.FilterDefinitionsService = (*FilterDefinitions)(nil)
+
+func (m *FilterDefinitions) Getlist(ctx context.Context, params *ticketmatic.FilterDefinitionQuery) (r0 *filterdefinitions.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: FilterDefinitions.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *FilterDefinitions) Get(ctx context.Context, id int64) (r0 *ticketmatic.FilterDefinition, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: FilterDefinitions.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *FilterDefinitions) Create(ctx context.Context, data *ticketmatic.FilterDefinition) (r0 *ticketmatic.FilterDefinition, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: FilterDefinitions.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *FilterDefinitions) Update(ctx context.Context, id int64, data *ticketmatic.FilterDefinition) (r0 *ticketmatic.FilterDefinition, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: FilterDefinitions.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *FilterDefinitions) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: FilterDefinitions.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *FilterDefinitions) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: FilterDefinitions.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *FilterDefinitions) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: FilterDefinitions.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.OptInsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type OptIns struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.OptInQuery) (*optins.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.OptIn, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.OptIn) (*ticketmatic.OptIn, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.OptIn) (*ticketmatic.OptIn, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.OptInsService = (*OptIns)(nil)
+
+func (m *OptIns) Getlist(ctx context.Context, params *ticketmatic.OptInQuery) (r0 *optins.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: OptIns.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *OptIns) Get(ctx context.Context, id int64) (r0 *ticketmatic.OptIn, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: OptIns.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *OptIns) Create(ctx context.Context, data *ticketmatic.OptIn) (r0 *ticketmatic.OptIn, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: OptIns.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *OptIns) Update(ctx context.Context, id int64, data *ticketmatic.OptIn) (r0 *ticketmatic.OptIn, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: OptIns.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *OptIns) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: OptIns.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *OptIns) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: OptIns.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *OptIns) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: OptIns.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.PhoneNumberTypesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type PhoneNumberTypes struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.PhoneNumberTypeQuery) (*phonenumbertypes.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.PhoneNumberType, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.PhoneNumberType) (*ticketmatic.PhoneNumberType, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.PhoneNumberType) (*ticketmatic.PhoneNumberType, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.PhoneNumberTypesService = (*PhoneNumberTypes)(nil)
+
+func (m *PhoneNumberTypes) Getlist(ctx context.Context, params *ticketmatic.PhoneNumberTypeQuery) (r0 *phonenumbertypes.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: PhoneNumberTypes.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *PhoneNumberTypes) Get(ctx context.Context, id int64) (r0 *ticketmatic.PhoneNumberType, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: PhoneNumberTypes.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *PhoneNumberTypes) Create(ctx context.Context, data *ticketmatic.PhoneNumberType) (r0 *ticketmatic.PhoneNumberType, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: PhoneNumberTypes.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *PhoneNumberTypes) Update(ctx context.Context, id int64, data *ticketmatic.PhoneNumberType) (r0 *ticketmatic.PhoneNumberType, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: PhoneNumberTypes.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *PhoneNumberTypes) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: PhoneNumberTypes.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *PhoneNumberTypes) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: PhoneNumberTypes.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *PhoneNumberTypes) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: PhoneNumberTypes.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.RelationTypesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type RelationTypes struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.RelationTypeQuery) (*relationtypes.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.RelationType, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.RelationType) (*ticketmatic.RelationType, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.RelationType) (*ticketmatic.RelationType, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.RelationTypesService = (*RelationTypes)(nil)
+
+func (m *RelationTypes) Getlist(ctx context.Context, params *ticketmatic.RelationTypeQuery) (r0 *relationtypes.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: RelationTypes.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *RelationTypes) Get(ctx context.Context, id int64) (r0 *ticketmatic.RelationType, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: RelationTypes.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *RelationTypes) Create(ctx context.Context, data *ticketmatic.RelationType) (r0 *ticketmatic.RelationType, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: RelationTypes.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *RelationTypes) Update(ctx context.Context, id int64, data *ticketmatic.RelationType) (r0 *ticketmatic.RelationType, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: RelationTypes.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *RelationTypes) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: RelationTypes.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *RelationTypes) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: RelationTypes.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *RelationTypes) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: RelationTypes.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.ReportsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Reports struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.ReportQuery) (*reports.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.Report, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.Report) (*ticketmatic.Report, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.Report) (*ticketmatic.Report, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.ReportsService = (*Reports)(nil)
+
+func (m *Reports) Getlist(ctx context.Context, params *ticketmatic.ReportQuery) (r0 *reports.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: Reports.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *Reports) Get(ctx context.Context, id int64) (r0 *ticketmatic.Report, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: Reports.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *Reports) Create(ctx context.Context, data *ticketmatic.Report) (r0 *ticketmatic.Report, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: Reports.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *Reports) Update(ctx context.Context, id int64, data *ticketmatic.Report) (r0 *ticketmatic.Report, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: Reports.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *Reports) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: Reports.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *Reports) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: Reports.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *Reports) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: Reports.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.TicketSalesFlowsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type TicketSalesFlows struct {
+	Recorder
+
+	GetlistFunc     func(ctx context.Context, params *ticketmatic.TicketsalesflowQuery) (*ticketsalesflows.List, error)
+	GetFunc         func(ctx context.Context, id int64) (*ticketmatic.Ticketsalesflow, error)
+	CreateFunc      func(ctx context.Context, data *ticketmatic.Ticketsalesflow) (*ticketmatic.Ticketsalesflow, error)
+	UpdateFunc      func(ctx context.Context, id int64, data *ticketmatic.Ticketsalesflow) (*ticketmatic.Ticketsalesflow, error)
+	DeleteFunc      func(ctx context.Context, id int64) error
+	FlowsessionFunc func(ctx context.Context, data *ticketmatic.Flowsession) (string, error)
+	FlowinfoFunc    func(ctx context.Context, token string) (*ticketmatic.Flowinfo, error)
+}
+
+var _ services.TicketSalesFlowsService = (*TicketSalesFlows)(nil)
+
+func (m *TicketSalesFlows) Getlist(ctx context.Context, params *ticketmatic.TicketsalesflowQuery) (r0 *ticketsalesflows.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: TicketSalesFlows.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *TicketSalesFlows) Get(ctx context.Context, id int64) (r0 *ticketmatic.Ticketsalesflow, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: TicketSalesFlows.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *TicketSalesFlows) Create(ctx context.Context, data *ticketmatic.Ticketsalesflow) (r0 *ticketmatic.Ticketsalesflow, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: TicketSalesFlows.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *TicketSalesFlows) Update(ctx context.Context, id int64, data *ticketmatic.Ticketsalesflow) (r0 *ticketmatic.Ticketsalesflow, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: TicketSalesFlows.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *TicketSalesFlows) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: TicketSalesFlows.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *TicketSalesFlows) Flowsession(ctx context.Context, data *ticketmatic.Flowsession) (r0 string, err error) {
+	m.record("Flowsession", data)
+	if m.FlowsessionFunc == nil {
+		err = errors.New("mock: TicketSalesFlows.Flowsession not set")
+		return
+	}
+	return m.FlowsessionFunc(ctx, data)
+}
+
+func (m *TicketSalesFlows) Flowinfo(ctx context.Context, token string) (r0 *ticketmatic.Flowinfo, err error) {
+	m.record("Flowinfo", token)
+	if m.FlowinfoFunc == nil {
+		err = errors.New("mock: TicketSalesFlows.Flowinfo not set")
+		return
+	}
+	return m.FlowinfoFunc(ctx, token)
+}
+
+// Mock of services.TicketSalesSetupsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type TicketSalesSetups struct {
+	Recorder
+
+	GetlistFunc func(ctx context.Context, params *ticketmatic.TicketsalessetupQuery) (*ticketsalessetups.List, error)
+	GetFunc     func(ctx context.Context, id int64) (*ticketmatic.Ticketsalessetup, error)
+	CreateFunc  func(ctx context.Context, data *ticketmatic.Ticketsalessetup) (*ticketmatic.Ticketsalessetup, error)
+	UpdateFunc  func(ctx context.Context, id int64, data *ticketmatic.Ticketsalessetup) (*ticketmatic.Ticketsalessetup, error)
+	DeleteFunc  func(ctx context.Context, id int64) error
+}
+
+var _ services.TicketSalesSetupsService = (*TicketSalesSetups)(nil)
+
+func (m *TicketSalesSetups) Getlist(ctx context.Context, params *ticketmatic.TicketsalessetupQuery) (r0 *ticketsalessetups.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: TicketSalesSetups.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *TicketSalesSetups) Get(ctx context.Context, id int64) (r0 *ticketmatic.Ticketsalessetup, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: TicketSalesSetups.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *TicketSalesSetups) Create(ctx context.Context, data *ticketmatic.Ticketsalessetup) (r0 *ticketmatic.Ticketsalessetup, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: TicketSalesSetups.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *TicketSalesSetups) Update(ctx context.Context, id int64, data *ticketmatic.Ticketsalessetup) (r0 *ticketmatic.Ticketsalessetup, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: TicketSalesSetups.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *TicketSalesSetups) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: TicketSalesSetups.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// Mock of services.ViewsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Views struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.ViewQuery) (*views.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.View, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.View) (*ticketmatic.View, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.View) (*ticketmatic.View, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.ViewsService = (*Views)(nil)
+
+func (m *Views) Getlist(ctx context.Context, params *ticketmatic.ViewQuery) (r0 *views.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: Views.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *Views) Get(ctx context.Context, id int64) (r0 *ticketmatic.View, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: Views.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *Views) Create(ctx context.Context, data *ticketmatic.View) (r0 *ticketmatic.View, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: Views.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *Views) Update(ctx context.Context, id int64, data *ticketmatic.View) (r0 *ticketmatic.View, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: Views.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *Views) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: Views.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *Views) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: Views.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *Views) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: Views.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.DeliveryScenariosService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type DeliveryScenarios struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.DeliveryScenarioQuery) (*deliveryscenarios.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.DeliveryScenario, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.DeliveryScenario) (*ticketmatic.DeliveryScenario, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.DeliveryScenario) (*ticketmatic.DeliveryScenario, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.DeliveryScenariosService = (*DeliveryScenarios)(nil)
+
+func (m *DeliveryScenarios) Getlist(ctx context.Context, params *ticketmatic.DeliveryScenarioQuery) (r0 *deliveryscenarios.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: DeliveryScenarios.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *DeliveryScenarios) Get(ctx context.Context, id int64) (r0 *ticketmatic.DeliveryScenario, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: DeliveryScenarios.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *DeliveryScenarios) Create(ctx context.Context, data *ticketmatic.DeliveryScenario) (r0 *ticketmatic.DeliveryScenario, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: DeliveryScenarios.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *DeliveryScenarios) Update(ctx context.Context, id int64, data *ticketmatic.DeliveryScenario) (r0 *ticketmatic.DeliveryScenario, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: DeliveryScenarios.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *DeliveryScenarios) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: DeliveryScenarios.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *DeliveryScenarios) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: DeliveryScenarios.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *DeliveryScenarios) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: DeliveryScenarios.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.LockTypesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type LockTypes struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.LockTypeQuery) (*locktypes.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.LockType, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.LockType) (*ticketmatic.LockType, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.LockType) (*ticketmatic.LockType, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.LockTypesService = (*LockTypes)(nil)
+
+func (m *LockTypes) Getlist(ctx context.Context, params *ticketmatic.LockTypeQuery) (r0 *locktypes.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: LockTypes.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *LockTypes) Get(ctx context.Context, id int64) (r0 *ticketmatic.LockType, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: LockTypes.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *LockTypes) Create(ctx context.Context, data *ticketmatic.LockType) (r0 *ticketmatic.LockType, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: LockTypes.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *LockTypes) Update(ctx context.Context, id int64, data *ticketmatic.LockType) (r0 *ticketmatic.LockType, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: LockTypes.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *LockTypes) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: LockTypes.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *LockTypes) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: LockTypes.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *LockTypes) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: LockTypes.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.OrderFeesService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type OrderFees struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.OrderFeeQuery) (*orderfees.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.OrderFee, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.OrderFee) (*ticketmatic.OrderFee, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.OrderFeesService = (*OrderFees)(nil)
+
+func (m *OrderFees) Getlist(ctx context.Context, params *ticketmatic.OrderFeeQuery) (r0 *orderfees.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: OrderFees.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *OrderFees) Get(ctx context.Context, id int64) (r0 *ticketmatic.OrderFee, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: OrderFees.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *OrderFees) Create(ctx context.Context, data *ticketmatic.OrderFee) (r0 *ticketmatic.OrderFee, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: OrderFees.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *OrderFees) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: OrderFees.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *OrderFees) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: OrderFees.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *OrderFees) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: OrderFees.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.PaymentMethodsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type PaymentMethods struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.PaymentMethodQuery) (*paymentmethods.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.PaymentMethod, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.PaymentMethod) (*ticketmatic.PaymentMethod, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.PaymentMethod) (*ticketmatic.PaymentMethod, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.PaymentMethodsService = (*PaymentMethods)(nil)
+
+func (m *PaymentMethods) Getlist(ctx context.Context, params *ticketmatic.PaymentMethodQuery) (r0 *paymentmethods.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: PaymentMethods.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *PaymentMethods) Get(ctx context.Context, id int64) (r0 *ticketmatic.PaymentMethod, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: PaymentMethods.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *PaymentMethods) Create(ctx context.Context, data *ticketmatic.PaymentMethod) (r0 *ticketmatic.PaymentMethod, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: PaymentMethods.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *PaymentMethods) Update(ctx context.Context, id int64, data *ticketmatic.PaymentMethod) (r0 *ticketmatic.PaymentMethod, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: PaymentMethods.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *PaymentMethods) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: PaymentMethods.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *PaymentMethods) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: PaymentMethods.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *PaymentMethods) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: PaymentMethods.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.PaymentScenariosService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type PaymentScenarios struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.PaymentScenarioQuery) (*paymentscenarios.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.PaymentScenario, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.PaymentScenario) (*ticketmatic.PaymentScenario, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.PaymentScenario) (*ticketmatic.PaymentScenario, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.PaymentScenariosService = (*PaymentScenarios)(nil)
+
+func (m *PaymentScenarios) Getlist(ctx context.Context, params *ticketmatic.PaymentScenarioQuery) (r0 *paymentscenarios.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: PaymentScenarios.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *PaymentScenarios) Get(ctx context.Context, id int64) (r0 *ticketmatic.PaymentScenario, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: PaymentScenarios.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *PaymentScenarios) Create(ctx context.Context, data *ticketmatic.PaymentScenario) (r0 *ticketmatic.PaymentScenario, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: PaymentScenarios.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *PaymentScenarios) Update(ctx context.Context, id int64, data *ticketmatic.PaymentScenario) (r0 *ticketmatic.PaymentScenario, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: PaymentScenarios.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *PaymentScenarios) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: PaymentScenarios.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *PaymentScenarios) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: PaymentScenarios.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *PaymentScenarios) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: PaymentScenarios.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.SalesChannelsService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type SalesChannels struct {
+	Recorder
+
+	GetlistFunc      func(ctx context.Context, params *ticketmatic.SalesChannelQuery) (*saleschannels.List, error)
+	GetFunc          func(ctx context.Context, id int64) (*ticketmatic.SalesChannel, error)
+	CreateFunc       func(ctx context.Context, data *ticketmatic.SalesChannel) (*ticketmatic.SalesChannel, error)
+	UpdateFunc       func(ctx context.Context, id int64, data *ticketmatic.SalesChannel) (*ticketmatic.SalesChannel, error)
+	DeleteFunc       func(ctx context.Context, id int64) error
+	TranslationsFunc func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc    func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+var _ services.SalesChannelsService = (*SalesChannels)(nil)
+
+func (m *SalesChannels) Getlist(ctx context.Context, params *ticketmatic.SalesChannelQuery) (r0 *saleschannels.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: SalesChannels.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *SalesChannels) Get(ctx context.Context, id int64) (r0 *ticketmatic.SalesChannel, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: SalesChannels.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *SalesChannels) Create(ctx context.Context, data *ticketmatic.SalesChannel) (r0 *ticketmatic.SalesChannel, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: SalesChannels.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *SalesChannels) Update(ctx context.Context, id int64, data *ticketmatic.SalesChannel) (r0 *ticketmatic.SalesChannel, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: SalesChannels.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *SalesChannels) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: SalesChannels.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *SalesChannels) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: SalesChannels.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *SalesChannels) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: SalesChannels.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+// Mock of services.VouchersService. Operations call the function set in the
+// matching field, or fail when it isn't set.
+type Vouchers struct {
+	Recorder
+
+	GetlistFunc         func(ctx context.Context, params *ticketmatic.VoucherQuery) (*vouchers.List, error)
+	GetFunc             func(ctx context.Context, id int64) (*ticketmatic.Voucher, error)
+	CreateFunc          func(ctx context.Context, data *ticketmatic.Voucher) (*ticketmatic.Voucher, error)
+	UpdateFunc          func(ctx context.Context, id int64, data *ticketmatic.Voucher) (*ticketmatic.Voucher, error)
+	DeleteFunc          func(ctx context.Context, id int64) error
+	TranslationsFunc    func(ctx context.Context, id int64) (map[string]string, error)
+	TranslateFunc       func(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+	CreatecodesFunc     func(ctx context.Context, id int64, data *ticketmatic.AddVoucherCodes) ([]*ticketmatic.VoucherCode, error)
+	DeactivatecodesFunc func(ctx context.Context, id int64, data []*ticketmatic.VoucherCode) error
+}
+
+var _ services.VouchersService = (*Vouchers)(nil)
+
+func (m *Vouchers) Getlist(ctx context.Context, params *ticketmatic.VoucherQuery) (r0 *vouchers.List, err error) {
+	m.record("Getlist", params)
+	if m.GetlistFunc == nil {
+		err = errors.New("mock: Vouchers.Getlist not set")
+		return
+	}
+	return m.GetlistFunc(ctx, params)
+}
+
+func (m *Vouchers) Get(ctx context.Context, id int64) (r0 *ticketmatic.Voucher, err error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		err = errors.New("mock: Vouchers.Get not set")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *Vouchers) Create(ctx context.Context, data *ticketmatic.Voucher) (r0 *ticketmatic.Voucher, err error) {
+	m.record("Create", data)
+	if m.CreateFunc == nil {
+		err = errors.New("mock: Vouchers.Create not set")
+		return
+	}
+	return m.CreateFunc(ctx, data)
+}
+
+func (m *Vouchers) Update(ctx context.Context, id int64, data *ticketmatic.Voucher) (r0 *ticketmatic.Voucher, err error) {
+	m.record("Update", id, data)
+	if m.UpdateFunc == nil {
+		err = errors.New("mock: Vouchers.Update not set")
+		return
+	}
+	return m.UpdateFunc(ctx, id, data)
+}
+
+func (m *Vouchers) Delete(ctx context.Context, id int64) (err error) {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		err = errors.New("mock: Vouchers.Delete not set")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *Vouchers) Translations(ctx context.Context, id int64) (r0 map[string]string, err error) {
+	m.record("Translations", id)
+	if m.TranslationsFunc == nil {
+		err = errors.New("mock: Vouchers.Translations not set")
+		return
+	}
+	return m.TranslationsFunc(ctx, id)
+}
+
+func (m *Vouchers) Translate(ctx context.Context, id int64, data map[string]string) (r0 map[string]string, err error) {
+	m.record("Translate", id, data)
+	if m.TranslateFunc == nil {
+		err = errors.New("mock: Vouchers.Translate not set")
+		return
+	}
+	return m.TranslateFunc(ctx, id, data)
+}
+
+func (m *Vouchers) Createcodes(ctx context.Context, id int64, data *ticketmatic.AddVoucherCodes) (r0 []*ticketmatic.VoucherCode, err error) {
+	m.record("Createcodes", id, data)
+	if m.CreatecodesFunc == nil {
+		err = errors.New("mock: Vouchers.Createcodes not set")
+		return
+	}
+	return m.CreatecodesFunc(ctx, id, data)
+}
+
+func (m *Vouchers) Deactivatecodes(ctx context.Context, id int64, data []*ticketmatic.VoucherCode) (err error) {
+	m.record("Deactivatecodes", id, data)
+	if m.DeactivatecodesFunc == nil {
+		err = errors.New("mock: Vouchers.Deactivatecodes not set")
+		return
+	}
+	return m.DeactivatecodesFunc(ctx, id, data)
+}
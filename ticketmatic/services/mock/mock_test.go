@@ -0,0 +1,50 @@
+package mock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/services"
+)
+
+// Code under test, using the services
+func orderCode(api services.API, id int64) (string, error) {
+	order, err := api.Orders().Get(context.Background(), id)
+	if err != nil {
+		return "", err
+	}
+	return order.Code, nil
+}
+
+func TestMock(t *testing.T) {
+	m := New()
+	m.OrdersMock.GetFunc = func(ctx context.Context, id int64) (*ticketmatic.Order, error) {
+		return &ticketmatic.Order{Orderid: id, Code: "ABC"}, nil
+	}
+
+	code, err := orderCode(m, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != "ABC" {
+		t.Errorf("Unexpected code, got %#v, expected %#v", code, "ABC")
+	}
+
+	calls := m.OrdersMock.CallsTo("Get")
+	if len(calls) != 1 || calls[0].Args[0] != int64(12) {
+		t.Errorf("Unexpected calls, got %#v", calls)
+	}
+
+	_, err = m.Events().Get(context.Background(), 1)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+	if len(m.EventsMock.Calls()) != 1 {
+		t.Errorf("Unexpected calls, got %#v", m.EventsMock.Calls())
+	}
+	m.EventsMock.Reset()
+	if len(m.EventsMock.Calls()) != 0 {
+		t.Errorf("Unexpected calls after reset, got %#v", m.EventsMock.Calls())
+	}
+}
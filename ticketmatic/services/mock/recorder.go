@@ -0,0 +1,50 @@
+package mock
+
+import (
+	"sync"
+)
+
+// A call to a mock
+type Call struct {
+	Method string
+
+	// Arguments, without the context
+	Args []interface{}
+}
+
+// Records the calls to a mock
+type Recorder struct {
+	mutex sync.Mutex
+	calls []*Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.calls = append(r.calls, &Call{Method: method, Args: args})
+}
+
+// Calls so far, in order
+func (r *Recorder) Calls() []*Call {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]*Call(nil), r.calls...)
+}
+
+// Calls of a method so far
+func (r *Recorder) CallsTo(method string) []*Call {
+	var result []*Call
+	for _, c := range r.Calls() {
+		if c.Method == method {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Forget the calls so far
+func (r *Recorder) Reset() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.calls = nil
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Implements the services with the API client
+type Client struct {
+	client *ticketmatic.Client
+}
+
+// Create the services for a client
+func New(client *ticketmatic.Client) *Client {
+	return &Client{client: client}
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,2811 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package services
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/contacts"
+	"github.com/ticketmatic/tm-go/ticketmatic/diagnostics"
+	"github.com/ticketmatic/tm-go/ticketmatic/events"
+	"github.com/ticketmatic/tm-go/ticketmatic/eventstream"
+	"github.com/ticketmatic/tm-go/ticketmatic/jobs"
+	"github.com/ticketmatic/tm-go/ticketmatic/orders"
+	"github.com/ticketmatic/tm-go/ticketmatic/sales/waitinglistrequests"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/accountparameters"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/documents"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/ordermails"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/ticketlayouts"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/ticketlayouttemplates"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/webskins"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/events/eventlocations"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/orderfeedefinitions"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/pricelists"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/pricetypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/ticketfees"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/productcategories"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/products"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/seatingplans/seatingplans"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/seatingplans/seatranks"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/contactaddresstypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/contactfields"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/contacttitles"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/customfields"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/customfieldvalues"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/dupedetectrules"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/fielddefinitions"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/filterdefinitions"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/optins"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/phonenumbertypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/relationtypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/reports"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/ticketsalesflows"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/ticketsalessetups"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/views"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/deliveryscenarios"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/locktypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/orderfees"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/paymentmethods"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/paymentscenarios"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/saleschannels"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/vouchers"
+	"github.com/ticketmatic/tm-go/ticketmatic/subscribers"
+	"github.com/ticketmatic/tm-go/ticketmatic/tools"
+)
+
+// All services
+type API interface {
+	Orders() OrdersService
+	Events() EventsService
+	Contacts() ContactsService
+	Jobs() JobsService
+	Subscribers() SubscribersService
+	Eventstream() EventstreamService
+	Tools() ToolsService
+	Diagnostics() DiagnosticsService
+	WaitingListRequests() WaitingListRequestsService
+	AccountParameters() AccountParametersService
+	Documents() DocumentsService
+	OrderMails() OrderMailsService
+	TicketLayouts() TicketLayoutsService
+	TicketLayoutTemplates() TicketLayoutTemplatesService
+	WebSkins() WebSkinsService
+	EventLocations() EventLocationsService
+	OrderFeeDefinitions() OrderFeeDefinitionsService
+	PriceLists() PriceListsService
+	PriceTypes() PriceTypesService
+	TicketFees() TicketFeesService
+	ProductCategories() ProductCategoriesService
+	Products() ProductsService
+	SeatingPlans() SeatingPlansService
+	SeatRanks() SeatRanksService
+	ContactAddressTypes() ContactAddressTypesService
+	ContactFields() ContactFieldsService
+	ContactTitles() ContactTitlesService
+	CustomFields() CustomFieldsService
+	CustomFieldValues() CustomFieldValuesService
+	DupeDetectRules() DupeDetectRulesService
+	FieldDefinitions() FieldDefinitionsService
+	FilterDefinitions() FilterDefinitionsService
+	OptIns() OptInsService
+	PhoneNumberTypes() PhoneNumberTypesService
+	RelationTypes() RelationTypesService
+	Reports() ReportsService
+	TicketSalesFlows() TicketSalesFlowsService
+	TicketSalesSetups() TicketSalesSetupsService
+	Views() ViewsService
+	DeliveryScenarios() DeliveryScenariosService
+	LockTypes() LockTypesService
+	OrderFees() OrderFeesService
+	PaymentMethods() PaymentMethodsService
+	PaymentScenarios() PaymentScenariosService
+	SalesChannels() SalesChannelsService
+	Vouchers() VouchersService
+}
+
+// Operations of the orders package
+type OrdersService interface {
+	// Get a list of orders
+	Getlist(ctx context.Context, params *ticketmatic.OrderQuery) (*orders.List, error)
+
+	// Get a single order
+	Get(ctx context.Context, id int64) (*ticketmatic.Order, error)
+
+	// Create a new order
+	Create(ctx context.Context, data *ticketmatic.CreateOrder) (*ticketmatic.Order, error)
+
+	// Update an order
+	Update(ctx context.Context, id int64, data *ticketmatic.UpdateOrder) (*ticketmatic.Order, error)
+
+	// Delete an order
+	Delete(ctx context.Context, id int64) error
+
+	// Batch operations
+	Batch(ctx context.Context, data *ticketmatic.BatchOrderOperation) error
+
+	// Delete orders
+	Deletebatch(ctx context.Context, data []int64) (*ticketmatic.BatchResult, error)
+
+	// Confirm an order
+	Confirm(ctx context.Context, id int64) (*ticketmatic.Order, error)
+
+	// Split tickets and/or products from an order into a new one.
+	Split(ctx context.Context, id int64, data *ticketmatic.SplitOrder) (*ticketmatic.Order, error)
+
+	// Add tickets to order
+	Addtickets(ctx context.Context, id int64, data *ticketmatic.AddTickets) (*ticketmatic.AddItemsResult, error)
+
+	// Modify tickets in order
+	Updatetickets(ctx context.Context, id int64, data *ticketmatic.UpdateTickets) (*ticketmatic.Order, error)
+
+	// Remove tickets from order
+	Deletetickets(ctx context.Context, id int64, data *ticketmatic.DeleteTickets) (*ticketmatic.Order, error)
+
+	// Add products to order
+	Addproducts(ctx context.Context, id int64, data *ticketmatic.AddProducts) (*ticketmatic.AddItemsResult, error)
+
+	// Modify products in order
+	Updateproducts(ctx context.Context, id int64, data *ticketmatic.UpdateProducts) (*ticketmatic.Order, error)
+
+	// Remove products from order
+	Deleteproducts(ctx context.Context, id int64, data *ticketmatic.DeleteProducts) (*ticketmatic.Order, error)
+
+	// Add payments to order
+	Addpayments(ctx context.Context, id int64, data *ticketmatic.AddPayments) (*ticketmatic.Order, error)
+
+	// Add refund for payment for order
+	Addrefunds(ctx context.Context, id int64, data *ticketmatic.AddRefunds) (*ticketmatic.Order, error)
+
+	// Get the log history for an order
+	Getlogs(ctx context.Context, id int64) ([]*ticketmatic.LogItem, error)
+
+	// [DEPRECATED] Export tickets to PDF
+	Postticketspdf(ctx context.Context, id int64, data *ticketmatic.TicketsPdfRequest) (*ticketmatic.Url, error)
+
+	// Export tickets and/or vouchercodes to PDF
+	Postpdf(ctx context.Context, id int64, data *ticketmatic.TicketsPdfRequest) (*ticketmatic.Url, error)
+
+	// Send the delivery e-mail for the order
+	Postticketsemaildelivery(ctx context.Context, id int64, data *ticketmatic.TicketsEmaildeliveryRequest) (*ticketmatic.Order, error)
+
+	// Send the payment instruction e-mail
+	Postticketsemailpaymentinstruction(ctx context.Context, id int64) (*ticketmatic.Order, error)
+
+	// Create a payment request for an online payment for the order
+	Postpaymentrequest(ctx context.Context, id int64, data *ticketmatic.PaymentRequest) (*ticketmatic.Url, error)
+
+	// Cancel the outstanding payment request for the order
+	Cancelpaymentrequest(ctx context.Context, id int64) error
+
+	// Get the PDF for a document for the order
+	Getdocument(ctx context.Context, id int64, documentid string, language string) (*ticketmatic.Url, error)
+
+	// Import historic orders
+	Import(ctx context.Context, data []*ticketmatic.ImportOrder) ([]*ticketmatic.OrderImportStatus, error)
+
+	// Reserve order IDs
+	Reserve(ctx context.Context, data *ticketmatic.OrderIdReservation) (*ticketmatic.OrderIdReservation, error)
+
+	// Purge orders
+	Purge(ctx context.Context, params *ticketmatic.PurgeOrdersRequest) (string, error)
+}
+
+type ordersService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Orders() OrdersService {
+	return &ordersService{c.client}
+}
+
+func (s *ordersService) Getlist(ctx context.Context, params *ticketmatic.OrderQuery) (*orders.List, error) {
+	return orders.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *ordersService) Get(ctx context.Context, id int64) (*ticketmatic.Order, error) {
+	return orders.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *ordersService) Create(ctx context.Context, data *ticketmatic.CreateOrder) (*ticketmatic.Order, error) {
+	return orders.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *ordersService) Update(ctx context.Context, id int64, data *ticketmatic.UpdateOrder) (*ticketmatic.Order, error) {
+	return orders.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Delete(ctx context.Context, id int64) error {
+	return orders.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *ordersService) Batch(ctx context.Context, data *ticketmatic.BatchOrderOperation) error {
+	return orders.Batch(s.client.WithContext(ctx), data)
+}
+
+func (s *ordersService) Deletebatch(ctx context.Context, data []int64) (*ticketmatic.BatchResult, error) {
+	return orders.Deletebatch(s.client.WithContext(ctx), data)
+}
+
+func (s *ordersService) Confirm(ctx context.Context, id int64) (*ticketmatic.Order, error) {
+	return orders.Confirm(s.client.WithContext(ctx), id)
+}
+
+func (s *ordersService) Split(ctx context.Context, id int64, data *ticketmatic.SplitOrder) (*ticketmatic.Order, error) {
+	return orders.Split(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Addtickets(ctx context.Context, id int64, data *ticketmatic.AddTickets) (*ticketmatic.AddItemsResult, error) {
+	return orders.Addtickets(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Updatetickets(ctx context.Context, id int64, data *ticketmatic.UpdateTickets) (*ticketmatic.Order, error) {
+	return orders.Updatetickets(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Deletetickets(ctx context.Context, id int64, data *ticketmatic.DeleteTickets) (*ticketmatic.Order, error) {
+	return orders.Deletetickets(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Addproducts(ctx context.Context, id int64, data *ticketmatic.AddProducts) (*ticketmatic.AddItemsResult, error) {
+	return orders.Addproducts(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Updateproducts(ctx context.Context, id int64, data *ticketmatic.UpdateProducts) (*ticketmatic.Order, error) {
+	return orders.Updateproducts(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Deleteproducts(ctx context.Context, id int64, data *ticketmatic.DeleteProducts) (*ticketmatic.Order, error) {
+	return orders.Deleteproducts(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Addpayments(ctx context.Context, id int64, data *ticketmatic.AddPayments) (*ticketmatic.Order, error) {
+	return orders.Addpayments(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Addrefunds(ctx context.Context, id int64, data *ticketmatic.AddRefunds) (*ticketmatic.Order, error) {
+	return orders.Addrefunds(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Getlogs(ctx context.Context, id int64) ([]*ticketmatic.LogItem, error) {
+	return orders.Getlogs(s.client.WithContext(ctx), id)
+}
+
+func (s *ordersService) Postticketspdf(ctx context.Context, id int64, data *ticketmatic.TicketsPdfRequest) (*ticketmatic.Url, error) {
+	return orders.Postticketspdf(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Postpdf(ctx context.Context, id int64, data *ticketmatic.TicketsPdfRequest) (*ticketmatic.Url, error) {
+	return orders.Postpdf(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Postticketsemaildelivery(ctx context.Context, id int64, data *ticketmatic.TicketsEmaildeliveryRequest) (*ticketmatic.Order, error) {
+	return orders.Postticketsemaildelivery(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Postticketsemailpaymentinstruction(ctx context.Context, id int64) (*ticketmatic.Order, error) {
+	return orders.Postticketsemailpaymentinstruction(s.client.WithContext(ctx), id)
+}
+
+func (s *ordersService) Postpaymentrequest(ctx context.Context, id int64, data *ticketmatic.PaymentRequest) (*ticketmatic.Url, error) {
+	return orders.Postpaymentrequest(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ordersService) Cancelpaymentrequest(ctx context.Context, id int64) error {
+	return orders.Cancelpaymentrequest(s.client.WithContext(ctx), id)
+}
+
+func (s *ordersService) Getdocument(ctx context.Context, id int64, documentid string, language string) (*ticketmatic.Url, error) {
+	return orders.Getdocument(s.client.WithContext(ctx), id, documentid, language)
+}
+
+func (s *ordersService) Import(ctx context.Context, data []*ticketmatic.ImportOrder) ([]*ticketmatic.OrderImportStatus, error) {
+	return orders.Import(s.client.WithContext(ctx), data)
+}
+
+func (s *ordersService) Reserve(ctx context.Context, data *ticketmatic.OrderIdReservation) (*ticketmatic.OrderIdReservation, error) {
+	return orders.Reserve(s.client.WithContext(ctx), data)
+}
+
+func (s *ordersService) Purge(ctx context.Context, params *ticketmatic.PurgeOrdersRequest) (string, error) {
+	return orders.Purge(s.client.WithContext(ctx), params)
+}
+
+// Operations of the events package
+type EventsService interface {
+	// Get a list of events
+	Getlist(ctx context.Context, params *ticketmatic.EventQuery) (*events.List, error)
+
+	// Get a single event
+	Get(ctx context.Context, id int64) (*ticketmatic.Event, error)
+
+	// Create a new event
+	Create(ctx context.Context, data *ticketmatic.Event) (*ticketmatic.Event, error)
+
+	// Update an event
+	Update(ctx context.Context, id int64, data *ticketmatic.Event) (*ticketmatic.Event, error)
+
+	// Batch operations
+	Batch(ctx context.Context, data *ticketmatic.BatchEventOperation) error
+
+	// Delete an event
+	Delete(ctx context.Context, id int64) error
+
+	// Get all tickets for an event
+	Gettickets(ctx context.Context, id int64, params *ticketmatic.EventTicketQuery) (*events.TicketStream, error)
+
+	// Batch update tickets for an event
+	Batchupdatetickets(ctx context.Context, id int64, data []*ticketmatic.EventTicket) error
+
+	// Lock a set of tickets
+	Locktickets(ctx context.Context, id int64, data *ticketmatic.EventLockTickets) error
+
+	// Unlock a set of tickets
+	Unlocktickets(ctx context.Context, id int64, data *ticketmatic.EventUnlockTickets) error
+
+	// Update the seat rank for a set of tickets
+	Updateseatrankfortickets(ctx context.Context, id int64, data *ticketmatic.EventUpdateSeatRankForTickets) error
+
+	// Scan out tickets that are scanned in
+	Scanticketsout(ctx context.Context, id int64, data *ticketmatic.EventScanTicketsOut) ([]int64, error)
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+
+	// Purge event
+	Purge(ctx context.Context, id int64) error
+
+	// Save an image
+	Saveimage(ctx context.Context, id int64, data string) (string, error)
+
+	// Delete the event image
+	Deleteimage(ctx context.Context, id int64) error
+}
+
+type eventsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Events() EventsService {
+	return &eventsService{c.client}
+}
+
+func (s *eventsService) Getlist(ctx context.Context, params *ticketmatic.EventQuery) (*events.List, error) {
+	return events.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *eventsService) Get(ctx context.Context, id int64) (*ticketmatic.Event, error) {
+	return events.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *eventsService) Create(ctx context.Context, data *ticketmatic.Event) (*ticketmatic.Event, error) {
+	return events.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *eventsService) Update(ctx context.Context, id int64, data *ticketmatic.Event) (*ticketmatic.Event, error) {
+	return events.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *eventsService) Batch(ctx context.Context, data *ticketmatic.BatchEventOperation) error {
+	return events.Batch(s.client.WithContext(ctx), data)
+}
+
+func (s *eventsService) Delete(ctx context.Context, id int64) error {
+	return events.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *eventsService) Gettickets(ctx context.Context, id int64, params *ticketmatic.EventTicketQuery) (*events.TicketStream, error) {
+	return events.Gettickets(s.client.WithContext(ctx), id, params)
+}
+
+func (s *eventsService) Batchupdatetickets(ctx context.Context, id int64, data []*ticketmatic.EventTicket) error {
+	return events.Batchupdatetickets(s.client.WithContext(ctx), id, data)
+}
+
+func (s *eventsService) Locktickets(ctx context.Context, id int64, data *ticketmatic.EventLockTickets) error {
+	return events.Locktickets(s.client.WithContext(ctx), id, data)
+}
+
+func (s *eventsService) Unlocktickets(ctx context.Context, id int64, data *ticketmatic.EventUnlockTickets) error {
+	return events.Unlocktickets(s.client.WithContext(ctx), id, data)
+}
+
+func (s *eventsService) Updateseatrankfortickets(ctx context.Context, id int64, data *ticketmatic.EventUpdateSeatRankForTickets) error {
+	return events.Updateseatrankfortickets(s.client.WithContext(ctx), id, data)
+}
+
+func (s *eventsService) Scanticketsout(ctx context.Context, id int64, data *ticketmatic.EventScanTicketsOut) ([]int64, error) {
+	return events.Scanticketsout(s.client.WithContext(ctx), id, data)
+}
+
+func (s *eventsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return events.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *eventsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return events.Translate(s.client.WithContext(ctx), id, data)
+}
+
+func (s *eventsService) Purge(ctx context.Context, id int64) error {
+	return events.Purge(s.client.WithContext(ctx), id)
+}
+
+func (s *eventsService) Saveimage(ctx context.Context, id int64, data string) (string, error) {
+	return events.Saveimage(s.client.WithContext(ctx), id, data)
+}
+
+func (s *eventsService) Deleteimage(ctx context.Context, id int64) error {
+	return events.Deleteimage(s.client.WithContext(ctx), id)
+}
+
+// Operations of the contacts package
+type ContactsService interface {
+	// Get a list of contacts
+	Getlist(ctx context.Context, params *ticketmatic.ContactQuery) (*contacts.List, error)
+
+	// Get a single contact
+	Get(ctx context.Context, id int64, params *ticketmatic.ContactGetQuery) (*ticketmatic.Contact, error)
+
+	// Create a new contact
+	Create(ctx context.Context, data *ticketmatic.Contact) (*ticketmatic.Contact, error)
+
+	// Update a contact
+	Update(ctx context.Context, id int64, data *ticketmatic.Contact) (*ticketmatic.Contact, error)
+
+	// Remove a contact
+	Delete(ctx context.Context, id int64) error
+
+	// Batch operations
+	Batch(ctx context.Context, data *ticketmatic.BatchContactOperation) error
+
+	// Import contact
+	Import(ctx context.Context, data []*ticketmatic.Contact) ([]*ticketmatic.ContactImportStatus, error)
+
+	// Reserve contact IDs
+	Reserve(ctx context.Context, data *ticketmatic.ContactIdReservation) (*ticketmatic.ContactIdReservation, error)
+
+	// Get a remark
+	Getremark(ctx context.Context, id int64, remarkid string) (*ticketmatic.ContactRemark, error)
+
+	// Create a remark
+	Createremark(ctx context.Context, id int64, data *ticketmatic.ContactRemark) (*ticketmatic.ContactRemark, error)
+
+	// Update a remark
+	Updateremark(ctx context.Context, id int64, remarkid string, data *ticketmatic.ContactRemark) (*ticketmatic.ContactRemark, error)
+
+	// Delete a remark
+	Deleteremark(ctx context.Context, id int64, remarkid string) error
+}
+
+type contactsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Contacts() ContactsService {
+	return &contactsService{c.client}
+}
+
+func (s *contactsService) Getlist(ctx context.Context, params *ticketmatic.ContactQuery) (*contacts.List, error) {
+	return contacts.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *contactsService) Get(ctx context.Context, id int64, params *ticketmatic.ContactGetQuery) (*ticketmatic.Contact, error) {
+	return contacts.Get(s.client.WithContext(ctx), id, params)
+}
+
+func (s *contactsService) Create(ctx context.Context, data *ticketmatic.Contact) (*ticketmatic.Contact, error) {
+	return contacts.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *contactsService) Update(ctx context.Context, id int64, data *ticketmatic.Contact) (*ticketmatic.Contact, error) {
+	return contacts.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *contactsService) Delete(ctx context.Context, id int64) error {
+	return contacts.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *contactsService) Batch(ctx context.Context, data *ticketmatic.BatchContactOperation) error {
+	return contacts.Batch(s.client.WithContext(ctx), data)
+}
+
+func (s *contactsService) Import(ctx context.Context, data []*ticketmatic.Contact) ([]*ticketmatic.ContactImportStatus, error) {
+	return contacts.Import(s.client.WithContext(ctx), data)
+}
+
+func (s *contactsService) Reserve(ctx context.Context, data *ticketmatic.ContactIdReservation) (*ticketmatic.ContactIdReservation, error) {
+	return contacts.Reserve(s.client.WithContext(ctx), data)
+}
+
+func (s *contactsService) Getremark(ctx context.Context, id int64, remarkid string) (*ticketmatic.ContactRemark, error) {
+	return contacts.Getremark(s.client.WithContext(ctx), id, remarkid)
+}
+
+func (s *contactsService) Createremark(ctx context.Context, id int64, data *ticketmatic.ContactRemark) (*ticketmatic.ContactRemark, error) {
+	return contacts.Createremark(s.client.WithContext(ctx), id, data)
+}
+
+func (s *contactsService) Updateremark(ctx context.Context, id int64, remarkid string, data *ticketmatic.ContactRemark) (*ticketmatic.ContactRemark, error) {
+	return contacts.Updateremark(s.client.WithContext(ctx), id, remarkid, data)
+}
+
+func (s *contactsService) Deleteremark(ctx context.Context, id int64, remarkid string) error {
+	return contacts.Deleteremark(s.client.WithContext(ctx), id, remarkid)
+}
+
+// Operations of the jobs package
+type JobsService interface {
+	// Get job
+	Get(ctx context.Context, id int64) (*ticketmatic.JobResult, error)
+}
+
+type jobsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Jobs() JobsService {
+	return &jobsService{c.client}
+}
+
+func (s *jobsService) Get(ctx context.Context, id int64) (*ticketmatic.JobResult, error) {
+	return jobs.Get(s.client.WithContext(ctx), id)
+}
+
+// Operations of the subscribers package
+type SubscribersService interface {
+	// Sync mailtool changes to Ticketmatic
+	Sync(ctx context.Context, data []*ticketmatic.SubscriberSync) error
+
+	// Create a new communcation in Ticketmatic based on a list of subscriber
+	// emailaddresses
+	Communications(ctx context.Context, data *ticketmatic.SubscriberCommunication) error
+}
+
+type subscribersService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Subscribers() SubscribersService {
+	return &subscribersService{c.client}
+}
+
+func (s *subscribersService) Sync(ctx context.Context, data []*ticketmatic.SubscriberSync) error {
+	return subscribers.Sync(s.client.WithContext(ctx), data)
+}
+
+func (s *subscribersService) Communications(ctx context.Context, data *ticketmatic.SubscriberCommunication) error {
+	return subscribers.Communications(s.client.WithContext(ctx), data)
+}
+
+// Operations of the eventstream package
+type EventstreamService interface {
+	// Poll eventstream
+	Eventstream(ctx context.Context, params *ticketmatic.EventstreamRequest) (*ticketmatic.EventstreamResult, error)
+}
+
+type eventstreamService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Eventstream() EventstreamService {
+	return &eventstreamService{c.client}
+}
+
+func (s *eventstreamService) Eventstream(ctx context.Context, params *ticketmatic.EventstreamRequest) (*ticketmatic.EventstreamResult, error) {
+	return eventstream.Eventstream(s.client.WithContext(ctx), params)
+}
+
+// Operations of the tools package
+type ToolsService interface {
+	// Get account information
+	Account(ctx context.Context) (*ticketmatic.AccountInfo, error)
+
+	// Get authorized accounts
+	Accounts(ctx context.Context) ([]*ticketmatic.AccountInfo, error)
+
+	// Execute a query on the public data model
+	Queries(ctx context.Context, data *ticketmatic.QueryRequest) (*ticketmatic.QueryResult, error)
+
+	// Export a query on the public data model
+	Export(ctx context.Context, data *ticketmatic.QueryRequest) (*tools.QueryStream, error)
+
+	// Get statistics on the tickets processed during a certain period
+	Ticketsprocessedstatistics(ctx context.Context, params *ticketmatic.TicketsprocessedRequest) ([]*ticketmatic.TicketsprocessedStatistics, error)
+}
+
+type toolsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Tools() ToolsService {
+	return &toolsService{c.client}
+}
+
+func (s *toolsService) Account(ctx context.Context) (*ticketmatic.AccountInfo, error) {
+	return tools.Account(s.client.WithContext(ctx))
+}
+
+func (s *toolsService) Accounts(ctx context.Context) ([]*ticketmatic.AccountInfo, error) {
+	return tools.Accounts(s.client.WithContext(ctx))
+}
+
+func (s *toolsService) Queries(ctx context.Context, data *ticketmatic.QueryRequest) (*ticketmatic.QueryResult, error) {
+	return tools.Queries(s.client.WithContext(ctx), data)
+}
+
+func (s *toolsService) Export(ctx context.Context, data *ticketmatic.QueryRequest) (*tools.QueryStream, error) {
+	return tools.Export(s.client.WithContext(ctx), data)
+}
+
+func (s *toolsService) Ticketsprocessedstatistics(ctx context.Context, params *ticketmatic.TicketsprocessedRequest) ([]*ticketmatic.TicketsprocessedStatistics, error) {
+	return tools.Ticketsprocessedstatistics(s.client.WithContext(ctx), params)
+}
+
+// Operations of the diagnostics package
+type DiagnosticsService interface {
+	// Get the backend time
+	Time(ctx context.Context) (*ticketmatic.Timestamp, error)
+}
+
+type diagnosticsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Diagnostics() DiagnosticsService {
+	return &diagnosticsService{c.client}
+}
+
+func (s *diagnosticsService) Time(ctx context.Context) (*ticketmatic.Timestamp, error) {
+	return diagnostics.Time(s.client.WithContext(ctx))
+}
+
+// Operations of the waitinglistrequests package
+type WaitingListRequestsService interface {
+	// Get a list of waiting list requests
+	Getlist(ctx context.Context, params *ticketmatic.WaitingListRequestQuery) (*waitinglistrequests.List, error)
+
+	// Get a single waiting list request
+	Get(ctx context.Context, id int64) (*ticketmatic.WaitingListRequest, error)
+
+	// Create a new waiting list request
+	Create(ctx context.Context, data *ticketmatic.WaitingListRequest) (*ticketmatic.WaitingListRequest, error)
+
+	// Modify an existing waiting list request
+	Update(ctx context.Context, id int64, data *ticketmatic.WaitingListRequest) (*ticketmatic.WaitingListRequest, error)
+
+	// Remove a waiting list request
+	Delete(ctx context.Context, id int64) error
+}
+
+type waitingListRequestsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) WaitingListRequests() WaitingListRequestsService {
+	return &waitingListRequestsService{c.client}
+}
+
+func (s *waitingListRequestsService) Getlist(ctx context.Context, params *ticketmatic.WaitingListRequestQuery) (*waitinglistrequests.List, error) {
+	return waitinglistrequests.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *waitingListRequestsService) Get(ctx context.Context, id int64) (*ticketmatic.WaitingListRequest, error) {
+	return waitinglistrequests.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *waitingListRequestsService) Create(ctx context.Context, data *ticketmatic.WaitingListRequest) (*ticketmatic.WaitingListRequest, error) {
+	return waitinglistrequests.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *waitingListRequestsService) Update(ctx context.Context, id int64, data *ticketmatic.WaitingListRequest) (*ticketmatic.WaitingListRequest, error) {
+	return waitinglistrequests.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *waitingListRequestsService) Delete(ctx context.Context, id int64) error {
+	return waitinglistrequests.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the accountparameters package
+type AccountParametersService interface {
+	// Get all configured account parameters
+	Getlist(ctx context.Context) ([]*ticketmatic.AccountParameter, error)
+
+	// Get an account parameter
+	Get(ctx context.Context, name string) (*ticketmatic.AccountParameter, error)
+
+	// Set an account parameter
+	Set(ctx context.Context, data *ticketmatic.AccountParameter) (*ticketmatic.AccountParameter, error)
+}
+
+type accountParametersService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) AccountParameters() AccountParametersService {
+	return &accountParametersService{c.client}
+}
+
+func (s *accountParametersService) Getlist(ctx context.Context) ([]*ticketmatic.AccountParameter, error) {
+	return accountparameters.Getlist(s.client.WithContext(ctx))
+}
+
+func (s *accountParametersService) Get(ctx context.Context, name string) (*ticketmatic.AccountParameter, error) {
+	return accountparameters.Get(s.client.WithContext(ctx), name)
+}
+
+func (s *accountParametersService) Set(ctx context.Context, data *ticketmatic.AccountParameter) (*ticketmatic.AccountParameter, error) {
+	return accountparameters.Set(s.client.WithContext(ctx), data)
+}
+
+// Operations of the documents package
+type DocumentsService interface {
+	// Get a list of documents
+	Getlist(ctx context.Context, params *ticketmatic.DocumentQuery) (*documents.List, error)
+
+	// Get a single document
+	Get(ctx context.Context, id int64) (*ticketmatic.Document, error)
+
+	// Create a new document
+	Create(ctx context.Context, data *ticketmatic.Document) (*ticketmatic.Document, error)
+
+	// Modify an existing document
+	Update(ctx context.Context, id int64, data *ticketmatic.Document) (*ticketmatic.Document, error)
+
+	// Remove a document
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type documentsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Documents() DocumentsService {
+	return &documentsService{c.client}
+}
+
+func (s *documentsService) Getlist(ctx context.Context, params *ticketmatic.DocumentQuery) (*documents.List, error) {
+	return documents.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *documentsService) Get(ctx context.Context, id int64) (*ticketmatic.Document, error) {
+	return documents.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *documentsService) Create(ctx context.Context, data *ticketmatic.Document) (*ticketmatic.Document, error) {
+	return documents.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *documentsService) Update(ctx context.Context, id int64, data *ticketmatic.Document) (*ticketmatic.Document, error) {
+	return documents.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *documentsService) Delete(ctx context.Context, id int64) error {
+	return documents.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *documentsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return documents.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *documentsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return documents.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the ordermails package
+type OrderMailsService interface {
+	// Get a list of order mail templates
+	Getlist(ctx context.Context, params *ticketmatic.OrderMailTemplateQuery) (*ordermails.List, error)
+
+	// Get a single order mail template
+	Get(ctx context.Context, id int64) (*ticketmatic.OrderMailTemplate, error)
+
+	// Create a new order mail template
+	Create(ctx context.Context, data *ticketmatic.OrderMailTemplate) (*ticketmatic.OrderMailTemplate, error)
+
+	// Modify an existing order mail template
+	Update(ctx context.Context, id int64, data *ticketmatic.OrderMailTemplate) (*ticketmatic.OrderMailTemplate, error)
+
+	// Remove an order mail template
+	Delete(ctx context.Context, id int64) error
+}
+
+type orderMailsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) OrderMails() OrderMailsService {
+	return &orderMailsService{c.client}
+}
+
+func (s *orderMailsService) Getlist(ctx context.Context, params *ticketmatic.OrderMailTemplateQuery) (*ordermails.List, error) {
+	return ordermails.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *orderMailsService) Get(ctx context.Context, id int64) (*ticketmatic.OrderMailTemplate, error) {
+	return ordermails.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *orderMailsService) Create(ctx context.Context, data *ticketmatic.OrderMailTemplate) (*ticketmatic.OrderMailTemplate, error) {
+	return ordermails.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *orderMailsService) Update(ctx context.Context, id int64, data *ticketmatic.OrderMailTemplate) (*ticketmatic.OrderMailTemplate, error) {
+	return ordermails.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *orderMailsService) Delete(ctx context.Context, id int64) error {
+	return ordermails.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the ticketlayouts package
+type TicketLayoutsService interface {
+	// Get a list of ticket layouts
+	Getlist(ctx context.Context, params *ticketmatic.TicketLayoutQuery) (*ticketlayouts.List, error)
+
+	// Get a single ticket layout
+	Get(ctx context.Context, id int64) (*ticketmatic.TicketLayout, error)
+
+	// Create a new ticket layout
+	Create(ctx context.Context, data *ticketmatic.TicketLayout) (*ticketmatic.TicketLayout, error)
+
+	// Modify an existing ticket layout
+	Update(ctx context.Context, id int64, data *ticketmatic.TicketLayout) (*ticketmatic.TicketLayout, error)
+
+	// Remove a ticket layout
+	Delete(ctx context.Context, id int64) error
+}
+
+type ticketLayoutsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) TicketLayouts() TicketLayoutsService {
+	return &ticketLayoutsService{c.client}
+}
+
+func (s *ticketLayoutsService) Getlist(ctx context.Context, params *ticketmatic.TicketLayoutQuery) (*ticketlayouts.List, error) {
+	return ticketlayouts.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *ticketLayoutsService) Get(ctx context.Context, id int64) (*ticketmatic.TicketLayout, error) {
+	return ticketlayouts.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *ticketLayoutsService) Create(ctx context.Context, data *ticketmatic.TicketLayout) (*ticketmatic.TicketLayout, error) {
+	return ticketlayouts.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *ticketLayoutsService) Update(ctx context.Context, id int64, data *ticketmatic.TicketLayout) (*ticketmatic.TicketLayout, error) {
+	return ticketlayouts.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ticketLayoutsService) Delete(ctx context.Context, id int64) error {
+	return ticketlayouts.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the ticketlayouttemplates package
+type TicketLayoutTemplatesService interface {
+	// Get a list of ticket layout templates
+	Getlist(ctx context.Context, params *ticketmatic.TicketLayoutTemplateQuery) (*ticketlayouttemplates.List, error)
+
+	// Get a single ticket layout template
+	Get(ctx context.Context, id int64) (*ticketmatic.TicketLayoutTemplate, error)
+
+	// Create a new ticket layout template
+	Create(ctx context.Context, data *ticketmatic.TicketLayoutTemplate) (*ticketmatic.TicketLayoutTemplate, error)
+
+	// Modify an existing ticket layout template
+	Update(ctx context.Context, id int64, data *ticketmatic.TicketLayoutTemplate) (*ticketmatic.TicketLayoutTemplate, error)
+
+	// Remove a ticket layout template
+	Delete(ctx context.Context, id int64) error
+}
+
+type ticketLayoutTemplatesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) TicketLayoutTemplates() TicketLayoutTemplatesService {
+	return &ticketLayoutTemplatesService{c.client}
+}
+
+func (s *ticketLayoutTemplatesService) Getlist(ctx context.Context, params *ticketmatic.TicketLayoutTemplateQuery) (*ticketlayouttemplates.List, error) {
+	return ticketlayouttemplates.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *ticketLayoutTemplatesService) Get(ctx context.Context, id int64) (*ticketmatic.TicketLayoutTemplate, error) {
+	return ticketlayouttemplates.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *ticketLayoutTemplatesService) Create(ctx context.Context, data *ticketmatic.TicketLayoutTemplate) (*ticketmatic.TicketLayoutTemplate, error) {
+	return ticketlayouttemplates.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *ticketLayoutTemplatesService) Update(ctx context.Context, id int64, data *ticketmatic.TicketLayoutTemplate) (*ticketmatic.TicketLayoutTemplate, error) {
+	return ticketlayouttemplates.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ticketLayoutTemplatesService) Delete(ctx context.Context, id int64) error {
+	return ticketlayouttemplates.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the webskins package
+type WebSkinsService interface {
+	// Get a list of web sales skins
+	Getlist(ctx context.Context, params *ticketmatic.WebSalesSkinQuery) (*webskins.List, error)
+
+	// Get a single web sales skin
+	Get(ctx context.Context, id int64) (*ticketmatic.WebSalesSkin, error)
+
+	// Create a new web sales skin
+	Create(ctx context.Context, data *ticketmatic.WebSalesSkin) (*ticketmatic.WebSalesSkin, error)
+
+	// Modify an existing web sales skin
+	Update(ctx context.Context, id int64, data *ticketmatic.WebSalesSkin) (*ticketmatic.WebSalesSkin, error)
+
+	// Remove a web sales skin
+	Delete(ctx context.Context, id int64) error
+}
+
+type webSkinsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) WebSkins() WebSkinsService {
+	return &webSkinsService{c.client}
+}
+
+func (s *webSkinsService) Getlist(ctx context.Context, params *ticketmatic.WebSalesSkinQuery) (*webskins.List, error) {
+	return webskins.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *webSkinsService) Get(ctx context.Context, id int64) (*ticketmatic.WebSalesSkin, error) {
+	return webskins.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *webSkinsService) Create(ctx context.Context, data *ticketmatic.WebSalesSkin) (*ticketmatic.WebSalesSkin, error) {
+	return webskins.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *webSkinsService) Update(ctx context.Context, id int64, data *ticketmatic.WebSalesSkin) (*ticketmatic.WebSalesSkin, error) {
+	return webskins.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *webSkinsService) Delete(ctx context.Context, id int64) error {
+	return webskins.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the eventlocations package
+type EventLocationsService interface {
+	// Get a list of event locations
+	Getlist(ctx context.Context, params *ticketmatic.EventLocationQuery) (*eventlocations.List, error)
+
+	// Get a single event location
+	Get(ctx context.Context, id int64) (*ticketmatic.EventLocation, error)
+
+	// Create a new event location
+	Create(ctx context.Context, data *ticketmatic.EventLocation) (*ticketmatic.EventLocation, error)
+
+	// Modify an existing event location
+	Update(ctx context.Context, id int64, data *ticketmatic.EventLocation) (*ticketmatic.EventLocation, error)
+
+	// Remove an event location
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type eventLocationsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) EventLocations() EventLocationsService {
+	return &eventLocationsService{c.client}
+}
+
+func (s *eventLocationsService) Getlist(ctx context.Context, params *ticketmatic.EventLocationQuery) (*eventlocations.List, error) {
+	return eventlocations.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *eventLocationsService) Get(ctx context.Context, id int64) (*ticketmatic.EventLocation, error) {
+	return eventlocations.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *eventLocationsService) Create(ctx context.Context, data *ticketmatic.EventLocation) (*ticketmatic.EventLocation, error) {
+	return eventlocations.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *eventLocationsService) Update(ctx context.Context, id int64, data *ticketmatic.EventLocation) (*ticketmatic.EventLocation, error) {
+	return eventlocations.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *eventLocationsService) Delete(ctx context.Context, id int64) error {
+	return eventlocations.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *eventLocationsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return eventlocations.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *eventLocationsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return eventlocations.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the orderfeedefinitions package
+type OrderFeeDefinitionsService interface {
+	// Get a list of order fee definitions
+	Getlist(ctx context.Context, params *ticketmatic.OrderFeeDefinitionQuery) (*orderfeedefinitions.List, error)
+
+	// Get a single order fee definition
+	Get(ctx context.Context, id int64) (*ticketmatic.OrderFeeDefinition, error)
+
+	// Create a new order fee definition
+	Create(ctx context.Context, data *ticketmatic.OrderFeeDefinition) (*ticketmatic.OrderFeeDefinition, error)
+
+	// Remove an order fee definition
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type orderFeeDefinitionsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) OrderFeeDefinitions() OrderFeeDefinitionsService {
+	return &orderFeeDefinitionsService{c.client}
+}
+
+func (s *orderFeeDefinitionsService) Getlist(ctx context.Context, params *ticketmatic.OrderFeeDefinitionQuery) (*orderfeedefinitions.List, error) {
+	return orderfeedefinitions.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *orderFeeDefinitionsService) Get(ctx context.Context, id int64) (*ticketmatic.OrderFeeDefinition, error) {
+	return orderfeedefinitions.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *orderFeeDefinitionsService) Create(ctx context.Context, data *ticketmatic.OrderFeeDefinition) (*ticketmatic.OrderFeeDefinition, error) {
+	return orderfeedefinitions.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *orderFeeDefinitionsService) Delete(ctx context.Context, id int64) error {
+	return orderfeedefinitions.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *orderFeeDefinitionsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return orderfeedefinitions.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *orderFeeDefinitionsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return orderfeedefinitions.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the pricelists package
+type PriceListsService interface {
+	// Get a list of price lists
+	Getlist(ctx context.Context, params *ticketmatic.PriceListQuery) (*pricelists.List, error)
+
+	// Get a single price list
+	Get(ctx context.Context, id int64) (*ticketmatic.PriceList, error)
+
+	// Create a new price list
+	Create(ctx context.Context, data *ticketmatic.PriceList) (*ticketmatic.PriceList, error)
+
+	// Modify an existing price list
+	Update(ctx context.Context, id int64, data *ticketmatic.PriceList) (*ticketmatic.PriceList, error)
+
+	// Remove a price list
+	Delete(ctx context.Context, id int64) error
+}
+
+type priceListsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) PriceLists() PriceListsService {
+	return &priceListsService{c.client}
+}
+
+func (s *priceListsService) Getlist(ctx context.Context, params *ticketmatic.PriceListQuery) (*pricelists.List, error) {
+	return pricelists.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *priceListsService) Get(ctx context.Context, id int64) (*ticketmatic.PriceList, error) {
+	return pricelists.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *priceListsService) Create(ctx context.Context, data *ticketmatic.PriceList) (*ticketmatic.PriceList, error) {
+	return pricelists.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *priceListsService) Update(ctx context.Context, id int64, data *ticketmatic.PriceList) (*ticketmatic.PriceList, error) {
+	return pricelists.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *priceListsService) Delete(ctx context.Context, id int64) error {
+	return pricelists.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the pricetypes package
+type PriceTypesService interface {
+	// Get a list of price types
+	Getlist(ctx context.Context, params *ticketmatic.PriceTypeQuery) (*pricetypes.List, error)
+
+	// Get a single price type
+	Get(ctx context.Context, id int64) (*ticketmatic.PriceType, error)
+
+	// Create a new price type
+	Create(ctx context.Context, data *ticketmatic.PriceType) (*ticketmatic.PriceType, error)
+
+	// Modify an existing price type
+	Update(ctx context.Context, id int64, data *ticketmatic.PriceType) (*ticketmatic.PriceType, error)
+
+	// Remove a price type
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type priceTypesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) PriceTypes() PriceTypesService {
+	return &priceTypesService{c.client}
+}
+
+func (s *priceTypesService) Getlist(ctx context.Context, params *ticketmatic.PriceTypeQuery) (*pricetypes.List, error) {
+	return pricetypes.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *priceTypesService) Get(ctx context.Context, id int64) (*ticketmatic.PriceType, error) {
+	return pricetypes.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *priceTypesService) Create(ctx context.Context, data *ticketmatic.PriceType) (*ticketmatic.PriceType, error) {
+	return pricetypes.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *priceTypesService) Update(ctx context.Context, id int64, data *ticketmatic.PriceType) (*ticketmatic.PriceType, error) {
+	return pricetypes.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *priceTypesService) Delete(ctx context.Context, id int64) error {
+	return pricetypes.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *priceTypesService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return pricetypes.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *priceTypesService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return pricetypes.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the ticketfees package
+type TicketFeesService interface {
+	// Get a list of ticket fees
+	Getlist(ctx context.Context, params *ticketmatic.TicketFeeQuery) (*ticketfees.List, error)
+
+	// Get a single ticket fee
+	Get(ctx context.Context, id int64) (*ticketmatic.TicketFee, error)
+
+	// Create a new ticket fee
+	Create(ctx context.Context, data *ticketmatic.TicketFee) (*ticketmatic.TicketFee, error)
+
+	// Modify an existing ticket fee
+	Update(ctx context.Context, id int64, data *ticketmatic.TicketFee) (*ticketmatic.TicketFee, error)
+
+	// Remove a ticket fee
+	Delete(ctx context.Context, id int64) error
+}
+
+type ticketFeesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) TicketFees() TicketFeesService {
+	return &ticketFeesService{c.client}
+}
+
+func (s *ticketFeesService) Getlist(ctx context.Context, params *ticketmatic.TicketFeeQuery) (*ticketfees.List, error) {
+	return ticketfees.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *ticketFeesService) Get(ctx context.Context, id int64) (*ticketmatic.TicketFee, error) {
+	return ticketfees.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *ticketFeesService) Create(ctx context.Context, data *ticketmatic.TicketFee) (*ticketmatic.TicketFee, error) {
+	return ticketfees.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *ticketFeesService) Update(ctx context.Context, id int64, data *ticketmatic.TicketFee) (*ticketmatic.TicketFee, error) {
+	return ticketfees.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ticketFeesService) Delete(ctx context.Context, id int64) error {
+	return ticketfees.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the productcategories package
+type ProductCategoriesService interface {
+	// Get a list of product categories
+	Getlist(ctx context.Context, params *ticketmatic.ProductCategoryQuery) (*productcategories.List, error)
+
+	// Get a single product category
+	Get(ctx context.Context, id int64) (*ticketmatic.ProductCategory, error)
+
+	// Create a new product category
+	Create(ctx context.Context, data *ticketmatic.ProductCategory) (*ticketmatic.ProductCategory, error)
+
+	// Modify an existing product category
+	Update(ctx context.Context, id int64, data *ticketmatic.ProductCategory) (*ticketmatic.ProductCategory, error)
+
+	// Remove a product category
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type productCategoriesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) ProductCategories() ProductCategoriesService {
+	return &productCategoriesService{c.client}
+}
+
+func (s *productCategoriesService) Getlist(ctx context.Context, params *ticketmatic.ProductCategoryQuery) (*productcategories.List, error) {
+	return productcategories.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *productCategoriesService) Get(ctx context.Context, id int64) (*ticketmatic.ProductCategory, error) {
+	return productcategories.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *productCategoriesService) Create(ctx context.Context, data *ticketmatic.ProductCategory) (*ticketmatic.ProductCategory, error) {
+	return productcategories.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *productCategoriesService) Update(ctx context.Context, id int64, data *ticketmatic.ProductCategory) (*ticketmatic.ProductCategory, error) {
+	return productcategories.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *productCategoriesService) Delete(ctx context.Context, id int64) error {
+	return productcategories.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *productCategoriesService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return productcategories.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *productCategoriesService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return productcategories.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the products package
+type ProductsService interface {
+	// Get a list of products
+	Getlist(ctx context.Context, params *ticketmatic.ProductQuery) (*products.List, error)
+
+	// Get a single product
+	Get(ctx context.Context, id int64) (*ticketmatic.Product, error)
+
+	// Create a new product
+	Create(ctx context.Context, data *ticketmatic.Product) (*ticketmatic.Product, error)
+
+	// Modify an existing product
+	Update(ctx context.Context, id int64, data *ticketmatic.Product) (*ticketmatic.Product, error)
+
+	// Remove a product
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type productsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Products() ProductsService {
+	return &productsService{c.client}
+}
+
+func (s *productsService) Getlist(ctx context.Context, params *ticketmatic.ProductQuery) (*products.List, error) {
+	return products.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *productsService) Get(ctx context.Context, id int64) (*ticketmatic.Product, error) {
+	return products.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *productsService) Create(ctx context.Context, data *ticketmatic.Product) (*ticketmatic.Product, error) {
+	return products.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *productsService) Update(ctx context.Context, id int64, data *ticketmatic.Product) (*ticketmatic.Product, error) {
+	return products.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *productsService) Delete(ctx context.Context, id int64) error {
+	return products.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *productsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return products.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *productsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return products.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the seatingplans package
+type SeatingPlansService interface {
+	// Get a list of seating plans
+	Getlist(ctx context.Context, params *ticketmatic.SeatingPlanQuery) (*seatingplans.List, error)
+
+	// Get a single seating plan
+	Get(ctx context.Context, id int64) (*ticketmatic.SeatingPlan, error)
+
+	// Create a new seating plan
+	Create(ctx context.Context, data *ticketmatic.SeatingPlan) (*ticketmatic.SeatingPlan, error)
+
+	// Modify an existing seating plan
+	Update(ctx context.Context, id int64, data *ticketmatic.SeatingPlan) (*ticketmatic.SeatingPlan, error)
+
+	// Remove a seating plan
+	Delete(ctx context.Context, id int64) error
+
+	// Get the svg for a specific zone
+	Getsvg(ctx context.Context, id int64, zoneid string) (*bytes.Buffer, error)
+
+	// Update the svg for a specific zone
+	Savesvg(ctx context.Context, id int64, zoneid string, data string) (*bytes.Buffer, error)
+
+	// Get the lock templates
+	Getlocktemplates(ctx context.Context, id int64) ([]*ticketmatic.LockTemplate, error)
+
+	// Save the lock templates
+	Savelocktemplates(ctx context.Context, id int64, data []*ticketmatic.LockTemplate) ([]*ticketmatic.LockTemplate, error)
+
+	// Get the seat description templates
+	Getseatdescriptiontemplates(ctx context.Context, id int64) ([]*ticketmatic.SeatDescriptionTemplate, error)
+
+	// Save the seat description templates
+	Saveseatdescriptiontemplates(ctx context.Context, id int64, data []*ticketmatic.SeatDescriptionTemplate) ([]*ticketmatic.SeatDescriptionTemplate, error)
+
+	// Get the logical plan for a specific zone
+	Getlogicalplan(ctx context.Context, id int64, zoneid string) (*ticketmatic.LogicalPlan, error)
+
+	// Update the logical plan for this zone.
+	Savelogicalplan(ctx context.Context, id int64, zoneid string, data *ticketmatic.LogicalPlan) (*ticketmatic.LogicalPlan, error)
+
+	// Purge seatingplan
+	Purge(ctx context.Context, id int64) error
+}
+
+type seatingPlansService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) SeatingPlans() SeatingPlansService {
+	return &seatingPlansService{c.client}
+}
+
+func (s *seatingPlansService) Getlist(ctx context.Context, params *ticketmatic.SeatingPlanQuery) (*seatingplans.List, error) {
+	return seatingplans.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *seatingPlansService) Get(ctx context.Context, id int64) (*ticketmatic.SeatingPlan, error) {
+	return seatingplans.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *seatingPlansService) Create(ctx context.Context, data *ticketmatic.SeatingPlan) (*ticketmatic.SeatingPlan, error) {
+	return seatingplans.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *seatingPlansService) Update(ctx context.Context, id int64, data *ticketmatic.SeatingPlan) (*ticketmatic.SeatingPlan, error) {
+	return seatingplans.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *seatingPlansService) Delete(ctx context.Context, id int64) error {
+	return seatingplans.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *seatingPlansService) Getsvg(ctx context.Context, id int64, zoneid string) (*bytes.Buffer, error) {
+	return seatingplans.Getsvg(s.client.WithContext(ctx), id, zoneid)
+}
+
+func (s *seatingPlansService) Savesvg(ctx context.Context, id int64, zoneid string, data string) (*bytes.Buffer, error) {
+	return seatingplans.Savesvg(s.client.WithContext(ctx), id, zoneid, data)
+}
+
+func (s *seatingPlansService) Getlocktemplates(ctx context.Context, id int64) ([]*ticketmatic.LockTemplate, error) {
+	return seatingplans.Getlocktemplates(s.client.WithContext(ctx), id)
+}
+
+func (s *seatingPlansService) Savelocktemplates(ctx context.Context, id int64, data []*ticketmatic.LockTemplate) ([]*ticketmatic.LockTemplate, error) {
+	return seatingplans.Savelocktemplates(s.client.WithContext(ctx), id, data)
+}
+
+func (s *seatingPlansService) Getseatdescriptiontemplates(ctx context.Context, id int64) ([]*ticketmatic.SeatDescriptionTemplate, error) {
+	return seatingplans.Getseatdescriptiontemplates(s.client.WithContext(ctx), id)
+}
+
+func (s *seatingPlansService) Saveseatdescriptiontemplates(ctx context.Context, id int64, data []*ticketmatic.SeatDescriptionTemplate) ([]*ticketmatic.SeatDescriptionTemplate, error) {
+	return seatingplans.Saveseatdescriptiontemplates(s.client.WithContext(ctx), id, data)
+}
+
+func (s *seatingPlansService) Getlogicalplan(ctx context.Context, id int64, zoneid string) (*ticketmatic.LogicalPlan, error) {
+	return seatingplans.Getlogicalplan(s.client.WithContext(ctx), id, zoneid)
+}
+
+func (s *seatingPlansService) Savelogicalplan(ctx context.Context, id int64, zoneid string, data *ticketmatic.LogicalPlan) (*ticketmatic.LogicalPlan, error) {
+	return seatingplans.Savelogicalplan(s.client.WithContext(ctx), id, zoneid, data)
+}
+
+func (s *seatingPlansService) Purge(ctx context.Context, id int64) error {
+	return seatingplans.Purge(s.client.WithContext(ctx), id)
+}
+
+// Operations of the seatranks package
+type SeatRanksService interface {
+	// Get a list of seat ranks
+	Getlist(ctx context.Context, params *ticketmatic.SeatRankQuery) (*seatranks.List, error)
+
+	// Get a single seat rank
+	Get(ctx context.Context, id int64) (*ticketmatic.SeatRank, error)
+
+	// Create a new seat rank
+	Create(ctx context.Context, data *ticketmatic.SeatRank) (*ticketmatic.SeatRank, error)
+
+	// Modify an existing seat rank
+	Update(ctx context.Context, id int64, data *ticketmatic.SeatRank) (*ticketmatic.SeatRank, error)
+
+	// Remove a seat rank
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type seatRanksService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) SeatRanks() SeatRanksService {
+	return &seatRanksService{c.client}
+}
+
+func (s *seatRanksService) Getlist(ctx context.Context, params *ticketmatic.SeatRankQuery) (*seatranks.List, error) {
+	return seatranks.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *seatRanksService) Get(ctx context.Context, id int64) (*ticketmatic.SeatRank, error) {
+	return seatranks.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *seatRanksService) Create(ctx context.Context, data *ticketmatic.SeatRank) (*ticketmatic.SeatRank, error) {
+	return seatranks.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *seatRanksService) Update(ctx context.Context, id int64, data *ticketmatic.SeatRank) (*ticketmatic.SeatRank, error) {
+	return seatranks.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *seatRanksService) Delete(ctx context.Context, id int64) error {
+	return seatranks.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *seatRanksService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return seatranks.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *seatRanksService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return seatranks.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the contactaddresstypes package
+type ContactAddressTypesService interface {
+	// Get a list of contact address types
+	Getlist(ctx context.Context, params *ticketmatic.ContactAddressTypeQuery) (*contactaddresstypes.List, error)
+
+	// Get a single contact address type
+	Get(ctx context.Context, id int64) (*ticketmatic.ContactAddressType, error)
+
+	// Create a new contact address type
+	Create(ctx context.Context, data *ticketmatic.ContactAddressType) (*ticketmatic.ContactAddressType, error)
+
+	// Modify an existing contact address type
+	Update(ctx context.Context, id int64, data *ticketmatic.ContactAddressType) (*ticketmatic.ContactAddressType, error)
+
+	// Remove a contact address type
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type contactAddressTypesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) ContactAddressTypes() ContactAddressTypesService {
+	return &contactAddressTypesService{c.client}
+}
+
+func (s *contactAddressTypesService) Getlist(ctx context.Context, params *ticketmatic.ContactAddressTypeQuery) (*contactaddresstypes.List, error) {
+	return contactaddresstypes.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *contactAddressTypesService) Get(ctx context.Context, id int64) (*ticketmatic.ContactAddressType, error) {
+	return contactaddresstypes.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *contactAddressTypesService) Create(ctx context.Context, data *ticketmatic.ContactAddressType) (*ticketmatic.ContactAddressType, error) {
+	return contactaddresstypes.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *contactAddressTypesService) Update(ctx context.Context, id int64, data *ticketmatic.ContactAddressType) (*ticketmatic.ContactAddressType, error) {
+	return contactaddresstypes.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *contactAddressTypesService) Delete(ctx context.Context, id int64) error {
+	return contactaddresstypes.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *contactAddressTypesService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return contactaddresstypes.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *contactAddressTypesService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return contactaddresstypes.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the contactfields package
+type ContactFieldsService interface {
+	// Get the contact fields
+	Getlist(ctx context.Context) (*contactfields.List, error)
+
+	// Get the contact field
+	Get(ctx context.Context, id int64) (*ticketmatic.ContactField, error)
+
+	// Get the translations for this field
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update the translations for this field
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type contactFieldsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) ContactFields() ContactFieldsService {
+	return &contactFieldsService{c.client}
+}
+
+func (s *contactFieldsService) Getlist(ctx context.Context) (*contactfields.List, error) {
+	return contactfields.Getlist(s.client.WithContext(ctx))
+}
+
+func (s *contactFieldsService) Get(ctx context.Context, id int64) (*ticketmatic.ContactField, error) {
+	return contactfields.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *contactFieldsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return contactfields.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *contactFieldsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return contactfields.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the contacttitles package
+type ContactTitlesService interface {
+	// Get a list of contact titles
+	Getlist(ctx context.Context, params *ticketmatic.ContactTitleQuery) (*contacttitles.List, error)
+
+	// Get a single contact title
+	Get(ctx context.Context, id int64) (*ticketmatic.ContactTitle, error)
+
+	// Create a new contact title
+	Create(ctx context.Context, data *ticketmatic.ContactTitle) (*ticketmatic.ContactTitle, error)
+
+	// Modify an existing contact title
+	Update(ctx context.Context, id int64, data *ticketmatic.ContactTitle) (*ticketmatic.ContactTitle, error)
+
+	// Remove a contact title
+	Delete(ctx context.Context, id int64) error
+}
+
+type contactTitlesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) ContactTitles() ContactTitlesService {
+	return &contactTitlesService{c.client}
+}
+
+func (s *contactTitlesService) Getlist(ctx context.Context, params *ticketmatic.ContactTitleQuery) (*contacttitles.List, error) {
+	return contacttitles.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *contactTitlesService) Get(ctx context.Context, id int64) (*ticketmatic.ContactTitle, error) {
+	return contacttitles.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *contactTitlesService) Create(ctx context.Context, data *ticketmatic.ContactTitle) (*ticketmatic.ContactTitle, error) {
+	return contacttitles.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *contactTitlesService) Update(ctx context.Context, id int64, data *ticketmatic.ContactTitle) (*ticketmatic.ContactTitle, error) {
+	return contacttitles.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *contactTitlesService) Delete(ctx context.Context, id int64) error {
+	return contacttitles.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the customfields package
+type CustomFieldsService interface {
+	// Get a list of custom fields
+	Getlist(ctx context.Context, params *ticketmatic.CustomFieldQuery) (*customfields.List, error)
+
+	// Get a single custom field
+	Get(ctx context.Context, id int64) (*ticketmatic.CustomField, error)
+
+	// Create a new custom field
+	Create(ctx context.Context, data *ticketmatic.CustomField) (*ticketmatic.CustomField, error)
+
+	// Modify an existing custom field
+	Update(ctx context.Context, id int64, data *ticketmatic.CustomField) (*ticketmatic.CustomField, error)
+
+	// Remove a custom field
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type customFieldsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) CustomFields() CustomFieldsService {
+	return &customFieldsService{c.client}
+}
+
+func (s *customFieldsService) Getlist(ctx context.Context, params *ticketmatic.CustomFieldQuery) (*customfields.List, error) {
+	return customfields.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *customFieldsService) Get(ctx context.Context, id int64) (*ticketmatic.CustomField, error) {
+	return customfields.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *customFieldsService) Create(ctx context.Context, data *ticketmatic.CustomField) (*ticketmatic.CustomField, error) {
+	return customfields.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *customFieldsService) Update(ctx context.Context, id int64, data *ticketmatic.CustomField) (*ticketmatic.CustomField, error) {
+	return customfields.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *customFieldsService) Delete(ctx context.Context, id int64) error {
+	return customfields.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *customFieldsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return customfields.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *customFieldsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return customfields.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the customfieldvalues package
+type CustomFieldValuesService interface {
+	// Get a list of custom field values
+	Getlist(ctx context.Context, params *ticketmatic.CustomFieldValueQuery) (*customfieldvalues.List, error)
+
+	// Get a single custom field value
+	Get(ctx context.Context, id int64) (*ticketmatic.CustomFieldValue, error)
+
+	// Create a new custom field value
+	Create(ctx context.Context, data *ticketmatic.CustomFieldValue) (*ticketmatic.CustomFieldValue, error)
+
+	// Modify an existing custom field value
+	Update(ctx context.Context, id int64, data *ticketmatic.CustomFieldValue) (*ticketmatic.CustomFieldValue, error)
+
+	// Remove a custom field value
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type customFieldValuesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) CustomFieldValues() CustomFieldValuesService {
+	return &customFieldValuesService{c.client}
+}
+
+func (s *customFieldValuesService) Getlist(ctx context.Context, params *ticketmatic.CustomFieldValueQuery) (*customfieldvalues.List, error) {
+	return customfieldvalues.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *customFieldValuesService) Get(ctx context.Context, id int64) (*ticketmatic.CustomFieldValue, error) {
+	return customfieldvalues.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *customFieldValuesService) Create(ctx context.Context, data *ticketmatic.CustomFieldValue) (*ticketmatic.CustomFieldValue, error) {
+	return customfieldvalues.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *customFieldValuesService) Update(ctx context.Context, id int64, data *ticketmatic.CustomFieldValue) (*ticketmatic.CustomFieldValue, error) {
+	return customfieldvalues.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *customFieldValuesService) Delete(ctx context.Context, id int64) error {
+	return customfieldvalues.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *customFieldValuesService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return customfieldvalues.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *customFieldValuesService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return customfieldvalues.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the dupedetectrules package
+type DupeDetectRulesService interface {
+	// Get a list of dupe detect rules
+	Getlist(ctx context.Context, params *ticketmatic.DupeDetectRuleQuery) (*dupedetectrules.List, error)
+
+	// Get a single dupe detect rule
+	Get(ctx context.Context, id int64) (*ticketmatic.DupeDetectRule, error)
+
+	// Create a new dupe detect rule
+	Create(ctx context.Context, data *ticketmatic.DupeDetectRule) (*ticketmatic.DupeDetectRule, error)
+
+	// Modify an existing dupe detect rule
+	Update(ctx context.Context, id int64, data *ticketmatic.DupeDetectRule) (*ticketmatic.DupeDetectRule, error)
+
+	// Remove a dupe detect rule
+	Delete(ctx context.Context, id int64) error
+}
+
+type dupeDetectRulesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) DupeDetectRules() DupeDetectRulesService {
+	return &dupeDetectRulesService{c.client}
+}
+
+func (s *dupeDetectRulesService) Getlist(ctx context.Context, params *ticketmatic.DupeDetectRuleQuery) (*dupedetectrules.List, error) {
+	return dupedetectrules.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *dupeDetectRulesService) Get(ctx context.Context, id int64) (*ticketmatic.DupeDetectRule, error) {
+	return dupedetectrules.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *dupeDetectRulesService) Create(ctx context.Context, data *ticketmatic.DupeDetectRule) (*ticketmatic.DupeDetectRule, error) {
+	return dupedetectrules.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *dupeDetectRulesService) Update(ctx context.Context, id int64, data *ticketmatic.DupeDetectRule) (*ticketmatic.DupeDetectRule, error) {
+	return dupedetectrules.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *dupeDetectRulesService) Delete(ctx context.Context, id int64) error {
+	return dupedetectrules.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the fielddefinitions package
+type FieldDefinitionsService interface {
+	// Get a list of field definitions
+	Getlist(ctx context.Context, params *ticketmatic.FieldDefinitionQuery) (*fielddefinitions.List, error)
+
+	// Get a single field definition
+	Get(ctx context.Context, id int64) (*ticketmatic.FieldDefinition, error)
+
+	// Create a new field definition
+	Create(ctx context.Context, data *ticketmatic.FieldDefinition) (*ticketmatic.FieldDefinition, error)
+
+	// Modify an existing field definition
+	Update(ctx context.Context, id int64, data *ticketmatic.FieldDefinition) (*ticketmatic.FieldDefinition, error)
+
+	// Remove a field definition
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+
+	// Get data for field definitions
+	Getdata(ctx context.Context, data *ticketmatic.FielddefinitionsDataRequest) ([]*ticketmatic.FielddefinitionsDataResult, error)
+}
+
+type fieldDefinitionsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) FieldDefinitions() FieldDefinitionsService {
+	return &fieldDefinitionsService{c.client}
+}
+
+func (s *fieldDefinitionsService) Getlist(ctx context.Context, params *ticketmatic.FieldDefinitionQuery) (*fielddefinitions.List, error) {
+	return fielddefinitions.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *fieldDefinitionsService) Get(ctx context.Context, id int64) (*ticketmatic.FieldDefinition, error) {
+	return fielddefinitions.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *fieldDefinitionsService) Create(ctx context.Context, data *ticketmatic.FieldDefinition) (*ticketmatic.FieldDefinition, error) {
+	return fielddefinitions.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *fieldDefinitionsService) Update(ctx context.Context, id int64, data *ticketmatic.FieldDefinition) (*ticketmatic.FieldDefinition, error) {
+	return fielddefinitions.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *fieldDefinitionsService) Delete(ctx context.Context, id int64) error {
+	return fielddefinitions.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *fieldDefinitionsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return fielddefinitions.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *fieldDefinitionsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return fielddefinitions.Translate(s.client.WithContext(ctx), id, data)
+}
+
+func (s *fieldDefinitionsService) Getdata(ctx context.Context, data *ticketmatic.FielddefinitionsDataRequest) ([]*ticketmatic.FielddefinitionsDataResult, error) {
+	return fielddefinitions.Getdata(s.client.WithContext(ctx), data)
+}
+
+// Operations of the filterdefinitions package
+type FilterDefinitionsService interface {
+	// Get a list of filter definitions
+	Getlist(ctx context.Context, params *ticketmatic.FilterDefinitionQuery) (*filterdefinitions.List, error)
+
+	// Get a single filter definition
+	Get(ctx context.Context, id int64) (*ticketmatic.FilterDefinition, error)
+
+	// Create a new filter definition
+	Create(ctx context.Context, data *ticketmatic.FilterDefinition) (*ticketmatic.FilterDefinition, error)
+
+	// Modify an existing filter definition
+	Update(ctx context.Context, id int64, data *ticketmatic.FilterDefinition) (*ticketmatic.FilterDefinition, error)
+
+	// Remove a filter definition
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type filterDefinitionsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) FilterDefinitions() FilterDefinitionsService {
+	return &filterDefinitionsService{c.client}
+}
+
+func (s *filterDefinitionsService) Getlist(ctx context.Context, params *ticketmatic.FilterDefinitionQuery) (*filterdefinitions.List, error) {
+	return filterdefinitions.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *filterDefinitionsService) Get(ctx context.Context, id int64) (*ticketmatic.FilterDefinition, error) {
+	return filterdefinitions.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *filterDefinitionsService) Create(ctx context.Context, data *ticketmatic.FilterDefinition) (*ticketmatic.FilterDefinition, error) {
+	return filterdefinitions.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *filterDefinitionsService) Update(ctx context.Context, id int64, data *ticketmatic.FilterDefinition) (*ticketmatic.FilterDefinition, error) {
+	return filterdefinitions.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *filterDefinitionsService) Delete(ctx context.Context, id int64) error {
+	return filterdefinitions.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *filterDefinitionsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return filterdefinitions.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *filterDefinitionsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return filterdefinitions.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the optins package
+type OptInsService interface {
+	// Get a list of opt ins
+	Getlist(ctx context.Context, params *ticketmatic.OptInQuery) (*optins.List, error)
+
+	// Get a single opt in
+	Get(ctx context.Context, id int64) (*ticketmatic.OptIn, error)
+
+	// Create a new opt in
+	Create(ctx context.Context, data *ticketmatic.OptIn) (*ticketmatic.OptIn, error)
+
+	// Modify an existing opt in
+	Update(ctx context.Context, id int64, data *ticketmatic.OptIn) (*ticketmatic.OptIn, error)
+
+	// Remove an opt in
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type optInsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) OptIns() OptInsService {
+	return &optInsService{c.client}
+}
+
+func (s *optInsService) Getlist(ctx context.Context, params *ticketmatic.OptInQuery) (*optins.List, error) {
+	return optins.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *optInsService) Get(ctx context.Context, id int64) (*ticketmatic.OptIn, error) {
+	return optins.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *optInsService) Create(ctx context.Context, data *ticketmatic.OptIn) (*ticketmatic.OptIn, error) {
+	return optins.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *optInsService) Update(ctx context.Context, id int64, data *ticketmatic.OptIn) (*ticketmatic.OptIn, error) {
+	return optins.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *optInsService) Delete(ctx context.Context, id int64) error {
+	return optins.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *optInsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return optins.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *optInsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return optins.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the phonenumbertypes package
+type PhoneNumberTypesService interface {
+	// Get a list of phone number types
+	Getlist(ctx context.Context, params *ticketmatic.PhoneNumberTypeQuery) (*phonenumbertypes.List, error)
+
+	// Get a single phone number type
+	Get(ctx context.Context, id int64) (*ticketmatic.PhoneNumberType, error)
+
+	// Create a new phone number type
+	Create(ctx context.Context, data *ticketmatic.PhoneNumberType) (*ticketmatic.PhoneNumberType, error)
+
+	// Modify an existing phone number type
+	Update(ctx context.Context, id int64, data *ticketmatic.PhoneNumberType) (*ticketmatic.PhoneNumberType, error)
+
+	// Remove a phone number type
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type phoneNumberTypesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) PhoneNumberTypes() PhoneNumberTypesService {
+	return &phoneNumberTypesService{c.client}
+}
+
+func (s *phoneNumberTypesService) Getlist(ctx context.Context, params *ticketmatic.PhoneNumberTypeQuery) (*phonenumbertypes.List, error) {
+	return phonenumbertypes.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *phoneNumberTypesService) Get(ctx context.Context, id int64) (*ticketmatic.PhoneNumberType, error) {
+	return phonenumbertypes.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *phoneNumberTypesService) Create(ctx context.Context, data *ticketmatic.PhoneNumberType) (*ticketmatic.PhoneNumberType, error) {
+	return phonenumbertypes.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *phoneNumberTypesService) Update(ctx context.Context, id int64, data *ticketmatic.PhoneNumberType) (*ticketmatic.PhoneNumberType, error) {
+	return phonenumbertypes.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *phoneNumberTypesService) Delete(ctx context.Context, id int64) error {
+	return phonenumbertypes.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *phoneNumberTypesService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return phonenumbertypes.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *phoneNumberTypesService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return phonenumbertypes.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the relationtypes package
+type RelationTypesService interface {
+	// Get a list of relation types
+	Getlist(ctx context.Context, params *ticketmatic.RelationTypeQuery) (*relationtypes.List, error)
+
+	// Get a single relation type
+	Get(ctx context.Context, id int64) (*ticketmatic.RelationType, error)
+
+	// Create a new relation type
+	Create(ctx context.Context, data *ticketmatic.RelationType) (*ticketmatic.RelationType, error)
+
+	// Modify an existing relation type
+	Update(ctx context.Context, id int64, data *ticketmatic.RelationType) (*ticketmatic.RelationType, error)
+
+	// Remove a relation type
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type relationTypesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) RelationTypes() RelationTypesService {
+	return &relationTypesService{c.client}
+}
+
+func (s *relationTypesService) Getlist(ctx context.Context, params *ticketmatic.RelationTypeQuery) (*relationtypes.List, error) {
+	return relationtypes.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *relationTypesService) Get(ctx context.Context, id int64) (*ticketmatic.RelationType, error) {
+	return relationtypes.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *relationTypesService) Create(ctx context.Context, data *ticketmatic.RelationType) (*ticketmatic.RelationType, error) {
+	return relationtypes.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *relationTypesService) Update(ctx context.Context, id int64, data *ticketmatic.RelationType) (*ticketmatic.RelationType, error) {
+	return relationtypes.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *relationTypesService) Delete(ctx context.Context, id int64) error {
+	return relationtypes.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *relationTypesService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return relationtypes.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *relationTypesService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return relationtypes.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the reports package
+type ReportsService interface {
+	// Get a list of reports
+	Getlist(ctx context.Context, params *ticketmatic.ReportQuery) (*reports.List, error)
+
+	// Get a single report
+	Get(ctx context.Context, id int64) (*ticketmatic.Report, error)
+
+	// Create a new report
+	Create(ctx context.Context, data *ticketmatic.Report) (*ticketmatic.Report, error)
+
+	// Modify an existing report
+	Update(ctx context.Context, id int64, data *ticketmatic.Report) (*ticketmatic.Report, error)
+
+	// Remove a report
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type reportsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Reports() ReportsService {
+	return &reportsService{c.client}
+}
+
+func (s *reportsService) Getlist(ctx context.Context, params *ticketmatic.ReportQuery) (*reports.List, error) {
+	return reports.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *reportsService) Get(ctx context.Context, id int64) (*ticketmatic.Report, error) {
+	return reports.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *reportsService) Create(ctx context.Context, data *ticketmatic.Report) (*ticketmatic.Report, error) {
+	return reports.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *reportsService) Update(ctx context.Context, id int64, data *ticketmatic.Report) (*ticketmatic.Report, error) {
+	return reports.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *reportsService) Delete(ctx context.Context, id int64) error {
+	return reports.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *reportsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return reports.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *reportsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return reports.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the ticketsalesflows package
+type TicketSalesFlowsService interface {
+	// Get a list of ticketsalesflows
+	Getlist(ctx context.Context, params *ticketmatic.TicketsalesflowQuery) (*ticketsalesflows.List, error)
+
+	// Get a single ticketsalesflow
+	Get(ctx context.Context, id int64) (*ticketmatic.Ticketsalesflow, error)
+
+	// Create a new ticketsalesflow
+	Create(ctx context.Context, data *ticketmatic.Ticketsalesflow) (*ticketmatic.Ticketsalesflow, error)
+
+	// Modify an existing ticketsalesflow
+	Update(ctx context.Context, id int64, data *ticketmatic.Ticketsalesflow) (*ticketmatic.Ticketsalesflow, error)
+
+	// Remove a ticketsalesflow
+	Delete(ctx context.Context, id int64) error
+
+	// Create a flow session
+	Flowsession(ctx context.Context, data *ticketmatic.Flowsession) (string, error)
+
+	// Get info on a flow
+	Flowinfo(ctx context.Context, token string) (*ticketmatic.Flowinfo, error)
+}
+
+type ticketSalesFlowsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) TicketSalesFlows() TicketSalesFlowsService {
+	return &ticketSalesFlowsService{c.client}
+}
+
+func (s *ticketSalesFlowsService) Getlist(ctx context.Context, params *ticketmatic.TicketsalesflowQuery) (*ticketsalesflows.List, error) {
+	return ticketsalesflows.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *ticketSalesFlowsService) Get(ctx context.Context, id int64) (*ticketmatic.Ticketsalesflow, error) {
+	return ticketsalesflows.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *ticketSalesFlowsService) Create(ctx context.Context, data *ticketmatic.Ticketsalesflow) (*ticketmatic.Ticketsalesflow, error) {
+	return ticketsalesflows.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *ticketSalesFlowsService) Update(ctx context.Context, id int64, data *ticketmatic.Ticketsalesflow) (*ticketmatic.Ticketsalesflow, error) {
+	return ticketsalesflows.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ticketSalesFlowsService) Delete(ctx context.Context, id int64) error {
+	return ticketsalesflows.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *ticketSalesFlowsService) Flowsession(ctx context.Context, data *ticketmatic.Flowsession) (string, error) {
+	return ticketsalesflows.Flowsession(s.client.WithContext(ctx), data)
+}
+
+func (s *ticketSalesFlowsService) Flowinfo(ctx context.Context, token string) (*ticketmatic.Flowinfo, error) {
+	return ticketsalesflows.Flowinfo(s.client.WithContext(ctx), token)
+}
+
+// Operations of the ticketsalessetups package
+type TicketSalesSetupsService interface {
+	// Get a list of ticketsalessetups
+	Getlist(ctx context.Context, params *ticketmatic.TicketsalessetupQuery) (*ticketsalessetups.List, error)
+
+	// Get a single ticketsalessetup
+	Get(ctx context.Context, id int64) (*ticketmatic.Ticketsalessetup, error)
+
+	// Create a new ticketsalessetup
+	Create(ctx context.Context, data *ticketmatic.Ticketsalessetup) (*ticketmatic.Ticketsalessetup, error)
+
+	// Modify an existing ticketsalessetup
+	Update(ctx context.Context, id int64, data *ticketmatic.Ticketsalessetup) (*ticketmatic.Ticketsalessetup, error)
+
+	// Remove a ticketsalessetup
+	Delete(ctx context.Context, id int64) error
+}
+
+type ticketSalesSetupsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) TicketSalesSetups() TicketSalesSetupsService {
+	return &ticketSalesSetupsService{c.client}
+}
+
+func (s *ticketSalesSetupsService) Getlist(ctx context.Context, params *ticketmatic.TicketsalessetupQuery) (*ticketsalessetups.List, error) {
+	return ticketsalessetups.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *ticketSalesSetupsService) Get(ctx context.Context, id int64) (*ticketmatic.Ticketsalessetup, error) {
+	return ticketsalessetups.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *ticketSalesSetupsService) Create(ctx context.Context, data *ticketmatic.Ticketsalessetup) (*ticketmatic.Ticketsalessetup, error) {
+	return ticketsalessetups.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *ticketSalesSetupsService) Update(ctx context.Context, id int64, data *ticketmatic.Ticketsalessetup) (*ticketmatic.Ticketsalessetup, error) {
+	return ticketsalessetups.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *ticketSalesSetupsService) Delete(ctx context.Context, id int64) error {
+	return ticketsalessetups.Delete(s.client.WithContext(ctx), id)
+}
+
+// Operations of the views package
+type ViewsService interface {
+	// Get a list of views
+	Getlist(ctx context.Context, params *ticketmatic.ViewQuery) (*views.List, error)
+
+	// Get a single view
+	Get(ctx context.Context, id int64) (*ticketmatic.View, error)
+
+	// Create a new view
+	Create(ctx context.Context, data *ticketmatic.View) (*ticketmatic.View, error)
+
+	// Modify an existing view
+	Update(ctx context.Context, id int64, data *ticketmatic.View) (*ticketmatic.View, error)
+
+	// Remove a view
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type viewsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Views() ViewsService {
+	return &viewsService{c.client}
+}
+
+func (s *viewsService) Getlist(ctx context.Context, params *ticketmatic.ViewQuery) (*views.List, error) {
+	return views.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *viewsService) Get(ctx context.Context, id int64) (*ticketmatic.View, error) {
+	return views.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *viewsService) Create(ctx context.Context, data *ticketmatic.View) (*ticketmatic.View, error) {
+	return views.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *viewsService) Update(ctx context.Context, id int64, data *ticketmatic.View) (*ticketmatic.View, error) {
+	return views.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *viewsService) Delete(ctx context.Context, id int64) error {
+	return views.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *viewsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return views.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *viewsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return views.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the deliveryscenarios package
+type DeliveryScenariosService interface {
+	// Get a list of delivery scenarios
+	Getlist(ctx context.Context, params *ticketmatic.DeliveryScenarioQuery) (*deliveryscenarios.List, error)
+
+	// Get a single delivery scenario
+	Get(ctx context.Context, id int64) (*ticketmatic.DeliveryScenario, error)
+
+	// Create a new delivery scenario
+	Create(ctx context.Context, data *ticketmatic.DeliveryScenario) (*ticketmatic.DeliveryScenario, error)
+
+	// Modify an existing delivery scenario
+	Update(ctx context.Context, id int64, data *ticketmatic.DeliveryScenario) (*ticketmatic.DeliveryScenario, error)
+
+	// Remove a delivery scenario
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type deliveryScenariosService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) DeliveryScenarios() DeliveryScenariosService {
+	return &deliveryScenariosService{c.client}
+}
+
+func (s *deliveryScenariosService) Getlist(ctx context.Context, params *ticketmatic.DeliveryScenarioQuery) (*deliveryscenarios.List, error) {
+	return deliveryscenarios.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *deliveryScenariosService) Get(ctx context.Context, id int64) (*ticketmatic.DeliveryScenario, error) {
+	return deliveryscenarios.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *deliveryScenariosService) Create(ctx context.Context, data *ticketmatic.DeliveryScenario) (*ticketmatic.DeliveryScenario, error) {
+	return deliveryscenarios.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *deliveryScenariosService) Update(ctx context.Context, id int64, data *ticketmatic.DeliveryScenario) (*ticketmatic.DeliveryScenario, error) {
+	return deliveryscenarios.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *deliveryScenariosService) Delete(ctx context.Context, id int64) error {
+	return deliveryscenarios.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *deliveryScenariosService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return deliveryscenarios.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *deliveryScenariosService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return deliveryscenarios.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the locktypes package
+type LockTypesService interface {
+	// Get a list of lock types
+	Getlist(ctx context.Context, params *ticketmatic.LockTypeQuery) (*locktypes.List, error)
+
+	// Get a single lock type
+	Get(ctx context.Context, id int64) (*ticketmatic.LockType, error)
+
+	// Create a new lock type
+	Create(ctx context.Context, data *ticketmatic.LockType) (*ticketmatic.LockType, error)
+
+	// Modify an existing lock type
+	Update(ctx context.Context, id int64, data *ticketmatic.LockType) (*ticketmatic.LockType, error)
+
+	// Remove a lock type
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type lockTypesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) LockTypes() LockTypesService {
+	return &lockTypesService{c.client}
+}
+
+func (s *lockTypesService) Getlist(ctx context.Context, params *ticketmatic.LockTypeQuery) (*locktypes.List, error) {
+	return locktypes.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *lockTypesService) Get(ctx context.Context, id int64) (*ticketmatic.LockType, error) {
+	return locktypes.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *lockTypesService) Create(ctx context.Context, data *ticketmatic.LockType) (*ticketmatic.LockType, error) {
+	return locktypes.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *lockTypesService) Update(ctx context.Context, id int64, data *ticketmatic.LockType) (*ticketmatic.LockType, error) {
+	return locktypes.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *lockTypesService) Delete(ctx context.Context, id int64) error {
+	return locktypes.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *lockTypesService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return locktypes.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *lockTypesService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return locktypes.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the orderfees package
+type OrderFeesService interface {
+	// Get a list of order fees
+	Getlist(ctx context.Context, params *ticketmatic.OrderFeeQuery) (*orderfees.List, error)
+
+	// Get a single order fee
+	Get(ctx context.Context, id int64) (*ticketmatic.OrderFee, error)
+
+	// Create a new order fee
+	Create(ctx context.Context, data *ticketmatic.OrderFee) (*ticketmatic.OrderFee, error)
+
+	// Remove an order fee
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type orderFeesService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) OrderFees() OrderFeesService {
+	return &orderFeesService{c.client}
+}
+
+func (s *orderFeesService) Getlist(ctx context.Context, params *ticketmatic.OrderFeeQuery) (*orderfees.List, error) {
+	return orderfees.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *orderFeesService) Get(ctx context.Context, id int64) (*ticketmatic.OrderFee, error) {
+	return orderfees.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *orderFeesService) Create(ctx context.Context, data *ticketmatic.OrderFee) (*ticketmatic.OrderFee, error) {
+	return orderfees.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *orderFeesService) Delete(ctx context.Context, id int64) error {
+	return orderfees.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *orderFeesService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return orderfees.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *orderFeesService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return orderfees.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the paymentmethods package
+type PaymentMethodsService interface {
+	// Get a list of payment methods
+	Getlist(ctx context.Context, params *ticketmatic.PaymentMethodQuery) (*paymentmethods.List, error)
+
+	// Get a single payment method
+	Get(ctx context.Context, id int64) (*ticketmatic.PaymentMethod, error)
+
+	// Create a new payment method
+	Create(ctx context.Context, data *ticketmatic.PaymentMethod) (*ticketmatic.PaymentMethod, error)
+
+	// Modify an existing payment method
+	Update(ctx context.Context, id int64, data *ticketmatic.PaymentMethod) (*ticketmatic.PaymentMethod, error)
+
+	// Remove a payment method
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type paymentMethodsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) PaymentMethods() PaymentMethodsService {
+	return &paymentMethodsService{c.client}
+}
+
+func (s *paymentMethodsService) Getlist(ctx context.Context, params *ticketmatic.PaymentMethodQuery) (*paymentmethods.List, error) {
+	return paymentmethods.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *paymentMethodsService) Get(ctx context.Context, id int64) (*ticketmatic.PaymentMethod, error) {
+	return paymentmethods.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *paymentMethodsService) Create(ctx context.Context, data *ticketmatic.PaymentMethod) (*ticketmatic.PaymentMethod, error) {
+	return paymentmethods.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *paymentMethodsService) Update(ctx context.Context, id int64, data *ticketmatic.PaymentMethod) (*ticketmatic.PaymentMethod, error) {
+	return paymentmethods.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *paymentMethodsService) Delete(ctx context.Context, id int64) error {
+	return paymentmethods.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *paymentMethodsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return paymentmethods.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *paymentMethodsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return paymentmethods.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the paymentscenarios package
+type PaymentScenariosService interface {
+	// Get a list of payment scenarios
+	Getlist(ctx context.Context, params *ticketmatic.PaymentScenarioQuery) (*paymentscenarios.List, error)
+
+	// Get a single payment scenario
+	Get(ctx context.Context, id int64) (*ticketmatic.PaymentScenario, error)
+
+	// Create a new payment scenario
+	Create(ctx context.Context, data *ticketmatic.PaymentScenario) (*ticketmatic.PaymentScenario, error)
+
+	// Modify an existing payment scenario
+	Update(ctx context.Context, id int64, data *ticketmatic.PaymentScenario) (*ticketmatic.PaymentScenario, error)
+
+	// Remove a payment scenario
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type paymentScenariosService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) PaymentScenarios() PaymentScenariosService {
+	return &paymentScenariosService{c.client}
+}
+
+func (s *paymentScenariosService) Getlist(ctx context.Context, params *ticketmatic.PaymentScenarioQuery) (*paymentscenarios.List, error) {
+	return paymentscenarios.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *paymentScenariosService) Get(ctx context.Context, id int64) (*ticketmatic.PaymentScenario, error) {
+	return paymentscenarios.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *paymentScenariosService) Create(ctx context.Context, data *ticketmatic.PaymentScenario) (*ticketmatic.PaymentScenario, error) {
+	return paymentscenarios.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *paymentScenariosService) Update(ctx context.Context, id int64, data *ticketmatic.PaymentScenario) (*ticketmatic.PaymentScenario, error) {
+	return paymentscenarios.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *paymentScenariosService) Delete(ctx context.Context, id int64) error {
+	return paymentscenarios.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *paymentScenariosService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return paymentscenarios.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *paymentScenariosService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return paymentscenarios.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the saleschannels package
+type SalesChannelsService interface {
+	// Get a list of sales channels
+	Getlist(ctx context.Context, params *ticketmatic.SalesChannelQuery) (*saleschannels.List, error)
+
+	// Get a single sales channel
+	Get(ctx context.Context, id int64) (*ticketmatic.SalesChannel, error)
+
+	// Create a new sales channel
+	Create(ctx context.Context, data *ticketmatic.SalesChannel) (*ticketmatic.SalesChannel, error)
+
+	// Modify an existing sales channel
+	Update(ctx context.Context, id int64, data *ticketmatic.SalesChannel) (*ticketmatic.SalesChannel, error)
+
+	// Remove a sales channel
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+}
+
+type salesChannelsService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) SalesChannels() SalesChannelsService {
+	return &salesChannelsService{c.client}
+}
+
+func (s *salesChannelsService) Getlist(ctx context.Context, params *ticketmatic.SalesChannelQuery) (*saleschannels.List, error) {
+	return saleschannels.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *salesChannelsService) Get(ctx context.Context, id int64) (*ticketmatic.SalesChannel, error) {
+	return saleschannels.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *salesChannelsService) Create(ctx context.Context, data *ticketmatic.SalesChannel) (*ticketmatic.SalesChannel, error) {
+	return saleschannels.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *salesChannelsService) Update(ctx context.Context, id int64, data *ticketmatic.SalesChannel) (*ticketmatic.SalesChannel, error) {
+	return saleschannels.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *salesChannelsService) Delete(ctx context.Context, id int64) error {
+	return saleschannels.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *salesChannelsService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return saleschannels.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *salesChannelsService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return saleschannels.Translate(s.client.WithContext(ctx), id, data)
+}
+
+// Operations of the vouchers package
+type VouchersService interface {
+	// Get a list of vouchers
+	Getlist(ctx context.Context, params *ticketmatic.VoucherQuery) (*vouchers.List, error)
+
+	// Get a single voucher
+	Get(ctx context.Context, id int64) (*ticketmatic.Voucher, error)
+
+	// Create a new voucher
+	Create(ctx context.Context, data *ticketmatic.Voucher) (*ticketmatic.Voucher, error)
+
+	// Modify an existing voucher
+	Update(ctx context.Context, id int64, data *ticketmatic.Voucher) (*ticketmatic.Voucher, error)
+
+	// Remove a voucher
+	Delete(ctx context.Context, id int64) error
+
+	// Fetch translatable fields
+	Translations(ctx context.Context, id int64) (map[string]string, error)
+
+	// Update translations
+	Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error)
+
+	// Create voucher codes
+	Createcodes(ctx context.Context, id int64, data *ticketmatic.AddVoucherCodes) ([]*ticketmatic.VoucherCode, error)
+
+	// Deactivate voucher codes
+	Deactivatecodes(ctx context.Context, id int64, data []*ticketmatic.VoucherCode) error
+}
+
+type vouchersService struct {
+	client *ticketmatic.Client
+}
+
+func (c *Client) Vouchers() VouchersService {
+	return &vouchersService{c.client}
+}
+
+func (s *vouchersService) Getlist(ctx context.Context, params *ticketmatic.VoucherQuery) (*vouchers.List, error) {
+	return vouchers.Getlist(s.client.WithContext(ctx), params)
+}
+
+func (s *vouchersService) Get(ctx context.Context, id int64) (*ticketmatic.Voucher, error) {
+	return vouchers.Get(s.client.WithContext(ctx), id)
+}
+
+func (s *vouchersService) Create(ctx context.Context, data *ticketmatic.Voucher) (*ticketmatic.Voucher, error) {
+	return vouchers.Create(s.client.WithContext(ctx), data)
+}
+
+func (s *vouchersService) Update(ctx context.Context, id int64, data *ticketmatic.Voucher) (*ticketmatic.Voucher, error) {
+	return vouchers.Update(s.client.WithContext(ctx), id, data)
+}
+
+func (s *vouchersService) Delete(ctx context.Context, id int64) error {
+	return vouchers.Delete(s.client.WithContext(ctx), id)
+}
+
+func (s *vouchersService) Translations(ctx context.Context, id int64) (map[string]string, error) {
+	return vouchers.Translations(s.client.WithContext(ctx), id)
+}
+
+func (s *vouchersService) Translate(ctx context.Context, id int64, data map[string]string) (map[string]string, error) {
+	return vouchers.Translate(s.client.WithContext(ctx), id, data)
+}
+
+func (s *vouchersService) Createcodes(ctx context.Context, id int64, data *ticketmatic.AddVoucherCodes) ([]*ticketmatic.VoucherCode, error) {
+	return vouchers.Createcodes(s.client.WithContext(ctx), id, data)
+}
+
+func (s *vouchersService) Deactivatecodes(ctx context.Context, id int64, data []*ticketmatic.VoucherCode) error {
+	return vouchers.Deactivatecodes(s.client.WithContext(ctx), id, data)
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestServices(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/test/orders/12" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"orderid":12,"code":"ABC"}`))
+	}))
+	defer srv.Close()
+
+	c := ticketmatic.NewClient("test", "key", "secret")
+	c.Server = srv.URL
+
+	var api API = New(c)
+	order, err := api.Orders().Get(context.Background(), 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.Orderid != 12 || order.Code != "ABC" {
+		t.Errorf("Unexpected order, got %#v", order)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = api.Orders().Get(ctx, 12)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
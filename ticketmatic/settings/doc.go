@@ -0,0 +1,17 @@
+// Generic access to the settings resources of an account.
+//
+// Each settings package (pricetypes, saleschannels, ...) has its own typed
+// operations. This package describes all of them with a common interface,
+// Resource, and lists them in All, so tools can work on any of them:
+//
+//	// Typed
+//	types, err := settings.Find(client, settings.PriceTypes, "student", false)
+//
+//	// Untyped, by name
+//	r := settings.Lookup("pricetypes")
+//	err := settings.Archive(client, r, 12, 13)
+//
+//	// All resources
+//	backup, err := settings.MakeBackup(client)
+//	matches, err := settings.Search(client, "vip", true)
+package settings
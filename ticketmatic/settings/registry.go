@@ -0,0 +1,178 @@
+package settings
+
+import (
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/documents"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/ordermails"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/ticketlayouts"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/ticketlayouttemplates"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/communicationanddesign/webskins"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/events/eventlocations"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/orderfeedefinitions"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/pricelists"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/pricetypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/pricing/ticketfees"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/productcategories"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/products"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/seatingplans/seatingplans"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/seatingplans/seatranks"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/contactaddresstypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/contactfields"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/contacttitles"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/customfields"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/customfieldvalues"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/dupedetectrules"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/fielddefinitions"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/filterdefinitions"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/optins"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/phonenumbertypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/relationtypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/reports"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/ticketsalesflows"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/ticketsalessetups"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/views"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/deliveryscenarios"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/locktypes"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/orderfees"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/paymentmethods"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/paymentscenarios"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/ticketsales/saleschannels"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/vouchers"
+)
+
+// Settings resources
+var (
+	CustomFields Resource[ticketmatic.CustomField, ticketmatic.CustomFieldQuery] = newResource("customfields", "system/customfields",
+		customfields.Getlist, customfields.Get, customfields.Create, customfields.Update, customfields.Delete)
+
+	CustomFieldValues Resource[ticketmatic.CustomFieldValue, ticketmatic.CustomFieldValueQuery] = newResource("customfieldvalues", "system/customfieldvalues",
+		customfieldvalues.Getlist, customfieldvalues.Get, customfieldvalues.Create, customfieldvalues.Update, customfieldvalues.Delete)
+
+	ContactTitles Resource[ticketmatic.ContactTitle, ticketmatic.ContactTitleQuery] = newResource("contacttitles", "system/contacttitles",
+		contacttitles.Getlist, contacttitles.Get, contacttitles.Create, contacttitles.Update, contacttitles.Delete)
+
+	ContactAddressTypes Resource[ticketmatic.ContactAddressType, ticketmatic.ContactAddressTypeQuery] = newResource("contactaddresstypes", "system/contactaddresstypes",
+		contactaddresstypes.Getlist, contactaddresstypes.Get, contactaddresstypes.Create, contactaddresstypes.Update, contactaddresstypes.Delete)
+
+	PhoneNumberTypes Resource[ticketmatic.PhoneNumberType, ticketmatic.PhoneNumberTypeQuery] = newResource("phonenumbertypes", "system/phonenumbertypes",
+		phonenumbertypes.Getlist, phonenumbertypes.Get, phonenumbertypes.Create, phonenumbertypes.Update, phonenumbertypes.Delete)
+
+	RelationTypes Resource[ticketmatic.RelationType, ticketmatic.RelationTypeQuery] = newResource("relationtypes", "system/relationtypes",
+		relationtypes.Getlist, relationtypes.Get, relationtypes.Create, relationtypes.Update, relationtypes.Delete)
+
+	OptIns Resource[ticketmatic.OptIn, ticketmatic.OptInQuery] = newResource("optins", "system/optins",
+		optins.Getlist, optins.Get, optins.Create, optins.Update, optins.Delete)
+
+	ContactFields Resource[ticketmatic.ContactField, NoQuery] = newResource("contactfields", "system/contactfields",
+		func(c *ticketmatic.Client, _ *NoQuery) (*contactfields.List, error) { return contactfields.Getlist(c) },
+		contactfields.Get, nil, nil, nil)
+
+	FieldDefinitions Resource[ticketmatic.FieldDefinition, ticketmatic.FieldDefinitionQuery] = newResource("fielddefinitions", "system/fielddefinitions",
+		fielddefinitions.Getlist, fielddefinitions.Get, fielddefinitions.Create, fielddefinitions.Update, fielddefinitions.Delete)
+
+	FilterDefinitions Resource[ticketmatic.FilterDefinition, ticketmatic.FilterDefinitionQuery] = newResource("filterdefinitions", "system/filterdefinitions",
+		filterdefinitions.Getlist, filterdefinitions.Get, filterdefinitions.Create, filterdefinitions.Update, filterdefinitions.Delete)
+
+	Views Resource[ticketmatic.View, ticketmatic.ViewQuery] = newResource("views", "system/views",
+		views.Getlist, views.Get, views.Create, views.Update, views.Delete)
+
+	Reports Resource[ticketmatic.Report, ticketmatic.ReportQuery] = newResource("reports", "system/reports",
+		reports.Getlist, reports.Get, reports.Create, reports.Update, reports.Delete)
+
+	DupeDetectRules Resource[ticketmatic.DupeDetectRule, ticketmatic.DupeDetectRuleQuery] = newResource("dupedetectrules", "system/dupedetectrules",
+		dupedetectrules.Getlist, dupedetectrules.Get, dupedetectrules.Create, dupedetectrules.Update, dupedetectrules.Delete)
+
+	TicketLayoutTemplates Resource[ticketmatic.TicketLayoutTemplate, ticketmatic.TicketLayoutTemplateQuery] = newResource("ticketlayouttemplates", "communicationanddesign/ticketlayouttemplates",
+		ticketlayouttemplates.Getlist, ticketlayouttemplates.Get, ticketlayouttemplates.Create, ticketlayouttemplates.Update, ticketlayouttemplates.Delete)
+
+	TicketLayouts Resource[ticketmatic.TicketLayout, ticketmatic.TicketLayoutQuery] = newResource("ticketlayouts", "communicationanddesign/ticketlayouts",
+		ticketlayouts.Getlist, ticketlayouts.Get, ticketlayouts.Create, ticketlayouts.Update, ticketlayouts.Delete)
+
+	Documents Resource[ticketmatic.Document, ticketmatic.DocumentQuery] = newResource("documents", "communicationanddesign/documents",
+		documents.Getlist, documents.Get, documents.Create, documents.Update, documents.Delete)
+
+	OrderMails Resource[ticketmatic.OrderMailTemplate, ticketmatic.OrderMailTemplateQuery] = newResource("ordermails", "communicationanddesign/ordermails",
+		ordermails.Getlist, ordermails.Get, ordermails.Create, ordermails.Update, ordermails.Delete)
+
+	WebSkins Resource[ticketmatic.WebSalesSkin, ticketmatic.WebSalesSkinQuery] = newResource("webskins", "communicationanddesign/webskins",
+		webskins.Getlist, webskins.Get, webskins.Create, webskins.Update, webskins.Delete)
+
+	EventLocations Resource[ticketmatic.EventLocation, ticketmatic.EventLocationQuery] = newResource("eventlocations", "events/eventlocations",
+		eventlocations.Getlist, eventlocations.Get, eventlocations.Create, eventlocations.Update, eventlocations.Delete)
+
+	SeatingPlans Resource[ticketmatic.SeatingPlan, ticketmatic.SeatingPlanQuery] = newResource("seatingplans", "seatingplans/seatingplans",
+		seatingplans.Getlist, seatingplans.Get, seatingplans.Create, seatingplans.Update, seatingplans.Delete)
+
+	SeatRanks Resource[ticketmatic.SeatRank, ticketmatic.SeatRankQuery] = newResource("seatranks", "seatingplans/seatranks",
+		seatranks.Getlist, seatranks.Get, seatranks.Create, seatranks.Update, seatranks.Delete)
+
+	PriceTypes Resource[ticketmatic.PriceType, ticketmatic.PriceTypeQuery] = newResource("pricetypes", "pricing/pricetypes",
+		pricetypes.Getlist, pricetypes.Get, pricetypes.Create, pricetypes.Update, pricetypes.Delete)
+
+	TicketFees Resource[ticketmatic.TicketFee, ticketmatic.TicketFeeQuery] = newResource("ticketfees", "pricing/ticketfees",
+		ticketfees.Getlist, ticketfees.Get, ticketfees.Create, ticketfees.Update, ticketfees.Delete)
+
+	PriceLists Resource[ticketmatic.PriceList, ticketmatic.PriceListQuery] = newResource("pricelists", "pricing/pricelists",
+		pricelists.Getlist, pricelists.Get, pricelists.Create, pricelists.Update, pricelists.Delete)
+
+	OrderFeeDefinitions Resource[ticketmatic.OrderFeeDefinition, ticketmatic.OrderFeeDefinitionQuery] = newResource("orderfeedefinitions", "pricing/orderfeedefinitions",
+		orderfeedefinitions.Getlist, orderfeedefinitions.Get, orderfeedefinitions.Create, nil, orderfeedefinitions.Delete)
+
+	OrderFees Resource[ticketmatic.OrderFee, ticketmatic.OrderFeeQuery] = newResource("orderfees", "ticketsales/orderfees",
+		orderfees.Getlist, orderfees.Get, orderfees.Create, nil, orderfees.Delete)
+
+	LockTypes Resource[ticketmatic.LockType, ticketmatic.LockTypeQuery] = newResource("locktypes", "ticketsales/locktypes",
+		locktypes.Getlist, locktypes.Get, locktypes.Create, locktypes.Update, locktypes.Delete)
+
+	SalesChannels Resource[ticketmatic.SalesChannel, ticketmatic.SalesChannelQuery] = newResource("saleschannels", "ticketsales/saleschannels",
+		saleschannels.Getlist, saleschannels.Get, saleschannels.Create, saleschannels.Update, saleschannels.Delete)
+
+	PaymentMethods Resource[ticketmatic.PaymentMethod, ticketmatic.PaymentMethodQuery] = newResource("paymentmethods", "ticketsales/paymentmethods",
+		paymentmethods.Getlist, paymentmethods.Get, paymentmethods.Create, paymentmethods.Update, paymentmethods.Delete)
+
+	PaymentScenarios Resource[ticketmatic.PaymentScenario, ticketmatic.PaymentScenarioQuery] = newResource("paymentscenarios", "ticketsales/paymentscenarios",
+		paymentscenarios.Getlist, paymentscenarios.Get, paymentscenarios.Create, paymentscenarios.Update, paymentscenarios.Delete)
+
+	DeliveryScenarios Resource[ticketmatic.DeliveryScenario, ticketmatic.DeliveryScenarioQuery] = newResource("deliveryscenarios", "ticketsales/deliveryscenarios",
+		deliveryscenarios.Getlist, deliveryscenarios.Get, deliveryscenarios.Create, deliveryscenarios.Update, deliveryscenarios.Delete)
+
+	ProductCategories Resource[ticketmatic.ProductCategory, ticketmatic.ProductCategoryQuery] = newResource("productcategories", "productcategories",
+		productcategories.Getlist, productcategories.Get, productcategories.Create, productcategories.Update, productcategories.Delete)
+
+	Products Resource[ticketmatic.Product, ticketmatic.ProductQuery] = newResource("products", "products",
+		products.Getlist, products.Get, products.Create, products.Update, products.Delete)
+
+	Vouchers Resource[ticketmatic.Voucher, ticketmatic.VoucherQuery] = newResource("vouchers", "vouchers",
+		vouchers.Getlist, vouchers.Get, vouchers.Create, vouchers.Update, vouchers.Delete)
+
+	TicketSalesSetups Resource[ticketmatic.Ticketsalessetup, ticketmatic.TicketsalessetupQuery] = newResource("ticketsalessetups", "system/ticketsalessetups",
+		ticketsalessetups.Getlist, ticketsalessetups.Get, ticketsalessetups.Create, ticketsalessetups.Update, ticketsalessetups.Delete)
+
+	TicketSalesFlows Resource[ticketmatic.Ticketsalesflow, ticketmatic.TicketsalesflowQuery] = newResource("ticketsalesflows", "system/ticketsalesflows",
+		ticketsalesflows.Getlist, ticketsalesflows.Get, ticketsalesflows.Create, ticketsalesflows.Update, ticketsalesflows.Delete)
+)
+
+// All settings resources, in an order where referenced objects come before
+// the objects referring to them. Account parameters are not included: they
+// are identified by key rather than id.
+var All = []Any{
+	CustomFields, CustomFieldValues, ContactTitles, ContactAddressTypes,
+	PhoneNumberTypes, RelationTypes, OptIns, ContactFields,
+	FieldDefinitions, FilterDefinitions, Views, Reports,
+	DupeDetectRules, TicketLayoutTemplates, TicketLayouts, Documents,
+	OrderMails, WebSkins, EventLocations, SeatingPlans,
+	SeatRanks, SalesChannels, PriceTypes, TicketFees,
+	PriceLists, OrderFeeDefinitions, OrderFees, LockTypes,
+	PaymentMethods, PaymentScenarios, DeliveryScenarios, ProductCategories,
+	Products, Vouchers, TicketSalesSetups, TicketSalesFlows,
+}
+
+// Find a resource by name, nil if unknown
+func Lookup(name string) Any {
+	for _, r := range All {
+		if r.Name() == name {
+			return r
+		}
+	}
+	return nil
+}
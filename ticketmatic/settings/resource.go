@@ -0,0 +1,248 @@
+package settings
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// A type of settings object, accessed through untyped objects. The objects
+// are pointers to the object type: *ticketmatic.PriceType.
+type Any interface {
+	// Name: "pricetypes"
+	Name() string
+
+	// API path below settings: "pricing/pricetypes"
+	Path() string
+
+	// Object type: ticketmatic.PriceType
+	Type() reflect.Type
+
+	// A new, empty object
+	New() interface{}
+
+	// Whether objects can be created, updated and deleted (archived)
+	CanCreate() bool
+	CanUpdate() bool
+	CanDelete() bool
+
+	// List all objects, including the archived ones if archived is set
+	ListObjects(c *ticketmatic.Client, archived bool) ([]interface{}, error)
+
+	// Get a single object
+	GetObject(c *ticketmatic.Client, id int64) (interface{}, error)
+
+	// Create an object, returns the new object
+	CreateObject(c *ticketmatic.Client, o interface{}) (interface{}, error)
+
+	// Update an object, returns the updated object
+	UpdateObject(c *ticketmatic.Client, id int64, o interface{}) (interface{}, error)
+
+	// Delete an object. Most settings are archived instead of deleted.
+	Delete(c *ticketmatic.Client, id int64) error
+}
+
+// A type of settings object: T is the object type, Q the type of its list
+// query.
+//
+//	types, err := settings.PriceTypes.List(client, &ticketmatic.PriceTypeQuery{
+//		Includearchived: true,
+//	})
+//
+// Operations that aren't supported by the resource return an error.
+type Resource[T any, Q any] interface {
+	Any
+
+	List(c *ticketmatic.Client, q *Q) ([]*T, error)
+	Get(c *ticketmatic.Client, id int64) (*T, error)
+	Create(c *ticketmatic.Client, o *T) (*T, error)
+	Update(c *ticketmatic.Client, id int64, o *T) (*T, error)
+}
+
+// Query type of resources whose list can't be filtered
+type NoQuery struct{}
+
+type resource[T any, Q any] struct {
+	name string
+	path string
+
+	list   func(*ticketmatic.Client, *Q) ([]*T, error)
+	get    func(*ticketmatic.Client, int64) (*T, error)
+	create func(*ticketmatic.Client, *T) (*T, error)
+	update func(*ticketmatic.Client, int64, *T) (*T, error)
+	del    func(*ticketmatic.Client, int64) error
+}
+
+// Create a resource from the operations of its package. Create, update and
+// del can be nil when not supported.
+func newResource[T, Q, L any](name, path string,
+	getlist func(*ticketmatic.Client, *Q) (*L, error),
+	get func(*ticketmatic.Client, int64) (*T, error),
+	create func(*ticketmatic.Client, *T) (*T, error),
+	update func(*ticketmatic.Client, int64, *T) (*T, error),
+	del func(*ticketmatic.Client, int64) error) *resource[T, Q] {
+
+	// Lists have their objects in a Data field
+	field, ok := reflect.TypeOf((*L)(nil)).Elem().FieldByName("Data")
+	if !ok || field.Type != reflect.TypeOf([]*T(nil)) {
+		panic(fmt.Sprintf("settings: list of %s has no Data field of type []*%T", name, *new(T)))
+	}
+
+	return &resource[T, Q]{
+		name: name,
+		path: path,
+		list: func(c *ticketmatic.Client, q *Q) ([]*T, error) {
+			l, err := getlist(c, q)
+			if err != nil {
+				return nil, err
+			}
+			return reflect.ValueOf(l).Elem().FieldByIndex(field.Index).Interface().([]*T), nil
+		},
+		get:    get,
+		create: create,
+		update: update,
+		del:    del,
+	}
+}
+
+func (r *resource[T, Q]) Name() string {
+	return r.name
+}
+
+func (r *resource[T, Q]) Path() string {
+	return r.path
+}
+
+func (r *resource[T, Q]) Type() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (r *resource[T, Q]) New() interface{} {
+	return new(T)
+}
+
+func (r *resource[T, Q]) CanCreate() bool {
+	return r.create != nil
+}
+
+func (r *resource[T, Q]) CanUpdate() bool {
+	return r.update != nil
+}
+
+func (r *resource[T, Q]) CanDelete() bool {
+	return r.del != nil
+}
+
+func (r *resource[T, Q]) List(c *ticketmatic.Client, q *Q) ([]*T, error) {
+	return r.list(c, q)
+}
+
+func (r *resource[T, Q]) Get(c *ticketmatic.Client, id int64) (*T, error) {
+	return r.get(c, id)
+}
+
+func (r *resource[T, Q]) Create(c *ticketmatic.Client, o *T) (*T, error) {
+	if r.create == nil {
+		return nil, fmt.Errorf("Creating %s is not supported", r.name)
+	}
+	return r.create(c, o)
+}
+
+func (r *resource[T, Q]) Update(c *ticketmatic.Client, id int64, o *T) (*T, error) {
+	if r.update == nil {
+		return nil, fmt.Errorf("Updating %s is not supported", r.name)
+	}
+	return r.update(c, id, o)
+}
+
+func (r *resource[T, Q]) Delete(c *ticketmatic.Client, id int64) error {
+	if r.del == nil {
+		return fmt.Errorf("Deleting %s is not supported", r.name)
+	}
+	return r.del(c, id)
+}
+
+func (r *resource[T, Q]) ListObjects(c *ticketmatic.Client, archived bool) ([]interface{}, error) {
+	// Not all queries have an includearchived field, use the route directly
+	req := c.NewRequest("GET", "/{accountname}/settings/"+r.path, "json")
+	req.AddParameter("includearchived", archived)
+
+	var obj struct {
+		Data []*T `json:"data"`
+	}
+	err := req.Run(&obj)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]interface{}, 0, len(obj.Data))
+	for _, o := range obj.Data {
+		result = append(result, o)
+	}
+	return result, nil
+}
+
+func (r *resource[T, Q]) GetObject(c *ticketmatic.Client, id int64) (interface{}, error) {
+	return r.Get(c, id)
+}
+
+func (r *resource[T, Q]) CreateObject(c *ticketmatic.Client, o interface{}) (interface{}, error) {
+	data, err := r.object(o)
+	if err != nil {
+		return nil, err
+	}
+	return r.Create(c, data)
+}
+
+func (r *resource[T, Q]) UpdateObject(c *ticketmatic.Client, id int64, o interface{}) (interface{}, error) {
+	data, err := r.object(o)
+	if err != nil {
+		return nil, err
+	}
+	return r.Update(c, id, data)
+}
+
+func (r *resource[T, Q]) object(o interface{}) (*T, error) {
+	data, ok := o.(*T)
+	if !ok {
+		return nil, fmt.Errorf("Expected %T for %s, got %T", data, r.name, o)
+	}
+	return data, nil
+}
+
+// Id of an object, 0 if it has none
+func ObjectID(o interface{}) int64 {
+	f := field(o, "Id")
+	if !f.IsValid() || f.Kind() != reflect.Int64 {
+		return 0
+	}
+	return f.Int()
+}
+
+// Fields used as name, for objects without a name field
+var nameFields = []string{"Name", "Caption", "Description"}
+
+// Name of an object: its name, caption or description. Empty if it has none.
+func ObjectName(o interface{}) string {
+	for _, name := range nameFields {
+		f := field(o, name)
+		if f.IsValid() && f.Kind() == reflect.String {
+			return f.String()
+		}
+	}
+	return ""
+}
+
+func field(o interface{}, name string) reflect.Value {
+	v := reflect.ValueOf(o)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v.FieldByName(name)
+}
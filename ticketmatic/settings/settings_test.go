@@ -0,0 +1,143 @@
+package settings
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apitest"
+)
+
+const pricetypesPath = "settings/pricing/pricetypes"
+
+// A fake account holding price types
+func newFakeAccount(types ...interface{}) *apitest.Account {
+	account := apitest.New(map[string][]map[string]interface{}{
+		pricetypesPath: apitest.Objects(types...),
+	})
+	account.LastID = 99
+	account.Archive = true
+	return account
+}
+
+func TestRegistry(t *testing.T) {
+	if len(All) != 36 {
+		t.Errorf("Unexpected number of resources, got %d", len(All))
+	}
+	seen := make(map[string]bool)
+	for _, r := range All {
+		if seen[r.Name()] {
+			t.Errorf("Duplicate resource %s", r.Name())
+		}
+		seen[r.Name()] = true
+		if Lookup(r.Name()) != r {
+			t.Errorf("Lookup of %s failed", r.Name())
+		}
+		if _, ok := r.Type().FieldByName("Id"); !ok {
+			t.Errorf("Type of %s has no id", r.Name())
+		}
+	}
+	if Lookup("unknown") != nil {
+		t.Error("Expected no resource")
+	}
+
+	if ContactFields.CanCreate() || OrderFees.CanUpdate() || !PriceTypes.CanDelete() {
+		t.Error("Unexpected supported operations")
+	}
+	_, err := OrderFees.Update(nil, 1, &ticketmatic.OrderFee{})
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+	_, err = PriceTypes.CreateObject(nil, &ticketmatic.OrderFee{})
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+
+	o := &ticketmatic.CustomField{Id: 3, Caption: "Size"}
+	if ObjectID(o) != 3 || ObjectName(o) != "Size" {
+		t.Errorf("Unexpected id or name, got %d %q", ObjectID(o), ObjectName(o))
+	}
+}
+
+func TestTools(t *testing.T) {
+	f := newFakeAccount(
+		&ticketmatic.PriceType{Id: 1, Name: "Regular"},
+		&ticketmatic.PriceType{Id: 2, Name: "Student"},
+		&ticketmatic.PriceType{Id: 3, Name: "Student (old)", Isarchived: true},
+	)
+	c := f.Client(t)
+
+	list, err := PriceTypes.List(c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 {
+		t.Errorf("Unexpected list, got %d price types", len(list))
+	}
+
+	found, err := Find(c, PriceTypes, "student", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0].Id != 2 {
+		t.Errorf("Unexpected result, got %#v", found)
+	}
+
+	matches, err := Search(c, "STUDENT", true, PriceTypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 2 || matches[1].Id != 3 || matches[1].Name != "Student (old)" || matches[1].Resource != PriceTypes {
+		t.Errorf("Unexpected matches, got %#v", matches)
+	}
+
+	b, err := MakeBackup(c, PriceTypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored Backup
+	err = json.Unmarshal(data, &restored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := Decode(restored, PriceTypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 3 || types[2].Name != "Student (old)" || !types[2].Isarchived {
+		t.Errorf("Unexpected backup, got %s", data)
+	}
+
+	err = Archive(c, PriceTypes, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Object(pricetypesPath, 1)["isarchived"] != true || f.Object(pricetypesPath, 2)["isarchived"] != true {
+		t.Error("Expected price types to be archived")
+	}
+	err = Unarchive(c, Lookup("pricetypes"), 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Object(pricetypesPath, 1)["isarchived"] != false || f.Object(pricetypesPath, 2)["isarchived"] != true || f.Object(pricetypesPath, 3)["isarchived"] != false {
+		t.Error("Expected price types 1 and 3 to be unarchived")
+	}
+	err = Archive(c, PriceTypes, 4)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+
+	target := newFakeAccount()
+	tc := target.Client(t)
+	copied, err := Copy(c, tc, PriceTypes, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(copied) != 1 || copied[0].Id != 100 || target.Object(pricetypesPath, 100)["name"] != "Student" {
+		t.Errorf("Unexpected copy, got %#v", copied)
+	}
+}
@@ -0,0 +1,183 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Objects of several resources, by resource name, in their JSON form. Can be
+// stored as is with encoding/json.
+type Backup map[string][]json.RawMessage
+
+// Back up all objects, including archived ones, of the given resources (all
+// resources if none are given).
+func MakeBackup(c *ticketmatic.Client, resources ...Any) (Backup, error) {
+	if len(resources) == 0 {
+		resources = All
+	}
+	b := make(Backup)
+	for _, r := range resources {
+		objects, err := r.ListObjects(c, true)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name(), err)
+		}
+		list := make([]json.RawMessage, 0, len(objects))
+		for _, o := range objects {
+			data, err := json.Marshal(o)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", r.Name(), err)
+			}
+			list = append(list, data)
+		}
+		b[r.Name()] = list
+	}
+	return b, nil
+}
+
+// Objects of a resource in a backup
+func Decode[T, Q any](b Backup, r Resource[T, Q]) ([]*T, error) {
+	result := make([]*T, 0, len(b[r.Name()]))
+	for _, data := range b[r.Name()] {
+		o := new(T)
+		err := json.Unmarshal(data, o)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name(), err)
+		}
+		result = append(result, o)
+	}
+	return result, nil
+}
+
+// Copy objects to another account, returns the created objects. Ids of other
+// objects that they refer to are copied as is: use the promote package to
+// copy settings along with the objects they refer to.
+func Copy[T, Q any](from, to *ticketmatic.Client, r Resource[T, Q], ids ...int64) ([]*T, error) {
+	if !r.CanCreate() {
+		return nil, fmt.Errorf("Creating %s is not supported", r.Name())
+	}
+	result := make([]*T, 0, len(ids))
+	for _, id := range ids {
+		o, err := r.Get(from, id)
+		if err != nil {
+			return result, fmt.Errorf("%s/%d: %s", r.Name(), id, err)
+		}
+		created, err := r.Create(to, o)
+		if err != nil {
+			return result, fmt.Errorf("%s/%d: %s", r.Name(), id, err)
+		}
+		result = append(result, created)
+	}
+	return result, nil
+}
+
+// Archive objects. Stops at the first failure.
+func Archive(c *ticketmatic.Client, r Any, ids ...int64) error {
+	if !r.CanDelete() {
+		return fmt.Errorf("Archiving %s is not supported", r.Name())
+	}
+	for _, id := range ids {
+		err := r.Delete(c, id)
+		if err != nil {
+			return fmt.Errorf("%s/%d: %s", r.Name(), id, err)
+		}
+	}
+	return nil
+}
+
+// Unarchive objects, by updating them with isarchived cleared. Fails for
+// objects that are still archived afterwards: not all resources allow this.
+// Stops at the first failure.
+func Unarchive(c *ticketmatic.Client, r Any, ids ...int64) error {
+	if !r.CanUpdate() {
+		return fmt.Errorf("Unarchiving %s is not supported", r.Name())
+	}
+	for _, id := range ids {
+		o, err := r.GetObject(c, id)
+		if err != nil {
+			return fmt.Errorf("%s/%d: %s", r.Name(), id, err)
+		}
+		f := field(o, "Isarchived")
+		if !f.IsValid() || f.Kind() != reflect.Bool {
+			return fmt.Errorf("Unarchiving %s is not supported", r.Name())
+		}
+		if !f.Bool() {
+			continue
+		}
+
+		// The API types leave out false values, send the field directly
+		req := c.NewRequest("PUT", "/{accountname}/settings/"+r.Path()+"/{id}", "json")
+		req.UrlParameters(map[string]interface{}{
+			"id": id,
+		})
+		req.Body(map[string]interface{}{"isarchived": false}, "json")
+
+		o = r.New()
+		err = req.Run(o)
+		if err != nil {
+			return fmt.Errorf("%s/%d: %s", r.Name(), id, err)
+		}
+		if field(o, "Isarchived").Bool() {
+			return fmt.Errorf("%s/%d: Still archived", r.Name(), id)
+		}
+	}
+	return nil
+}
+
+// Objects whose name contains text, ignoring case. Includes archived objects
+// if archived is set.
+func Find[T, Q any](c *ticketmatic.Client, r Resource[T, Q], text string, archived bool) ([]*T, error) {
+	objects, err := r.ListObjects(c, archived)
+	if err != nil {
+		return nil, err
+	}
+	var result []*T
+	for _, o := range objects {
+		if matchName(o, text) {
+			result = append(result, o.(*T))
+		}
+	}
+	return result, nil
+}
+
+// An object found by Search
+type Match struct {
+	Resource Any
+	Id       int64
+	Name     string
+	Object   interface{}
+}
+
+// Search the objects of the given resources (all resources if none are
+// given) whose name contains text, ignoring case. Includes archived objects
+// if archived is set.
+func Search(c *ticketmatic.Client, text string, archived bool, resources ...Any) ([]*Match, error) {
+	if len(resources) == 0 {
+		resources = All
+	}
+	var result []*Match
+	for _, r := range resources {
+		objects, err := r.ListObjects(c, archived)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name(), err)
+		}
+		for _, o := range objects {
+			if matchName(o, text) {
+				result = append(result, &Match{
+					Resource: r,
+					Id:       ObjectID(o),
+					Name:     ObjectName(o),
+					Object:   o,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+func matchName(o interface{}, text string) bool {
+	return strings.Contains(strings.ToLower(ObjectName(o)), strings.ToLower(text))
+}
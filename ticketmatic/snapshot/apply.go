@@ -0,0 +1,95 @@
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apiutil"
+)
+
+var done = map[Action]string{
+	ActionCreate: "Created",
+	ActionUpdate: "Updated",
+	ActionDelete: "Deleted",
+}
+
+// Options for applying a plan
+type ApplyOptions struct {
+	// Only report what would be done, don't change anything
+	DryRun bool
+
+	// Receives a line per applied change (optional)
+	Log io.Writer
+}
+
+// Apply the changes of a plan, in order. Stops at the first failure.
+//
+// The snapshot files of created and updated objects are rewritten with the
+// result, so created objects get their id and planning again gives an empty
+// plan.
+func Apply(client *ticketmatic.Client, plan *Plan, opts *ApplyOptions) error {
+	if opts == nil {
+		opts = &ApplyOptions{}
+	}
+	log := opts.Log
+	if log == nil {
+		log = ioutil.Discard
+	}
+
+	for _, c := range plan.Changes {
+		if !c.Supported() {
+			return fmt.Errorf("Can't %s %s: not supported", c.Action, apiutil.Label(c.Resource.Name, c.Id, c.Name))
+		}
+		if opts.DryRun {
+			fmt.Fprintf(log, "Would %s %s\n", c.Action, apiutil.Label(c.Resource.Name, c.Id, c.Name))
+			continue
+		}
+
+		err := apply(client, c)
+		if err != nil {
+			return fmt.Errorf("Failed to %s %s: %s", c.Action, apiutil.Label(c.Resource.Name, c.Id, c.Name), err)
+		}
+		fmt.Fprintf(log, "%s %s\n", done[c.Action], apiutil.Label(c.Resource.Name, c.Id, c.Name))
+	}
+	return nil
+}
+
+func apply(client *ticketmatic.Client, c *Change) error {
+	var (
+		result Object
+		err    error
+	)
+	switch c.Action {
+	case ActionCreate:
+		result, err = c.Resource.Create(client, c.Object)
+		if err == nil {
+			c.Id = result.ID()
+		}
+	case ActionUpdate:
+		result, err = c.Resource.Update(client, c.Id, c.Object)
+	case ActionDelete:
+		return c.Resource.Delete(client, c.Id)
+	}
+	if err != nil || c.File == "" {
+		return err
+	}
+
+	// Keep the snapshot in sync
+	file := objectFile(filepath.Dir(c.File), result)
+	err = writeObject(file, result)
+	if err != nil {
+		return err
+	}
+	if file != c.File {
+		err = os.Remove(c.File)
+		if err != nil {
+			return err
+		}
+		c.File = file
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+// Settings as code: snapshot the configuration of an account to a directory
+// of JSON files, diff it against the live account and apply the changes.
+//
+//	// Save the current configuration
+//	err := snapshot.Save(client, "config")
+//
+//	// ... edit the files, commit them to version control ...
+//
+//	snap, err := snapshot.Load("config")
+//	plan, err := snapshot.MakePlan(client, snap, nil)
+//	fmt.Print(plan)
+//
+//	// ~ pricetypes/12 "Regular"
+//	//     name: "Regular" -> "Regular price"
+//	// + pricetypes "Student"
+//	// 1 to create, 1 to update, 0 to delete
+//
+//	err = snapshot.Apply(client, plan, &snapshot.ApplyOptions{Log: os.Stdout})
+//
+// Applying is idempotent: once applied, planning again gives no changes.
+//
+// Snapshots are stored as JSON: YAML would require a dependency outside of
+// the standard library.
+package snapshot
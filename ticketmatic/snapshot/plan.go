@@ -0,0 +1,292 @@
+package snapshot
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apiutil"
+)
+
+// What a change does
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// A changed field
+type FieldChange struct {
+	// Path of the field: "name", "saleschannels[0].price"
+	Path string
+
+	// Live value, nil when created
+	Old interface{}
+
+	// Desired value
+	New interface{}
+}
+
+func (f *FieldChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", f.Path, describe(f.Old), describe(f.New))
+}
+
+// A change to a single object
+type Change struct {
+	Resource *Resource
+	Action   Action
+
+	// Object id, 0 when created
+	Id int64
+
+	// Object name, for display
+	Name string
+
+	// Changed fields (for updates)
+	Fields []*FieldChange
+
+	// The object to send (for creates and updates)
+	Object Object
+
+	// Snapshot file of the object, rewritten after applying
+	File string
+}
+
+// Whether the resource supports the action
+func (c *Change) Supported() bool {
+	switch c.Action {
+	case ActionCreate:
+		return c.Resource.Create != nil
+	case ActionUpdate:
+		return c.Resource.Update != nil
+	case ActionDelete:
+		return c.Resource.Delete != nil
+	}
+	return false
+}
+
+func (c *Change) String() string {
+	var b strings.Builder
+	sign := map[Action]string{ActionCreate: "+", ActionUpdate: "~", ActionDelete: "-"}[c.Action]
+	fmt.Fprintf(&b, "%s %s", sign, apiutil.Label(c.Resource.Name, c.Id, c.Name))
+	if !c.Supported() {
+		fmt.Fprintf(&b, " (%s not supported)", c.Action)
+	}
+	for _, f := range c.Fields {
+		fmt.Fprintf(&b, "\n    %s", f)
+	}
+	return b.String()
+}
+
+// Changes needed to make an account match a snapshot
+type Plan struct {
+	Changes []*Change
+}
+
+// Whether the account already matches the snapshot
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Human-readable diff, one change per line with changed fields indented
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "No changes\n"
+	}
+
+	var b strings.Builder
+	counts := make(map[Action]int)
+	for _, c := range p.Changes {
+		b.WriteString(c.String())
+		b.WriteString("\n")
+		counts[c.Action]++
+	}
+	fmt.Fprintf(&b, "%d to create, %d to update, %d to delete\n", counts[ActionCreate], counts[ActionUpdate], counts[ActionDelete])
+	return b.String()
+}
+
+// Planning options
+type PlanOptions struct {
+	// Delete (archive) live objects that aren't in the snapshot. Without
+	// Prune, such objects are left alone.
+	Prune bool
+
+	// Only plan these resources (default: all resources in the snapshot)
+	Resources []string
+}
+
+// Compare a snapshot with the live account and plan the changes needed to
+// make the account match.
+//
+// Only the fields present in a snapshot object are compared, so snapshot
+// files can be trimmed to the fields that should be managed. Objects with an
+// id that doesn't exist (anymore) in the account are an error: remove the id
+// to have them created.
+func MakePlan(client *ticketmatic.Client, snap *Snapshot, opts *PlanOptions) (*Plan, error) {
+	if opts == nil {
+		opts = &PlanOptions{}
+	}
+	res, err := selectResources(opts.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{}
+	for _, r := range res {
+		entries, ok := snap.Objects[r.Name]
+		if !ok {
+			continue
+		}
+
+		objects, err := r.List(client)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name, err)
+		}
+		changes, err := diffResource(r, entries, objects, opts.Prune)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, changes...)
+	}
+	return plan, nil
+}
+
+// Diff the snapshot entries of a resource against the live objects
+func diffResource(r *Resource, entries []*Entry, objects []Object, prune bool) ([]*Change, error) {
+	live := make(map[int64]Object, len(objects))
+	for _, o := range objects {
+		live[o.ID()] = o
+	}
+
+	var changes []*Change
+	seen := make(map[int64]bool)
+	for _, e := range entries {
+		id := e.Object.ID()
+		if id == 0 {
+			changes = append(changes, &Change{
+				Resource: r,
+				Action:   ActionCreate,
+				Name:     e.Object.Name(),
+				Object:   Payload(nil, e.Object),
+				File:     e.File,
+			})
+			continue
+		}
+
+		current, ok := live[id]
+		if !ok {
+			return nil, fmt.Errorf("%s: %s doesn't exist in the account, remove its id to create it", e.File, apiutil.Label(r.Name, id, ""))
+		}
+		seen[id] = true
+
+		fields := Diff(current, e.Object)
+		if len(fields) > 0 {
+			changes = append(changes, &Change{
+				Resource: r,
+				Action:   ActionUpdate,
+				Id:       id,
+				Name:     e.Object.Name(),
+				Fields:   fields,
+				Object:   Payload(current, e.Object),
+				File:     e.File,
+			})
+		}
+	}
+
+	if prune {
+		for _, o := range objects {
+			if !seen[o.ID()] {
+				changes = append(changes, &Change{
+					Resource: r,
+					Action:   ActionDelete,
+					Id:       o.ID(),
+					Name:     o.Name(),
+				})
+			}
+		}
+	}
+	return changes, nil
+}
+
+// Differences between a live object and the desired state. Only the fields
+// present in desired are compared.
+func Diff(current, desired Object) []*FieldChange {
+	var fields []*FieldChange
+	for _, k := range sortedKeys(desired) {
+		if !ignoredFields[k] {
+			fields = diffValue(fields, k, current[k], desired[k])
+		}
+	}
+	return fields
+}
+
+// The object to send: the live object with the desired fields applied,
+// without the fields managed by Ticketmatic
+func Payload(current, desired Object) Object {
+	o := make(Object)
+	for k, v := range current {
+		if !ignoredFields[k] {
+			o[k] = v
+		}
+	}
+	for k, v := range desired {
+		if !ignoredFields[k] {
+			o[k] = v
+		}
+	}
+	return o
+}
+
+// Append the differences between two JSON values
+func diffValue(fields []*FieldChange, path string, old, new interface{}) []*FieldChange {
+	switch n := new.(type) {
+	case map[string]interface{}:
+		if o, ok := old.(map[string]interface{}); ok {
+			keys := make(map[string]bool)
+			for k := range o {
+				keys[k] = true
+			}
+			for k := range n {
+				keys[k] = true
+			}
+			names := make([]string, 0, len(keys))
+			for k := range keys {
+				names = append(names, k)
+			}
+			sort.Strings(names)
+			for _, k := range names {
+				fields = diffValue(fields, path+"."+k, o[k], n[k])
+			}
+			return fields
+		}
+	case []interface{}:
+		if o, ok := old.([]interface{}); ok && len(o) == len(n) {
+			for i := range n {
+				fields = diffValue(fields, fmt.Sprintf("%s[%d]", path, i), o[i], n[i])
+			}
+			return fields
+		}
+	}
+
+	if !reflect.DeepEqual(old, new) {
+		fields = append(fields, &FieldChange{
+			Path: path,
+			Old:  old,
+			New:  new,
+		})
+	}
+	return fields
+}
+
+func sortedKeys(o Object) []string {
+	keys := make([]string, 0, len(o))
+	for k := range o {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
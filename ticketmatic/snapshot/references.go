@@ -0,0 +1,101 @@
+package snapshot
+
+import (
+	"strings"
+)
+
+// A reference from an object to another object
+type Reference struct {
+	// Path of the id field in the JSON object. A "[]" suffix iterates over
+	// an array: "prices.prices[].pricetypeid", "saleschannels[]"
+	Path string
+
+	// Referenced resource
+	Resource string
+}
+
+// References between settings objects, per resource
+var References = map[string][]Reference{
+	"pricelists": {
+		{"prices.prices[].pricetypeid", "pricetypes"},
+		{"prices.prices[].saleschannels[]", "saleschannels"},
+		{"prices.seatrankids[]", "seatranks"},
+	},
+	"ticketfees": {
+		{"rules.default[].saleschannelid", "saleschannels"},
+		{"rules.exceptions[].pricetypeid", "pricetypes"},
+		{"rules.exceptions[].saleschannels[].saleschannelid", "saleschannels"},
+	},
+	"saleschannels": {
+		{"ordermailtemplateid_confirmation", "ordermails"},
+	},
+	"paymentscenarios": {
+		{"ordermailtemplateid_expiry", "ordermails"},
+		{"ordermailtemplateid_overdue", "ordermails"},
+		{"ordermailtemplateid_paymentinstruction", "ordermails"},
+		{"paymentmethods[]", "paymentmethods"},
+	},
+	"deliveryscenarios": {
+		{"ordermailtemplateid_delivery", "ordermails"},
+		{"ordermailtemplateid_deliverystarted", "ordermails"},
+	},
+	"products": {
+		{"categoryid", "productcategories"},
+		{"layoutid", "ticketlayouts"},
+		{"saleschannels[]", "saleschannels"},
+	},
+	"vouchers": {
+		{"paymentmethodid", "paymentmethods"},
+	},
+}
+
+// Ids referenced by an object, 0 (not set) is skipped
+func (r Reference) IDs(o map[string]interface{}) []int64 {
+	var ids []int64
+	walk(o, strings.Split(r.Path, "."), func(id int64) (interface{}, error) {
+		ids = append(ids, id)
+		return float64(id), nil
+	})
+	return ids
+}
+
+// Replace the referenced ids of an object by the result of fn. Modifies o
+// in place, stops at the first error.
+func (r Reference) Rewrite(o map[string]interface{}, fn func(id int64) (interface{}, error)) error {
+	_, err := walk(o, strings.Split(r.Path, "."), fn)
+	return err
+}
+
+func walk(v interface{}, path []string, fn func(id int64) (interface{}, error)) (interface{}, error) {
+	if len(path) == 0 {
+		id, ok := v.(float64)
+		if !ok || id == 0 {
+			return v, nil
+		}
+		return fn(int64(id))
+	}
+
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v, nil
+	}
+	key := strings.TrimSuffix(path[0], "[]")
+	child, ok := m[key]
+	if !ok {
+		return v, nil
+	}
+
+	var err error
+	if key != path[0] {
+		items, _ := child.([]interface{})
+		for i, item := range items {
+			items[i], err = walk(item, path[1:], fn)
+			if err != nil {
+				return v, err
+			}
+		}
+	} else {
+		m[key], err = walk(child, path[1:], fn)
+	}
+	return v, err
+}
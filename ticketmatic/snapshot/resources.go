@@ -0,0 +1,145 @@
+package snapshot
+
+import (
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apiutil"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings"
+)
+
+// A settings object, in its JSON form
+type Object map[string]interface{}
+
+// ID of the object, 0 if not set
+func (o Object) ID() int64 {
+	id, _ := o["id"].(float64)
+	return int64(id)
+}
+
+// Name of the object, for display
+func (o Object) Name() string {
+	name, _ := o["name"].(string)
+	return name
+}
+
+// A type of settings object, accessed through untyped objects
+type Resource struct {
+	// Name, used as directory name in snapshots: "pricetypes"
+	Name string
+
+	// API path below settings: "pricing/pricetypes"
+	Path string
+
+	// List all (non-archived) objects
+	List func(c *ticketmatic.Client) ([]Object, error)
+
+	// Create an object, nil if not supported
+	Create func(c *ticketmatic.Client, o Object) (Object, error)
+
+	// Update an object, nil if not supported
+	Update func(c *ticketmatic.Client, id int64, o Object) (Object, error)
+
+	// Delete (archive) an object, nil if not supported
+	Delete func(c *ticketmatic.Client, id int64) error
+
+	settings settings.Any
+}
+
+// Untyped access to a settings resource
+func resource(r settings.Any) *Resource {
+	res := &Resource{
+		Name:     r.Name(),
+		Path:     r.Path(),
+		settings: r,
+		List: func(c *ticketmatic.Client) ([]Object, error) {
+			items, err := r.ListObjects(c, false)
+			if err != nil {
+				return nil, err
+			}
+			return toObjects(items)
+		},
+	}
+	if r.CanCreate() {
+		res.Create = func(c *ticketmatic.Client, o Object) (Object, error) {
+			data := r.New()
+			err := apiutil.Convert(o, data)
+			if err != nil {
+				return nil, err
+			}
+			result, err := r.CreateObject(c, data)
+			if err != nil {
+				return nil, err
+			}
+			return toObject(result)
+		}
+	}
+	if r.CanUpdate() {
+		res.Update = func(c *ticketmatic.Client, id int64, o Object) (Object, error) {
+			data := r.New()
+			err := apiutil.Convert(o, data)
+			if err != nil {
+				return nil, err
+			}
+			result, err := r.UpdateObject(c, id, data)
+			if err != nil {
+				return nil, err
+			}
+			return toObject(result)
+		}
+	}
+	if r.CanDelete() {
+		res.Delete = r.Delete
+	}
+	return res
+}
+
+func toObject(v interface{}) (Object, error) {
+	var o Object
+	err := apiutil.Convert(v, &o)
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// All settings resources, in an order where referenced objects come before
+// the objects referring to them.
+var Resources = resources(settings.All)
+
+func resources(all []settings.Any) []*Resource {
+	result := make([]*Resource, 0, len(all))
+	for _, r := range all {
+		result = append(result, resource(r))
+	}
+	return result
+}
+
+// Find a resource by name, nil if unknown
+func Lookup(name string) *Resource {
+	for _, r := range Resources {
+		if r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// List all objects, including archived ones
+func (r *Resource) ListAll(c *ticketmatic.Client) ([]Object, error) {
+	items, err := r.settings.ListObjects(c, true)
+	if err != nil {
+		return nil, err
+	}
+	return toObjects(items)
+}
+
+func toObjects(items []interface{}) ([]Object, error) {
+	result := make([]Object, 0, len(items))
+	for _, item := range items {
+		o, err := toObject(item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, o)
+	}
+	return result, nil
+}
@@ -0,0 +1,195 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Fields managed by Ticketmatic, ignored when comparing and not sent when
+// applying
+var ignoredFields = map[string]bool{
+	"id":           true,
+	"createdts":    true,
+	"lastupdatets": true,
+	"isarchived":   true,
+}
+
+// An object in a snapshot
+type Entry struct {
+	// File the object was read from
+	File string
+
+	Object Object
+}
+
+// The settings of an account, as stored in a directory with a subdirectory
+// per resource and a JSON file per object:
+//
+//	pricetypes/12.json
+//	pricetypes/13.json
+//	saleschannels/1.json
+//
+// Objects without id are created when applied, their file is then replaced by
+// one named after the new id.
+type Snapshot struct {
+	Dir string
+
+	// Objects per resource name, sorted by id (new objects last)
+	Objects map[string][]*Entry
+}
+
+// Select the resources by name, all resources if no names are given
+func selectResources(names []string) ([]*Resource, error) {
+	if len(names) == 0 {
+		return Resources, nil
+	}
+	var result []*Resource
+	for _, r := range Resources {
+		for _, n := range names {
+			if r.Name == n {
+				result = append(result, r)
+			}
+		}
+	}
+	if len(result) != len(names) {
+		for _, n := range names {
+			if Lookup(n) == nil {
+				return nil, fmt.Errorf("Unknown resource: %s", n)
+			}
+		}
+	}
+	return result, nil
+}
+
+// Save the settings of the account to dir. Without resource names, all
+// resources are saved.
+//
+// Existing JSON files in the resource directories are removed, so the
+// directory reflects the account exactly (and works well under version
+// control).
+func Save(client *ticketmatic.Client, dir string, resources ...string) error {
+	res, err := selectResources(resources)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range res {
+		objects, err := r.List(client)
+		if err != nil {
+			return fmt.Errorf("%s: %s", r.Name, err)
+		}
+
+		rdir := filepath.Join(dir, r.Name)
+		err = os.MkdirAll(rdir, 0755)
+		if err != nil {
+			return err
+		}
+		old, err := filepath.Glob(filepath.Join(rdir, "*.json"))
+		if err != nil {
+			return err
+		}
+		for _, f := range old {
+			err = os.Remove(f)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, o := range objects {
+			err = writeObject(objectFile(rdir, o), o)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func objectFile(dir string, o Object) string {
+	return filepath.Join(dir, fmt.Sprintf("%d.json", o.ID()))
+}
+
+func writeObject(path string, o Object) error {
+	data, err := json.MarshalIndent(o, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Read a snapshot directory. Subdirectories that aren't named after a
+// resource are ignored.
+func Load(dir string) (*Snapshot, error) {
+	s := &Snapshot{
+		Dir:     dir,
+		Objects: make(map[string][]*Entry),
+	}
+
+	for _, r := range Resources {
+		files, err := filepath.Glob(filepath.Join(dir, r.Name, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		if files == nil {
+			if fi, err := os.Stat(filepath.Join(dir, r.Name)); err != nil || !fi.IsDir() {
+				continue
+			}
+		}
+
+		entries := make([]*Entry, 0, len(files))
+		for _, f := range files {
+			data, err := ioutil.ReadFile(f)
+			if err != nil {
+				return nil, err
+			}
+			var o Object
+			err = json.Unmarshal(data, &o)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid JSON in %s: %s", f, err)
+			}
+			entries = append(entries, &Entry{
+				File:   f,
+				Object: o,
+			})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			a, b := entries[i].Object.ID(), entries[j].Object.ID()
+			if (a == 0) != (b == 0) {
+				// New objects last
+				return b == 0
+			}
+			if a != b {
+				return a < b
+			}
+			return entries[i].File < entries[j].File
+		})
+		s.Objects[r.Name] = entries
+	}
+	return s, nil
+}
+
+// Describe a value for display
+func describe(v interface{}) string {
+	if v == nil {
+		return "null"
+	}
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	s := strings.TrimSuffix(b.String(), "\n")
+	if len(s) > 80 {
+		s = s[:77] + "..."
+	}
+	return s
+}
@@ -0,0 +1,201 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/internal/apitest"
+)
+
+const pricetypesPath = "settings/pricing/pricetypes"
+
+func setup(t *testing.T) (*apitest.Account, *ticketmatic.Client) {
+	account := apitest.New(map[string][]map[string]interface{}{
+		pricetypesPath: {
+			{"id": 1, "name": "Regular", "typeid": 2301, "remark": ""},
+			{"id": 2, "name": "Free", "typeid": 2304, "remark": "Invitations"},
+		},
+	})
+	account.LastID = 2
+	return account, account.Client(t)
+}
+
+func TestSnapshotPlanApply(t *testing.T) {
+	account, c := setup(t)
+	dir := t.TempDir()
+
+	err := Save(c, dir, "pricetypes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "pricetypes", "*.json"))
+	if len(files) != 2 {
+		t.Fatalf("Unexpected files, got %v", files)
+	}
+
+	snap, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := MakePlan(c, snap, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() {
+		t.Fatalf("Expected an empty plan, got %s", plan)
+	}
+
+	// Rename one, add one, remove one
+	err = ioutil.WriteFile(filepath.Join(dir, "pricetypes", "1.json"), []byte(`{"id": 1, "name": "Regular price"}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "pricetypes", "student.json"), []byte(`{"name": "Student", "typeid": 2302}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Remove(filepath.Join(dir, "pricetypes", "2.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snap, err = Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err = MakePlan(c, snap, &PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `~ pricetypes/1 "Regular price"
+    name: "Regular" -> "Regular price"
++ pricetypes "Student"
+- pricetypes/2 "Free"
+1 to create, 1 to update, 1 to delete
+`
+	if plan.String() != expected {
+		t.Errorf("Unexpected plan, got:\n%s\nexpected:\n%s", plan, expected)
+	}
+
+	// Dry run doesn't change anything
+	var log bytes.Buffer
+	err = Apply(c, plan, &ApplyOptions{DryRun: true, Log: &log})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests := account.Requests(pricetypesPath); len(requests) != 3 {
+		t.Errorf("Unexpected requests, got %d", len(requests))
+	}
+	if !strings.Contains(log.String(), `Would create pricetypes "Student"`) {
+		t.Errorf("Unexpected log, got %s", log.String())
+	}
+
+	log.Reset()
+	err = Apply(c, plan, &ApplyOptions{Log: &log})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedLog := `Updated pricetypes/1 "Regular price"
+Created pricetypes/3 "Student"
+Deleted pricetypes/2 "Free"
+`
+	if log.String() != expectedLog {
+		t.Errorf("Unexpected log, got:\n%s\nexpected:\n%s", log.String(), expectedLog)
+	}
+
+	if name := account.Object(pricetypesPath, 1)["name"]; name != "Regular price" {
+		t.Errorf("Unexpected name, got %v, expected %v", name, "Regular price")
+	}
+	if typeid := account.Object(pricetypesPath, 1)["typeid"]; typeid != float64(2301) {
+		t.Errorf("Unmanaged field changed, got %v, expected %v", typeid, 2301)
+	}
+	if account.Object(pricetypesPath, 2) != nil {
+		t.Errorf("Expected price type 2 to be deleted")
+	}
+
+	// The new object got its id
+	if _, err := os.Stat(filepath.Join(dir, "pricetypes", "3.json")); err != nil {
+		t.Error(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "pricetypes", "student.json")); !os.IsNotExist(err) {
+		t.Errorf("Expected student.json to be replaced")
+	}
+
+	// Idempotent
+	snap, err = Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err = MakePlan(c, snap, &PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() {
+		t.Errorf("Expected an empty plan, got %s", plan)
+	}
+}
+
+func TestPlanUnknownID(t *testing.T) {
+	_, c := setup(t)
+	dir := t.TempDir()
+
+	err := os.MkdirAll(filepath.Join(dir, "pricetypes"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "pricetypes", "99.json"), []byte(`{"id": 99, "name": "Gone"}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snap, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = MakePlan(c, snap, nil)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestDiffValue(t *testing.T) {
+	old := map[string]interface{}{"a": 1.0, "b": []interface{}{1.0, 2.0}, "c": map[string]interface{}{"x": "y"}}
+	new := map[string]interface{}{"a": 1.0, "b": []interface{}{1.0, 3.0}, "c": map[string]interface{}{"x": "z", "w": true}}
+
+	fields := diffValue(nil, "obj", old, new)
+	var result []string
+	for _, f := range fields {
+		result = append(result, f.String())
+	}
+	expected := []string{`obj.b[1]: 2 -> 3`, `obj.c.w: null -> true`, `obj.c.x: "y" -> "z"`}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Unexpected diff, got %#v, expected %#v", result, expected)
+	}
+}
+
+func TestResourceOrder(t *testing.T) {
+	index := make(map[string]int)
+	for i, r := range Resources {
+		index[r.Name] = i
+	}
+	for name, refs := range References {
+		i, ok := index[name]
+		if !ok {
+			t.Errorf("Unknown resource %s", name)
+			continue
+		}
+		for _, ref := range refs {
+			j, ok := index[ref.Resource]
+			if !ok || j >= i {
+				t.Errorf("Expected %s before %s, which refers to it in %s", ref.Resource, name, ref.Path)
+			}
+		}
+	}
+}
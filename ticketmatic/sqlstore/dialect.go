@@ -0,0 +1,88 @@
+package sqlstore
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kind of column
+type Kind int
+
+const (
+	KindInt Kind = iota
+	KindFloat
+	KindBool
+	KindText
+	KindTime
+	KindJSON
+)
+
+// SQL flavor: column types and placeholders
+type Dialect struct {
+	Name string
+
+	// Use numbered placeholders ($1, $2) instead of ?
+	Numbered bool
+
+	// Column type per kind
+	Types map[Kind]string
+}
+
+var (
+	SQLite = &Dialect{
+		Name: "sqlite",
+		Types: map[Kind]string{
+			KindInt:   "INTEGER",
+			KindFloat: "REAL",
+			KindBool:  "BOOLEAN",
+			KindText:  "TEXT",
+			KindTime:  "TIMESTAMP",
+			KindJSON:  "TEXT",
+		},
+	}
+
+	PostgreSQL = &Dialect{
+		Name:     "postgres",
+		Numbered: true,
+		Types: map[Kind]string{
+			KindInt:   "BIGINT",
+			KindFloat: "DOUBLE PRECISION",
+			KindBool:  "BOOLEAN",
+			KindText:  "TEXT",
+			KindTime:  "TIMESTAMP WITH TIME ZONE",
+			KindJSON:  "JSONB",
+		},
+	}
+
+	MySQL = &Dialect{
+		Name: "mysql",
+		Types: map[Kind]string{
+			KindInt:   "BIGINT",
+			KindFloat: "DOUBLE",
+			KindBool:  "BOOLEAN",
+			KindText:  "LONGTEXT",
+			KindTime:  "DATETIME(6)",
+			KindJSON:  "JSON",
+		},
+	}
+)
+
+// Format a query, replacing the ? placeholders if needed
+func (d *Dialect) query(format string, args ...interface{}) string {
+	q := fmt.Sprintf(format, args...)
+	if !d.Numbered {
+		return q
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, c := range q {
+		if c == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+		} else {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
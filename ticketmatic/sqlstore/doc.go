@@ -0,0 +1,23 @@
+// Store orders, tickets, payments, contacts and events in tables of any
+// database/sql database.
+//
+// A Table maps a type to columns: scalar fields become columns of the
+// matching type, maps, slices and nested objects are stored as JSON and
+// custom fields get a c_<key> column of their own:
+//
+//	fields, err := sqlstore.LoadCustomFields(client)
+//	for _, t := range sqlstore.Tables(fields) {
+//		_, err = db.Exec(t.CreateSQL(sqlstore.PostgreSQL))
+//	}
+//
+//	orders := sqlstore.Orders(fields[ticketmatic.CustomFieldObjectTypeOrder])
+//	err = orders.Upsert(db, sqlstore.PostgreSQL, order)
+//
+// Upserts update the row and insert it when nothing was updated, which works
+// with every driver. Wrap them in a transaction when storing many rows.
+//
+// The ticketmatic.Time and ticketmatic.Money types implement sql.Scanner and
+// driver.Valuer, JSON does the same for maps and slices:
+//
+//	err = row.Scan(&order.Orderid, &order.Createdts, sqlstore.JSON(&order.Lookup))
+package sqlstore
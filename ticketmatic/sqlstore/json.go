@@ -0,0 +1,69 @@
+package sqlstore
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Stores a map, slice or object as JSON. Empty values are stored as NULL.
+//
+//	_, err = db.Exec("UPDATE tm_orders SET lookup = ? WHERE orderid = ?", sqlstore.JSON(order.Lookup), order.Orderid)
+//	err = row.Scan(sqlstore.JSON(&order.Lookup))
+//
+// Scan needs a pointer.
+type JSONValue struct {
+	V interface{}
+}
+
+// Wrap a value to store it as JSON
+func JSON(v interface{}) JSONValue {
+	return JSONValue{V: v}
+}
+
+func (j JSONValue) Value() (driver.Value, error) {
+	if isEmpty(reflect.ValueOf(j.V)) {
+		return nil, nil
+	}
+	data, err := json.Marshal(j.V)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (j JSONValue) Scan(src interface{}) error {
+	v := reflect.ValueOf(j.V)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("Cannot scan into %T, need a pointer", j.V)
+	}
+
+	var data []byte
+	switch s := src.(type) {
+	case nil:
+		v.Elem().Set(reflect.Zero(v.Elem().Type()))
+		return nil
+	case string:
+		data = []byte(s)
+	case []byte:
+		data = s
+	default:
+		return fmt.Errorf("Cannot scan %T as JSON", src)
+	}
+	// Start from the zero value, Unmarshal merges into existing maps
+	v.Elem().Set(reflect.Zero(v.Elem().Type()))
+	return json.Unmarshal(data, j.V)
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,244 @@
+package sqlstore
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestCreateSQL(t *testing.T) {
+	table := Orders([]*ticketmatic.CustomField{
+		{Key: "vip", Fieldtypeid: int64(ticketmatic.CustomFieldFieldTypeBoolean)},
+		{Key: "old", Fieldtypeid: int64(ticketmatic.CustomFieldFieldTypeString), Isarchived: true},
+	})
+	ddl := table.CreateSQL(SQLite)
+
+	for _, s := range []string{
+		"CREATE TABLE IF NOT EXISTS tm_orders (\n  orderid INTEGER PRIMARY KEY,\n",
+		"  lookup TEXT,\n",
+		"  createdts TIMESTAMP,\n",
+		"  totalamount REAL,\n",
+		"  c_vip BOOLEAN\n)",
+	} {
+		if !strings.Contains(ddl, s) {
+			t.Errorf("Expected %#v in %s", s, ddl)
+		}
+	}
+	for _, s := range []string{"  tickets ", "  payments ", "c_old"} {
+		if strings.Contains(ddl, s) {
+			t.Errorf("Unexpected %#v in %s", s, ddl)
+		}
+	}
+
+	q := PostgreSQL.query("UPDATE t SET a = ?, b = ? WHERE c = ?")
+	if q != "UPDATE t SET a = $1, b = $2 WHERE c = $3" {
+		t.Errorf("Unexpected query, got %#v", q)
+	}
+}
+
+func TestUpsert(t *testing.T) {
+	db, err := sql.Open("sqlstoretest", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	table := Orders([]*ticketmatic.CustomField{
+		{Key: "vip", Fieldtypeid: int64(ticketmatic.CustomFieldFieldTypeBoolean)},
+		{Key: "since", Fieldtypeid: int64(ticketmatic.CustomFieldFieldTypeDate)},
+		{Key: "note", Fieldtypeid: int64(ticketmatic.CustomFieldFieldTypeString)},
+	})
+	created := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	order := &ticketmatic.Order{
+		Orderid:      12,
+		Code:         "ABC",
+		Totalamount:  25.5,
+		Createdts:    ticketmatic.NewTime(created),
+		Lookup:       map[string]interface{}{"a": "b"},
+		Promocodes:   []string{"SPRING"},
+		Tickets:      []*ticketmatic.OrderTicket{{Id: 1}},
+		CustomFields: map[string]interface{}{"vip": true, "since": "2026-01-02"},
+	}
+	err = table.Upsert(db, SQLite, order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	order.Code = "DEF"
+	err = table.Upsert(db, SQLite, []*ticketmatic.Order{order})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := fakeTables[t.Name()]["tm_orders"]
+	if len(rows) != 1 {
+		t.Fatalf("Unexpected rows, got %d, expected 1", len(rows))
+	}
+
+	var result ticketmatic.Order
+	err = table.Scan(db.QueryRow(table.SelectSQL()), &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Orderid != 12 || result.Code != "DEF" || result.Totalamount != 25.5 {
+		t.Errorf("Unexpected order, got %#v", result)
+	}
+	if !result.Createdts.Time().Equal(created) {
+		t.Errorf("Unexpected createdts, got %s, expected %s", result.Createdts.Time(), created)
+	}
+	if !reflect.DeepEqual(result.Lookup, order.Lookup) {
+		t.Errorf("Unexpected lookup, got %#v, expected %#v", result.Lookup, order.Lookup)
+	}
+	if !reflect.DeepEqual(result.Promocodes, order.Promocodes) {
+		t.Errorf("Unexpected promocodes, got %#v, expected %#v", result.Promocodes, order.Promocodes)
+	}
+	if result.Tickets != nil || result.Payments != nil {
+		t.Errorf("Unexpected tickets, got %#v", result.Tickets)
+	}
+	since, _ := ticketmatic.ParseTime("2026-01-02")
+	expected := map[string]interface{}{
+		"vip":   true,
+		"since": since.Format("2006-01-02T15:04:05.999999"),
+	}
+	if !reflect.DeepEqual(result.CustomFields, expected) {
+		t.Errorf("Unexpected custom fields, got %#v, expected %#v", result.CustomFields, expected)
+	}
+
+	err = table.Delete(db, SQLite, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 0 {
+		t.Errorf("Unexpected rows, got %d, expected 0", len(rows))
+	}
+}
+
+func TestJSON(t *testing.T) {
+	v, err := JSON(map[string]string{}).Value()
+	if err != nil || v != nil {
+		t.Errorf("Unexpected value, got %#v, expected nil", v)
+	}
+
+	m := map[string]string{"old": "x"}
+	err = JSON(&m).Scan([]byte(`{"a":"b"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m, map[string]string{"a": "b"}) {
+		t.Errorf("Unexpected map, got %#v", m)
+	}
+	err = JSON(&m).Scan(nil)
+	if err != nil || m != nil {
+		t.Errorf("Unexpected map, got %#v", m)
+	}
+	err = JSON(m).Scan("{}")
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+// A minimal database/sql driver that understands the statements of Table.
+// Rows are kept per database name and table, by key.
+var fakeTables = make(map[string]map[string]map[string][]driver.Value)
+
+func init() {
+	sql.Register("sqlstoretest", fakeDriver{})
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	if fakeTables[name] == nil {
+		fakeTables[name] = make(map[string]map[string][]driver.Value)
+	}
+	return &fakeConn{tables: fakeTables[name]}, nil
+}
+
+type fakeConn struct {
+	tables map[string]map[string][]driver.Value
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, fields: strings.Fields(query)}, nil
+}
+
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, fmt.Errorf("Not supported") }
+
+type fakeStmt struct {
+	conn   *fakeConn
+	fields []string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) table(after string) map[string][]driver.Value {
+	for i, f := range s.fields {
+		if f == after {
+			name := s.fields[i+1]
+			if s.conn.tables[name] == nil {
+				s.conn.tables[name] = make(map[string][]driver.Value)
+			}
+			return s.conn.tables[name]
+		}
+	}
+	return nil
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	switch s.fields[0] {
+	case "UPDATE":
+		table, key := s.table("UPDATE"), fmt.Sprint(args[len(args)-1])
+		if table[key] == nil {
+			return driver.RowsAffected(0), nil
+		}
+		table[key] = append([]driver.Value{args[len(args)-1]}, args[:len(args)-1]...)
+	case "INSERT":
+		s.table("INTO")[fmt.Sprint(args[0])] = args
+	case "DELETE":
+		delete(s.table("FROM"), fmt.Sprint(args[0]))
+	default:
+		return nil, fmt.Errorf("Unexpected statement: %s", strings.Join(s.fields, " "))
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.fields[0] != "SELECT" {
+		return nil, fmt.Errorf("Unexpected query: %s", strings.Join(s.fields, " "))
+	}
+	rows := &fakeRows{}
+	for _, f := range s.fields[1:] {
+		if f == "FROM" {
+			break
+		}
+		rows.columns = append(rows.columns, strings.TrimSuffix(f, ","))
+	}
+	for _, row := range s.table("FROM") {
+		rows.rows = append(rows.rows, row)
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
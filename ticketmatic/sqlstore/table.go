@@ -0,0 +1,387 @@
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// A column of a table
+type Column struct {
+	Name string
+	Kind Kind
+
+	// Index of the struct field, nil for custom fields
+	index []int
+
+	// Key of the custom field
+	custom string
+}
+
+// Maps a type to a table. The first column is the primary key.
+type Table struct {
+	Name    string
+	Columns []*Column
+
+	typ reflect.Type
+
+	// Index of the CustomFields field, nil if the type has none
+	customIndex []int
+}
+
+// Executes statements: *sql.DB, *sql.Tx or *sql.Conn
+type Execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// A row to scan: *sql.Row or *sql.Rows
+type Row interface {
+	Scan(dest ...interface{}) error
+}
+
+var timeType = reflect.TypeOf(ticketmatic.Time{})
+
+// Map a type to a table, v is a pointer to a struct such as
+// (*ticketmatic.Order)(nil). The first field becomes the primary key.
+// Archived custom fields are skipped, as are the omitted fields (by JSON
+// name).
+//
+// Panics if v isn't a pointer to a struct.
+func NewTable(name string, v interface{}, fields []*ticketmatic.CustomField, omit ...string) *Table {
+	typ := reflect.TypeOf(v)
+	if typ == nil || typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("sqlstore: need a pointer to a struct, got %T", v))
+	}
+	typ = typ.Elem()
+
+	skip := make(map[string]bool)
+	for _, o := range omit {
+		skip[o] = true
+	}
+
+	t := &Table{Name: name, typ: typ}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.Name == "CustomFields" {
+			t.customIndex = f.Index
+			continue
+		}
+		tag := strings.Split(f.Tag.Get("json"), ",")[0]
+		if tag == "" || tag == "-" || skip[tag] {
+			continue
+		}
+		t.Columns = append(t.Columns, &Column{Name: tag, Kind: kindOf(f.Type), index: f.Index})
+	}
+
+	if t.customIndex != nil {
+		for _, cf := range fields {
+			if cf.Isarchived {
+				continue
+			}
+			t.Columns = append(t.Columns, &Column{
+				Name:   "c_" + cf.Key,
+				Kind:   customKind(ticketmatic.CustomFieldFieldType(cf.Fieldtypeid)),
+				custom: cf.Key,
+			})
+		}
+	}
+	return t
+}
+
+func kindOf(typ reflect.Type) Kind {
+	if typ == timeType {
+		return KindTime
+	}
+	switch typ.Kind() {
+	case reflect.Int64:
+		return KindInt
+	case reflect.Float64:
+		return KindFloat
+	case reflect.Bool:
+		return KindBool
+	case reflect.String:
+		return KindText
+	}
+	return KindJSON
+}
+
+func customKind(typ ticketmatic.CustomFieldFieldType) Kind {
+	switch typ {
+	case ticketmatic.CustomFieldFieldTypeInteger:
+		return KindInt
+	case ticketmatic.CustomFieldFieldTypeDecimal:
+		return KindFloat
+	case ticketmatic.CustomFieldFieldTypeBoolean:
+		return KindBool
+	case ticketmatic.CustomFieldFieldTypeDate:
+		return KindTime
+	case ticketmatic.CustomFieldFieldTypeMultiLanguageString, ticketmatic.CustomFieldFieldTypeMultiLanguageText:
+		return KindJSON
+	}
+	return KindText
+}
+
+// Name of the primary key column
+func (t *Table) Key() string {
+	return t.Columns[0].Name
+}
+
+// CREATE TABLE statement for the table
+func (t *Table) CreateSQL(d *Dialect) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE TABLE IF NOT EXISTS %s (\n", t.Name)
+	for i, c := range t.Columns {
+		fmt.Fprintf(&b, "  %s %s", c.Name, d.Types[c.Kind])
+		if i == 0 {
+			b.WriteString(" PRIMARY KEY")
+		}
+		if i < len(t.Columns)-1 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+// SELECT statement for all columns, in the order Scan expects them
+func (t *Table) SelectSQL() string {
+	return fmt.Sprintf("SELECT %s FROM %s", strings.Join(t.names(), ", "), t.Name)
+}
+
+func (t *Table) names() []string {
+	names := make([]string, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+// Column values of an object, in column order
+func (t *Table) Values(obj interface{}) ([]interface{}, error) {
+	v, err := t.object(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var custom map[string]interface{}
+	if t.customIndex != nil {
+		custom, _ = v.FieldByIndex(t.customIndex).Interface().(map[string]interface{})
+	}
+
+	result := make([]interface{}, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		if c.index == nil {
+			val, err := customValue(c, custom[c.custom])
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, val)
+			continue
+		}
+
+		f := v.FieldByIndex(c.index)
+		if c.Kind == KindJSON {
+			result = append(result, JSON(f.Interface()))
+		} else {
+			result = append(result, f.Interface())
+		}
+	}
+	return result, nil
+}
+
+// Database value of a custom field as decoded from JSON
+func customValue(c *Column, val interface{}) (interface{}, error) {
+	if val == nil {
+		return nil, nil
+	}
+	switch c.Kind {
+	case KindInt:
+		if f, ok := val.(float64); ok {
+			return int64(f), nil
+		}
+	case KindTime:
+		s, ok := val.(string)
+		if !ok || s == "" {
+			return nil, nil
+		}
+		ts, err := ticketmatic.ParseTime(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", c.Name, err)
+		}
+		return ts, nil
+	case KindJSON:
+		return JSON(val), nil
+	case KindText:
+		if _, ok := val.(string); !ok {
+			return fmt.Sprint(val), nil
+		}
+	}
+	return val, nil
+}
+
+// Scan a row selected with SelectSQL into obj
+func (t *Table) Scan(row Row, obj interface{}) error {
+	v, err := t.object(obj)
+	if err != nil {
+		return err
+	}
+
+	dest := make([]interface{}, 0, len(t.Columns))
+	custom := make(map[*Column]*rawValue)
+	for _, c := range t.Columns {
+		if c.index == nil {
+			raw := &rawValue{}
+			custom[c] = raw
+			dest = append(dest, raw)
+			continue
+		}
+
+		f := v.FieldByIndex(c.index).Addr().Interface()
+		if c.Kind == KindJSON {
+			dest = append(dest, JSON(f))
+		} else {
+			dest = append(dest, f)
+		}
+	}
+
+	err = row.Scan(dest...)
+	if err != nil {
+		return err
+	}
+
+	if t.customIndex == nil {
+		return nil
+	}
+	fields := make(map[string]interface{})
+	for _, c := range t.Columns {
+		if c.index != nil || custom[c].v == nil {
+			continue
+		}
+		val, err := custom[c].decode(c.Kind)
+		if err != nil {
+			return fmt.Errorf("%s: %s", c.Name, err)
+		}
+		fields[c.custom] = val
+	}
+	v.FieldByIndex(t.customIndex).Set(reflect.ValueOf(fields))
+	return nil
+}
+
+// Holds a scanned value
+type rawValue struct {
+	v interface{}
+}
+
+func (r *rawValue) Scan(src interface{}) error {
+	if b, ok := src.([]byte); ok {
+		// Drivers may reuse the buffer
+		src = string(b)
+	}
+	r.v = src
+	return nil
+}
+
+// Convert a scanned value to the form decoded from JSON
+func (r *rawValue) decode(kind Kind) (interface{}, error) {
+	switch v := r.v.(type) {
+	case int64:
+		if kind == KindBool {
+			return v != 0, nil
+		}
+		return float64(v), nil
+	case time.Time:
+		data, err := ticketmatic.NewTime(v).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		return strings.Trim(string(data), `"`), nil
+	case string:
+		if kind == KindJSON {
+			var result interface{}
+			err := json.Unmarshal([]byte(v), &result)
+			return result, err
+		}
+	}
+	return r.v, nil
+}
+
+func (t *Table) object(obj interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Type() != t.typ {
+		return v, fmt.Errorf("Expected *%s, got %T", t.typ, obj)
+	}
+	return v.Elem(), nil
+}
+
+// Store objects, updating the rows that exist and inserting the others.
+// Slices of objects are stored element by element.
+//
+// MySQL reports unchanged rows as not updated, connect with
+// clientFoundRows=true to make the updates of unchanged rows work.
+func (t *Table) Upsert(db Execer, d *Dialect, objects ...interface{}) error {
+	names := t.names()
+	sets := make([]string, 0, len(names)-1)
+	for _, n := range names[1:] {
+		sets = append(sets, n+" = ?")
+	}
+	update := d.query("UPDATE %s SET %s WHERE %s = ?", t.Name, strings.Join(sets, ", "), t.Key())
+	insert := d.query("INSERT INTO %s (%s) VALUES (%s)", t.Name, strings.Join(names, ", "), strings.TrimSuffix(strings.Repeat("?, ", len(names)), ", "))
+
+	for _, obj := range expand(objects) {
+		vals, err := t.Values(obj)
+		if err != nil {
+			return err
+		}
+
+		res, err := db.Exec(update, append(vals[1:], vals[0])...)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			continue
+		}
+		_, err = db.Exec(insert, vals...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove rows by primary key
+func (t *Table) Delete(db Execer, d *Dialect, ids ...int64) error {
+	q := d.query("DELETE FROM %s WHERE %s = ?", t.Name, t.Key())
+	for _, id := range ids {
+		_, err := db.Exec(q, id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flatten slices of objects
+func expand(objects []interface{}) []interface{} {
+	var result []interface{}
+	for _, obj := range objects {
+		v := reflect.ValueOf(obj)
+		if v.Kind() != reflect.Slice {
+			result = append(result, obj)
+			continue
+		}
+		for i := 0; i < v.Len(); i++ {
+			result = append(result, v.Index(i).Interface())
+		}
+	}
+	return result
+}
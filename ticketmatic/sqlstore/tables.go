@@ -0,0 +1,74 @@
+package sqlstore
+
+import (
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/settings/system/customfields"
+)
+
+// Custom fields per object type
+type CustomFields map[ticketmatic.CustomFieldObjectType][]*ticketmatic.CustomField
+
+// Object types of the custom fields that get columns
+var customFieldTypes = []ticketmatic.CustomFieldObjectType{
+	ticketmatic.CustomFieldObjectTypeOrder,
+	ticketmatic.CustomFieldObjectTypeContact,
+	ticketmatic.CustomFieldObjectTypeEvent,
+	ticketmatic.CustomFieldObjectTypeTicket,
+}
+
+// Load the custom fields of orders, contacts, events and tickets
+func LoadCustomFields(client *ticketmatic.Client) (CustomFields, error) {
+	result := make(CustomFields)
+	for _, typ := range customFieldTypes {
+		l, err := customfields.Getlist(client, &ticketmatic.CustomFieldQuery{
+			Typeid: int64(typ),
+		})
+		if err != nil {
+			return nil, err
+		}
+		result[typ] = l.Data
+	}
+	return result, nil
+}
+
+// Orders, without tickets and payments: those have tables of their own
+func Orders(fields []*ticketmatic.CustomField) *Table {
+	return NewTable("tm_orders", (*ticketmatic.Order)(nil), fields, "tickets", "payments")
+}
+
+// Tickets of orders
+func OrderTickets() *Table {
+	return NewTable("tm_ordertickets", (*ticketmatic.OrderTicket)(nil), nil)
+}
+
+// Payments of orders
+func Payments() *Table {
+	return NewTable("tm_payments", (*ticketmatic.Payment)(nil), nil)
+}
+
+// Contacts
+func Contacts(fields []*ticketmatic.CustomField) *Table {
+	return NewTable("tm_contacts", (*ticketmatic.Contact)(nil), fields)
+}
+
+// Events
+func Events(fields []*ticketmatic.CustomField) *Table {
+	return NewTable("tm_events", (*ticketmatic.Event)(nil), fields)
+}
+
+// Tickets of events, with the ticket custom fields
+func EventTickets(fields []*ticketmatic.CustomField) *Table {
+	return NewTable("tm_eventtickets", (*ticketmatic.EventTicket)(nil), fields)
+}
+
+// All tables, fields can be nil
+func Tables(fields CustomFields) []*Table {
+	return []*Table{
+		Orders(fields[ticketmatic.CustomFieldObjectTypeOrder]),
+		OrderTickets(),
+		Payments(),
+		Contacts(fields[ticketmatic.CustomFieldObjectTypeContact]),
+		Events(fields[ticketmatic.CustomFieldObjectTypeEvent]),
+		EventTickets(fields[ticketmatic.CustomFieldObjectTypeTicket]),
+	}
+}
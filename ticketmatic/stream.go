@@ -5,11 +5,15 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"time"
 )
 
 type Stream struct {
 	resp   *http.Response
 	reader *bufio.Reader
+
+	// Timezone of the client, see Client.Location
+	loc *time.Location
 }
 
 func NewStream(resp *http.Response) *Stream {
@@ -29,7 +33,12 @@ func (s *Stream) Next(obj interface{}) error {
 		return io.EOF
 	}
 
-	return json.Unmarshal(line, obj)
+	err = json.Unmarshal(line, obj)
+	if err != nil {
+		return err
+	}
+	SetLocation(obj, s.loc)
+	return nil
 }
 
 func (s *Stream) Close() {
@@ -1,15 +1,28 @@
 package ticketmatic
 
 import (
+	"database/sql/driver"
 	"fmt"
+	"strings"
 	"time"
 )
 
 // Time is a wrapper that takes care of correct time serialization. You can
 // easily convert from/to a normal time.Time using the NewTime() and Time()
 // functions.
+//
+// Ticketmatic mostly returns timestamps without offset, in the timezone of
+// the account. These are kept as a wall clock until the timezone is known:
+// the client interprets them in its Location, Time() falls back to
+// time.Local.
 type Time struct {
 	ts time.Time
+
+	// ts is a wall clock (stored as UTC) without timezone
+	floating bool
+
+	// Timezone to write the timestamp in, nil for the timezone of ts
+	loc *time.Location
 }
 
 // Wrap a time.Time into a ticketmatic.Time
@@ -17,11 +30,87 @@ func NewTime(t time.Time) Time {
 	return Time{ts: t}
 }
 
-// Convert a ticketmatic.Time into a regular time.Time
+// A date without time or timezone, such as a birth date. It is never shifted
+// by the timezone of the client.
+func NewDate(year int, month time.Month, day int) Time {
+	return Time{ts: time.Date(year, month, day, 0, 0, 0, 0, time.UTC), floating: true}
+}
+
+// Convert a ticketmatic.Time into a regular time.Time. Timestamps without
+// timezone are interpreted in time.Local.
 func (t Time) Time() time.Time {
+	if t.floating {
+		return wallClock(t.ts, time.Local)
+	}
 	return t.ts
 }
 
+// The timestamp in a timezone. Timestamps without timezone are interpreted in
+// loc, and loc is used when writing the timestamp to the API. A nil loc
+// leaves the timestamp as is.
+func (t Time) In(loc *time.Location) Time {
+	if loc == nil {
+		return t
+	}
+	if t.floating {
+		t.ts = wallClock(t.ts, loc)
+		t.floating = false
+	} else {
+		t.ts = t.ts.In(loc)
+	}
+	t.loc = loc
+	return t
+}
+
+// Date of the timestamp. Timestamps without timezone (such as birth dates)
+// keep the date as read from the API, regardless of timezones.
+func (t Time) Date() (year int, month time.Month, day int) {
+	return t.ts.Date()
+}
+
+// Whether the time is unset
+func (t Time) IsZero() bool {
+	return t.ts.IsZero()
+}
+
+// Whether t is before u
+func (t Time) Before(u Time) bool {
+	return t.Time().Before(u.Time())
+}
+
+// Whether t is after u
+func (t Time) After(u Time) bool {
+	return t.Time().After(u.Time())
+}
+
+// Whether t and u are the same instant, regardless of timezone
+func (t Time) Equal(u Time) bool {
+	return t.Time().Equal(u.Time())
+}
+
+// Compare t to u: -1 if t is before u, +1 if t is after u, 0 if they're
+// equal. Useful with slices.SortFunc.
+func (t Time) Compare(u Time) int {
+	return t.Time().Compare(u.Time())
+}
+
+// The timestamp as written to the API ("2015-04-09T14:19:49"), empty when
+// unset
+func (t Time) String() string {
+	if t.ts.IsZero() {
+		return ""
+	}
+	return t.format()
+}
+
+func (t Time) format() string {
+	ts := t.ts
+	if t.loc != nil && !t.floating {
+		ts = ts.In(t.loc)
+	}
+	return ts.Format("2006-01-02T15:04:05.999999")
+}
+
 // Custom unmarshalling to handle the different time formats that may be
 // returned by Ticketmatic.
 func (t *Time) UnmarshalJSON(data []byte) error {
@@ -33,27 +122,170 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	}
 
 	s = s[1 : len(s)-1]
-	ts, err := ParseTime(s)
+	return t.parse(s)
+}
+
+// Marshal Time to JSON. Timestamps are written without offset, in the
+// timezone of the client (when set, see Client.Location).
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.ts.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(fmt.Sprintf(`"%s"`, t.String())), nil
+}
+
+// Marshal Time to text (used for map keys, query strings and configuration
+// files), in the same format as JSON. Unset times give an empty string.
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Unmarshal Time from text, in any of the formats accepted by ParseTime. An
+// empty string gives an unset time.
+func (t *Time) UnmarshalText(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if s == "" {
+		*t = Time{}
+		return nil
+	}
+	return t.parse(s)
+}
+
+func (t *Time) parse(s string) error {
+	ts, floating, err := parseTime(s)
 	if err != nil {
 		return err
 	}
-	t.ts = ts
+	*t = Time{ts: ts, floating: floating}
 	return nil
 }
 
-// Marshal Time to JSON.
-func (t Time) MarshalJSON() ([]byte, error) {
+// Store Time in a database column, zero times are stored as NULL.
+func (t Time) Value() (driver.Value, error) {
 	if t.ts.IsZero() {
+		return nil, nil
+	}
+	return t.Time(), nil
+}
+
+// Read Time from a database column. Accepts timestamps, NULL and the string
+// formats accepted by ParseTime.
+func (t *Time) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = Time{}
+	case time.Time:
+		*t = Time{ts: v}
+	case string:
+		return t.scanString(v)
+	case []byte:
+		return t.scanString(string(v))
+	default:
+		return fmt.Errorf("Cannot scan %T into Time", src)
+	}
+	return nil
+}
+
+func (t *Time) scanString(s string) error {
+	if s == "" {
+		*t = Time{}
+		return nil
+	}
+	return t.parse(s)
+}
+
+// A Time that may be null. Unlike Time, it tells a JSON null (or NULL column)
+// apart from a time that is set to the zero value.
+type NullTime struct {
+	Time  Time
+	Valid bool
+}
+
+// Wrap a time.Time into a valid NullTime
+func NewNullTime(t time.Time) NullTime {
+	return NullTime{Time: NewTime(t), Valid: true}
+}
+
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(fmt.Sprintf(`"%s"`, t.ts.Format("2006-01-02T15:04:05.999999"))), nil
+	return []byte(fmt.Sprintf(`"%s"`, n.Time.format())), nil
+}
+
+func (n *NullTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullTime{}
+		return nil
+	}
+	err := n.Time.UnmarshalJSON(data)
+	n.Valid = err == nil
+	return err
+}
+
+// Empty text when null
+func (n NullTime) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return []byte(n.Time.format()), nil
 }
 
-// Parse Ticketmatic timestamps
+func (n *NullTime) UnmarshalText(data []byte) error {
+	err := n.Time.UnmarshalText(data)
+	n.Valid = err == nil && len(strings.TrimSpace(string(data))) > 0
+	return err
+}
+
+// The time, "null" when not valid
+func (n NullTime) String() string {
+	if !n.Valid {
+		return "null"
+	}
+	return n.Time.format()
+}
+
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Time.Time(), nil
+}
+
+func (n *NullTime) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullTime{}
+		return nil
+	}
+	err := n.Time.Scan(src)
+	n.Valid = err == nil
+	return err
+}
+
+// Parse Ticketmatic timestamps. Timestamps without offset are interpreted in
+// time.Local.
 func ParseTime(s string) (time.Time, error) {
+	return ParseTimeIn(s, time.Local)
+}
+
+// Parse Ticketmatic timestamps, interpreting timestamps without offset in loc
+func ParseTimeIn(s string, loc *time.Location) (time.Time, error) {
+	ts, floating, err := parseTime(s)
+	if err != nil {
+		return ts, err
+	}
+	if floating {
+		ts = wallClock(ts, loc)
+	}
+	return ts, nil
+}
+
+// Parse a timestamp. Timestamps without offset are returned as a wall clock
+// in UTC, with floating set.
+func parseTime(s string) (ts time.Time, floating bool, err error) {
 	t, err := time.Parse(time.RFC3339, s)
 	if err == nil {
-		return t, nil
+		return t, false, nil
 	}
 
 	if len(s) >= 19 {
@@ -66,16 +298,25 @@ func ParseTime(s string) (time.Time, error) {
 
 		// "2015-04-09T14:19:49"
 		if len(s) == 19 {
-			return time.ParseInLocation("2006-01-02T15:04:05", s, time.Local)
+			t, err = time.Parse("2006-01-02T15:04:05", s)
 		} else {
-			return time.ParseInLocation("2006-01-02T15:04:05.999999", s, time.Local)
+			t, err = time.Parse("2006-01-02T15:04:05.999999", s)
 		}
+		return t, err == nil, err
 	} else if len(s) == 10 {
 		// "2015-04-09"
-		return time.ParseInLocation("2006-01-02", s, time.Local)
+		t, err = time.Parse("2006-01-02", s)
+		return t, err == nil, err
 	}
 
-	return time.Time{}, fmt.Errorf("Unknown date format: %s", s)
+	return time.Time{}, false, fmt.Errorf("Unknown date format: %s", s)
+}
+
+// The wall clock of ts in loc
+func wallClock(ts time.Time, loc *time.Location) time.Time {
+	y, mo, d := ts.Date()
+	h, mi, sec := ts.Clock()
+	return time.Date(y, mo, d, h, mi, sec, ts.Nanosecond(), loc)
 }
 
 // Parse a timestamp, panic if it fails
@@ -1,6 +1,11 @@
 package ticketmatic
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -30,3 +35,207 @@ func TestParseTime(t *testing.T) {
 		}
 	}
 }
+
+func TestTimeSQL(t *testing.T) {
+	v, err := Time{}.Value()
+	if err != nil || v != nil {
+		t.Errorf("Unexpected value, got %#v, expected nil", v)
+	}
+
+	ts := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	v, err = NewTime(ts).Value()
+	if err != nil || v != ts {
+		t.Errorf("Unexpected value, got %#v, expected %#v", v, ts)
+	}
+
+	for _, src := range []interface{}{ts, "2026-01-02T10:00:00Z", []byte("2026-01-02T10:00:00Z")} {
+		var tm Time
+		err := tm.Scan(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !tm.Time().Equal(ts) {
+			t.Errorf("Unexpected time for %#v, got %s", src, tm.Time())
+		}
+	}
+
+	tm := NewTime(ts)
+	err = tm.Scan(nil)
+	if err != nil || !tm.Time().IsZero() {
+		t.Errorf("Unexpected time, got %s", tm.Time())
+	}
+	err = tm.Scan(int64(1))
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestTimeLocation(t *testing.T) {
+	type object struct {
+		Systemtime Time `json:"systemtime"`
+		Birthdate  Time `json:"birthdate"`
+	}
+
+	var body, query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		query = r.URL.Query().Get("since")
+		w.Write([]byte(`{"systemtime":"2026-07-01 20:00:00","birthdate":"1959-09-21"}`))
+	}))
+	defer srv.Close()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient("test", "key", "secret")
+	c.Server = srv.URL
+	c.Location = ny
+
+	var obj *object
+	err = c.NewRequest("GET", "/{accountname}/x", "json").Run(&obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC)
+	if !obj.Systemtime.Time().Equal(expected) {
+		t.Errorf("Unexpected time, got %s, expected %s", obj.Systemtime.Time(), expected)
+	}
+	if y, m, d := obj.Birthdate.Date(); y != 1959 || m != time.September || d != 21 {
+		t.Errorf("Unexpected date, got %d-%d-%d", y, m, d)
+	}
+
+	// Written in the timezone of the client
+	r := c.NewRequest("POST", "/{accountname}/x", "json")
+	r.AddParameter("since", NewTime(expected))
+	sent := &object{Systemtime: NewTime(expected), Birthdate: NewDate(1959, 9, 21)}
+	r.Body(sent, "json")
+	err = r.Run(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != `{"systemtime":"2026-07-01T20:00:00","birthdate":"1959-09-21T00:00:00"}` {
+		t.Errorf("Unexpected body, got %s", body)
+	}
+	if !reflect.DeepEqual(sent, &object{Systemtime: NewTime(expected), Birthdate: NewDate(1959, 9, 21)}) {
+		t.Errorf("Unexpected change to the request body, got %#v", sent)
+	}
+
+	if query != `"2026-07-01T20:00:00"` {
+		t.Errorf("Unexpected parameter, got %s", query)
+	}
+
+	// Also in maps, which are copied as well
+	values := map[string]Time{"systemtime": NewTime(expected)}
+	r = c.NewRequest("POST", "/{accountname}/x", "json")
+	r.Body(values, "json")
+	err = r.Run(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != `{"systemtime":"2026-07-01T20:00:00"}` {
+		t.Errorf("Unexpected body, got %s", body)
+	}
+	if !reflect.DeepEqual(values, map[string]Time{"systemtime": NewTime(expected)}) {
+		t.Errorf("Unexpected change to the request body, got %#v", values)
+	}
+
+	// Without location, time.Local is used
+	err = json.Unmarshal([]byte(`{"systemtime":"2026-07-01 20:00:00"}`), &obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = time.Date(2026, 7, 1, 20, 0, 0, 0, time.Local)
+	if !obj.Systemtime.Time().Equal(expected) {
+		t.Errorf("Unexpected time, got %s, expected %s", obj.Systemtime.Time(), expected)
+	}
+}
+
+func TestTimeMethods(t *testing.T) {
+	a := NewTime(time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC))
+	b := NewTime(time.Date(2026, 1, 2, 11, 0, 0, 0, time.UTC))
+
+	if !a.Before(b) || a.After(b) || a.Equal(b) || a.Compare(b) != -1 || b.Compare(a) != 1 {
+		t.Errorf("Unexpected comparison of %s and %s", a, b)
+	}
+	if !a.Equal(a.In(time.FixedZone("test", 3600))) {
+		t.Errorf("Expected %s to equal itself in another timezone", a)
+	}
+	if !(Time{}).IsZero() || a.IsZero() {
+		t.Error("Unexpected IsZero")
+	}
+	if a.String() != "2026-01-02T10:00:00" || (Time{}).String() != "" {
+		t.Errorf("Unexpected string, got %#v", a.String())
+	}
+
+	// Text encoding, used for map keys
+	data, err := json.Marshal(map[Time]int{a: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"2026-01-02T10:00:00":1}` {
+		t.Errorf("Unexpected JSON, got %s", data)
+	}
+	var m map[Time]int
+	err = json.Unmarshal([]byte(`{"2026-01-02 10:00:00":1}`), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range m {
+		if k.String() != "2026-01-02T10:00:00" {
+			t.Errorf("Unexpected key, got %s", k)
+		}
+	}
+
+	var tm Time
+	err = tm.UnmarshalText([]byte("2026-01-02"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if y, mo, d := tm.Date(); y != 2026 || mo != time.January || d != 2 {
+		t.Errorf("Unexpected date, got %s", tm)
+	}
+	err = tm.UnmarshalText([]byte("soon"))
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestNullTime(t *testing.T) {
+	var obj struct {
+		A NullTime `json:"a"`
+		B NullTime `json:"b"`
+		C NullTime `json:"c"`
+	}
+	err := json.Unmarshal([]byte(`{"a":null,"b":"2026-01-02 10:00:00"}`), &obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.A.Valid || !obj.B.Valid || obj.C.Valid {
+		t.Errorf("Unexpected validity, got %v %v %v", obj.A.Valid, obj.B.Valid, obj.C.Valid)
+	}
+	if obj.B.String() != "2026-01-02T10:00:00" || obj.A.String() != "null" {
+		t.Errorf("Unexpected strings, got %s and %s", obj.A, obj.B)
+	}
+
+	obj.C = NullTime{Valid: true}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"a":null,"b":"2026-01-02T10:00:00","c":"0001-01-01T00:00:00"}`
+	if string(data) != expected {
+		t.Errorf("Unexpected JSON, got %s, expected %s", data, expected)
+	}
+
+	var n NullTime
+	err = n.Scan(nil)
+	if err != nil || n.Valid {
+		t.Errorf("Unexpected null time, got %#v", n)
+	}
+	v, err := NewNullTime(time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)).Value()
+	if err != nil || v == nil {
+		t.Errorf("Unexpected value, got %#v", v)
+	}
+}
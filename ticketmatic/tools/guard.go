@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"sync"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+// Allow destructive requests only on the accounts with the given short
+// names, as returned by Account. For use as Guard.AllowDestructive:
+//
+//	client.Guard = &ticketmatic.Guard{
+//		AllowDestructive: tools.AllowAccounts("mytest", "mystaging"),
+//	}
+//
+// The account of each client is looked up once.
+func AllowAccounts(shortnames ...string) func(c *ticketmatic.Client) (bool, error) {
+	allowed := make(map[string]bool)
+	for _, name := range shortnames {
+		allowed[name] = true
+	}
+
+	var mutex sync.Mutex
+	accounts := make(map[string]string)
+	return func(c *ticketmatic.Client) (bool, error) {
+		mutex.Lock()
+		shortname, ok := accounts[c.AccountCode]
+		mutex.Unlock()
+		if !ok {
+			account, err := Account(c)
+			if err != nil {
+				return false, err
+			}
+			shortname = account.Shortname
+
+			mutex.Lock()
+			accounts[c.AccountCode] = shortname
+			mutex.Unlock()
+		}
+		return allowed[shortname], nil
+	}
+}
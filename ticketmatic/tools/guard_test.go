@@ -0,0 +1,56 @@
+package tools
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestAllowAccounts(t *testing.T) {
+	lookups := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/test/tools/account":
+			lookups++
+			w.Write([]byte(`{"id":1,"shortname":"test","name":"Test account"}`))
+		case "/api/1/prod/tools/account":
+			lookups++
+			w.Write([]byte(`{"id":2,"shortname":"prod","name":"Production"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	allow := AllowAccounts("test", "staging")
+	for _, tc := range []struct {
+		account string
+		allowed bool
+	}{
+		{"test", true},
+		{"prod", false},
+		{"test", true},
+	} {
+		c := ticketmatic.NewClient(tc.account, "key", "secret")
+		c.Server = srv.URL
+		allowed, err := allow(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if allowed != tc.allowed {
+			t.Errorf("Unexpected result for %s, got %#v, expected %#v", tc.account, allowed, tc.allowed)
+		}
+	}
+	if lookups != 2 {
+		t.Errorf("Unexpected number of lookups, got %d, expected 2", lookups)
+	}
+
+	c := ticketmatic.NewClient("missing", "key", "secret")
+	c.Server = srv.URL
+	_, err := allow(c)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+	"github.com/ticketmatic/tm-go/ticketmatic/filter"
+)
+
+// Default number of rows fetched per page by a QueryIterator
+const DefaultPageSize = 100
+
+// Bind values to the numbered placeholders ($1, $2, ...) in a query on the
+// public data model. Values are quoted as SQL literals (see filter.Literal),
+// placeholders inside quoted strings and identifiers are left untouched.
+//
+//	q, err := tools.Bind("SELECT * FROM tm.order WHERE customerid = $1 AND code = $2", 12, code)
+func Bind(query string, args ...interface{}) (string, error) {
+	var b strings.Builder
+	used := make([]bool, len(args))
+
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '$' && i+1 < len(query) && isDigit(query[i+1]):
+			j := i + 1
+			for j < len(query) && isDigit(query[j]) {
+				j++
+			}
+			n, _ := strconv.Atoi(query[i+1 : j])
+			if n < 1 || n > len(args) {
+				return "", fmt.Errorf("Missing value for placeholder $%d", n)
+			}
+			lit, err := filter.Literal(args[n-1])
+			if err != nil {
+				return "", fmt.Errorf("Placeholder $%d: %s", n, err)
+			}
+			used[n-1] = true
+			b.WriteString(lit)
+			i = j - 1
+			continue
+		}
+		b.WriteByte(c)
+	}
+
+	if quote != 0 {
+		return "", fmt.Errorf("Unterminated quote in query")
+	}
+	for i, u := range used {
+		if !u {
+			return "", fmt.Errorf("Unused value for placeholder $%d", i+1)
+		}
+	}
+	return b.String(), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// Iterates over all rows of a query executed with Queries, fetching them page
+// by page using the Limit and Offset of the request.
+type QueryIterator struct {
+	client *ticketmatic.Client
+	req    ticketmatic.QueryRequest
+
+	page []map[string]interface{}
+	pos  int
+	done bool
+}
+
+// Create a new iterator. The Limit of the request is used as page size
+// (defaults to DefaultPageSize), the Offset as starting point.
+func NewQueryIterator(client *ticketmatic.Client, data *ticketmatic.QueryRequest) *QueryIterator {
+	it := &QueryIterator{
+		client: client,
+		req:    *data,
+	}
+	if it.req.Limit <= 0 {
+		it.req.Limit = DefaultPageSize
+	}
+	return it
+}
+
+// Fetch the next row, returns nil when all rows have been returned.
+func (it *QueryIterator) Next() (map[string]interface{}, error) {
+	for it.pos >= len(it.page) {
+		if it.done {
+			return nil, nil
+		}
+
+		res, err := Queries(it.client, &it.req)
+		if err != nil {
+			return nil, err
+		}
+		it.page = res.Results
+		it.pos = 0
+		it.req.Offset += int64(len(res.Results))
+		if int64(len(res.Results)) < it.req.Limit || (res.Nbrofresults > 0 && it.req.Offset >= res.Nbrofresults) {
+			it.done = true
+		}
+	}
+
+	row := it.page[it.pos]
+	it.pos++
+	return row, nil
+}
+
+// A source of result rows, implemented by QueryIterator and QueryStream.
+type RowReader interface {
+	// Next row, nil when done
+	Next() (map[string]interface{}, error)
+}
+
+// Read all rows into dest, which must be a pointer to a slice of structs (or
+// pointers to structs). See ScanRow for the mapping rules.
+func ScanAll(r RowReader, dest interface{}) error {
+	return scanAll(r, dest)
+}
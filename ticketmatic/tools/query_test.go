@@ -0,0 +1,135 @@
+package tools
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+func TestBind(t *testing.T) {
+	testcases := []struct {
+		query    string
+		args     []interface{}
+		expected string
+	}{
+		{
+			"SELECT * FROM tm.order WHERE customerid = $1 AND code = $2",
+			[]interface{}{12, "x' OR 1=1 --"},
+			"SELECT * FROM tm.order WHERE customerid = 12 AND code = 'x'' OR 1=1 --'",
+		},
+		{
+			"SELECT '$1', \"$1\" FROM tm.order WHERE id IN ($1, $1)",
+			[]interface{}{3},
+			"SELECT '$1', \"$1\" FROM tm.order WHERE id IN (3, 3)",
+		},
+		{
+			"SELECT 'it''s' AS a WHERE $2 < $1 AND $10 IS NULL",
+			[]interface{}{1, 2, 3, 4, 5, 6, 7, 8, 9, nil},
+			"",
+		},
+	}
+	for i, tc := range testcases {
+		got, err := Bind(tc.query, tc.args...)
+		if tc.expected == "" {
+			// Unused values
+			if err == nil {
+				t.Errorf("Expected an error for testcase %d", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.expected {
+			t.Errorf("Unexpected query, got %#v, expected %#v", got, tc.expected)
+		}
+	}
+
+	_, err := Bind("SELECT $2", 1)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+type testRow struct {
+	Id        int64
+	Name      string
+	Amount    ticketmatic.Money `tm:"totalamount"`
+	Price     float64
+	Createdts ticketmatic.Time
+	Paidts    *time.Time
+	Status    ticketmatic.OrderStatus
+	Ignored   string `tm:"-"`
+}
+
+func TestScanRow(t *testing.T) {
+	var row map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"id": 12, "name": 5, "totalamount": 10.1, "price": "2.5",
+		"createdts": "2026-05-01 20:00:00", "paidts": null, "status": 21002,
+		"ignored": "x", "unknown": true
+	}`), &row)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var r testRow
+	err = ScanRow(row, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Id != 12 || r.Name != "5" || r.Amount.String() != "10.10" || r.Price != 2.5 {
+		t.Errorf("Unexpected row: %#v", r)
+	}
+	if r.Createdts.Time().Hour() != 20 || r.Paidts != nil || r.Status != ticketmatic.OrderStatusConfirmed || r.Ignored != "" {
+		t.Errorf("Unexpected row: %#v", r)
+	}
+
+	// Timestamps without offset keep their wall clock in other timezones
+	loc := time.FixedZone("Test", -5*3600)
+	if ts := r.Createdts.In(loc).Time(); ts.Hour() != 20 || ts.Location() != loc {
+		t.Errorf("Unexpected timestamp, got %s", ts)
+	}
+
+	err = ScanRow(map[string]interface{}{"id": 1.5}, &r)
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+}
+
+func TestQueryIterator(t *testing.T) {
+	var offsets []int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ticketmatic.QueryRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		offsets = append(offsets, req.Offset)
+
+		res := &ticketmatic.QueryResult{Nbrofresults: 5}
+		for i := req.Offset; i < 5 && i < req.Offset+req.Limit; i++ {
+			res.Results = append(res.Results, map[string]interface{}{"id": i})
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+	defer srv.Close()
+
+	server := ticketmatic.Server
+	ticketmatic.Server = srv.URL
+	defer func() { ticketmatic.Server = server }()
+
+	c := ticketmatic.NewClient("test", "key", "secret")
+	var rows []*testRow
+	err := ScanAll(NewQueryIterator(c, &ticketmatic.QueryRequest{Query: "SELECT id FROM tm.order", Limit: 2}), &rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 5 || rows[4].Id != 4 {
+		t.Errorf("Unexpected rows: %#v", rows)
+	}
+	if len(offsets) != 3 || offsets[2] != 4 {
+		t.Errorf("Unexpected offsets: %#v", offsets)
+	}
+}
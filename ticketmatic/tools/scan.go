@@ -0,0 +1,250 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ticketmatic/tm-go/ticketmatic"
+)
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	tmTimeType    = reflect.TypeOf(ticketmatic.Time{})
+	moneyType     = reflect.TypeOf(ticketmatic.Money{})
+	interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// Copy the columns of a result row into the fields of a struct.
+//
+// A column is stored in the field with a matching `tm:"column"` tag or,
+// without tag, in the field whose name matches the column (ignoring case).
+// Fields tagged `tm:"-"` are skipped, as are columns without matching field.
+//
+// Values are converted to the field type where possible: numbers to any
+// integer (if integral), float or Money field, timestamps to time.Time and
+// ticketmatic.Time, and anything to string or interface{}. A NULL value leaves
+// the field at its zero value.
+//
+// Timestamps without offset are read like the API types do: a ticketmatic.Time
+// keeps them as a wall clock (see Time.In), a time.Time places them in
+// time.Local.
+func ScanRow(row map[string]interface{}, dest interface{}) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("Scan destination must be a pointer to a struct, got %T", dest)
+	}
+	v = v.Elem()
+
+	fields := structFields(v.Type())
+	for col, val := range row {
+		idx, ok := fields[strings.ToLower(col)]
+		if !ok {
+			continue
+		}
+		err := assign(v.Field(idx), val)
+		if err != nil {
+			return fmt.Errorf("Column %s: %s", col, err)
+		}
+	}
+	return nil
+}
+
+func scanAll(r RowReader, dest interface{}) error {
+	slice := reflect.ValueOf(dest)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("Scan destination must be a pointer to a slice, got %T", dest)
+	}
+	slice = slice.Elem()
+
+	elem := slice.Type().Elem()
+	isPtr := elem.Kind() == reflect.Ptr
+	if isPtr {
+		elem = elem.Elem()
+	}
+
+	for {
+		row, err := r.Next()
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			return nil
+		}
+
+		item := reflect.New(elem)
+		err = ScanRow(row, item.Interface())
+		if err != nil {
+			return err
+		}
+		if isPtr {
+			slice.Set(reflect.Append(slice, item))
+		} else {
+			slice.Set(reflect.Append(slice, item.Elem()))
+		}
+	}
+}
+
+// Map column names to field indexes
+func structFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		name := strings.ToLower(f.Name)
+		if tag := f.Tag.Get("tm"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			name = strings.ToLower(tag)
+		}
+		fields[name] = i
+	}
+	return fields
+}
+
+func assign(field reflect.Value, val interface{}) error {
+	if val == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	if field.Kind() == reflect.Ptr {
+		ptr := reflect.New(field.Type().Elem())
+		err := assign(ptr.Elem(), val)
+		if err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+
+	switch field.Type() {
+	case timeType, tmTimeType:
+		s, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("Cannot convert %T to a timestamp", val)
+		}
+		// Timestamps without offset stay floating in a ticketmatic.Time, so
+		// they can still be placed in the timezone of the account
+		var ts ticketmatic.Time
+		err := ts.UnmarshalText([]byte(s))
+		if err != nil {
+			return err
+		}
+		if field.Type() == timeType {
+			field.Set(reflect.ValueOf(ts.Time()))
+		} else {
+			field.Set(reflect.ValueOf(ts))
+		}
+		return nil
+	case moneyType:
+		var m ticketmatic.Money
+		var err error
+		switch v := val.(type) {
+		case float64:
+			m = ticketmatic.NewMoneyFromFloat(v)
+		case json.Number:
+			m, err = ticketmatic.ParseMoney(string(v))
+		case string:
+			m, err = ticketmatic.ParseMoney(v)
+		default:
+			err = fmt.Errorf("Cannot convert %T to an amount", val)
+		}
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(m))
+		return nil
+	case interfaceType:
+		field.Set(reflect.ValueOf(val))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		switch v := val.(type) {
+		case string:
+			field.SetString(v)
+		case float64:
+			field.SetString(strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			field.SetString(fmt.Sprintf("%v", v))
+		}
+		return nil
+	case reflect.Bool:
+		switch v := val.(type) {
+		case bool:
+			field.SetBool(v)
+		case float64:
+			field.SetBool(v != 0)
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return err
+			}
+			field.SetBool(b)
+		default:
+			return fmt.Errorf("Cannot convert %T to bool", val)
+		}
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f, err := toFloat(val)
+		if err != nil {
+			return err
+		}
+		if f != math.Trunc(f) || field.OverflowInt(int64(f)) {
+			return fmt.Errorf("Cannot store %v in %s", val, field.Type())
+		}
+		field.SetInt(int64(f))
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		f, err := toFloat(val)
+		if err != nil {
+			return err
+		}
+		if f != math.Trunc(f) || f < 0 || field.OverflowUint(uint64(f)) {
+			return fmt.Errorf("Cannot store %v in %s", val, field.Type())
+		}
+		field.SetUint(uint64(f))
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := toFloat(val)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+		return nil
+	}
+
+	// Anything else (maps, slices, nested structs): go through JSON
+	data, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, field.Addr().Interface())
+}
+
+func toFloat(val interface{}) (float64, error) {
+	switch v := val.(type) {
+	case float64:
+		return v, nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("Cannot convert %T to a number", val)
+}
@@ -0,0 +1,285 @@
+package ticketmatic
+
+import (
+	"bytes"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"time"
+)
+
+// Maximum number of tickets that can be added in a single call
+const MaxAddTickets = 50
+
+// A validation failure for a single field
+type FieldError struct {
+	// Path to the field, using the JSON names: "saleschannels[0].saleendts"
+	Field string
+
+	// What's wrong with it
+	Message string
+}
+
+func (e *FieldError) String() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Error returned when a request payload fails client-side validation
+type ValidationError struct {
+	// Name of the validated type
+	Type string
+
+	// Field-level errors
+	Fields []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Invalid %s:", e.Type)
+	for i, f := range e.Fields {
+		if i > 0 {
+			buf.WriteString(";")
+		}
+		fmt.Fprintf(&buf, " %s", f)
+	}
+	return buf.String()
+}
+
+// Implemented by request types that can be validated before sending.
+//
+// Validation is opt-in: call Validate yourself, or set Client.ValidateRequests
+// to validate every request body before it is sent.
+type Validator interface {
+	// Validate a complete object, as used when creating.
+	Validate() error
+
+	// Validate a partial object, as used when updating: required fields are
+	// not checked.
+	ValidatePartial() error
+}
+
+// Collects field errors while validating
+type validation struct {
+	typ     string
+	partial bool
+	prefix  string
+	fields  []*FieldError
+}
+
+func newValidation(typ string, partial bool) *validation {
+	return &validation{
+		typ:     typ,
+		partial: partial,
+	}
+}
+
+func (v *validation) fail(field, format string, args ...interface{}) {
+	v.fields = append(v.fields, &FieldError{
+		Field:   v.prefix + field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Run fn with a prefix for nested fields
+func (v *validation) nested(prefix string, fn func()) {
+	old := v.prefix
+	v.prefix = old + prefix + "."
+	fn()
+	v.prefix = old
+}
+
+func (v *validation) required(field string, set bool) {
+	if !v.partial && !set {
+		v.fail(field, "is required")
+	}
+}
+
+func (v *validation) positive(field string, val int64) {
+	if val < 0 {
+		v.fail(field, "must not be negative")
+	}
+}
+
+func (v *validation) before(startfield string, start Time, endfield string, end Time) {
+	if !start.Time().IsZero() && !end.Time().IsZero() && start.Time().After(end.Time()) {
+		v.fail(endfield, "must not be before %s", startfield)
+	}
+}
+
+func (v *validation) email(field, val string) {
+	if val == "" {
+		return
+	}
+	addr, err := mail.ParseAddress(val)
+	if err != nil || addr.Address != val {
+		v.fail(field, "is not a valid email address")
+	}
+}
+
+func (v *validation) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{
+		Type:   v.typ,
+		Fields: v.fields,
+	}
+}
+
+// Validate the event
+func (o *Event) Validate() error {
+	return o.validate(false)
+}
+
+// Validate the event, without checking required fields
+func (o *Event) ValidatePartial() error {
+	return o.validate(true)
+}
+
+func (o *Event) validate(partial bool) error {
+	v := newValidation("Event", partial)
+	v.required("name", o.Name != "")
+	v.before("startts", o.Startts, "endts", o.Endts)
+	v.before("salestartts", o.Salestartts, "saleendts", o.Saleendts)
+	v.positive("maxnbrofticketsperbasket", o.Maxnbrofticketsperbasket)
+	v.positive("totalmaxtickets", o.Totalmaxtickets)
+	for i, c := range o.Saleschannels {
+		v.nested(fmt.Sprintf("saleschannels[%d]", i), func() {
+			v.required("saleschannelid", c.Saleschannelid > 0)
+			v.before("salestartts", c.Salestartts, "saleendts", c.Saleendts)
+		})
+	}
+	return v.err()
+}
+
+// Validate the order creation request
+func (o *CreateOrder) Validate() error {
+	return o.validate(false)
+}
+
+// Validate the order creation request, without checking required fields
+func (o *CreateOrder) ValidatePartial() error {
+	return o.validate(true)
+}
+
+func (o *CreateOrder) validate(partial bool) error {
+	v := newValidation("CreateOrder", partial)
+	v.required("saleschannelid", o.Saleschannelid > 0)
+	return v.err()
+}
+
+// Validate the contact
+func (o *Contact) Validate() error {
+	return o.validate(false)
+}
+
+// Validate the contact, without checking required fields
+func (o *Contact) ValidatePartial() error {
+	return o.validate(true)
+}
+
+func (o *Contact) validate(partial bool) error {
+	v := newValidation("Contact", partial)
+	v.email("email", o.Email)
+	if o.Birthdate.Time().After(time.Now()) {
+		v.fail("birthdate", "must not be in the future")
+	}
+	for i, a := range o.Addresses {
+		if a.Countrycode != "" && len(a.Countrycode) != 2 {
+			v.fail(fmt.Sprintf("addresses[%d].countrycode", i), "must be a two-letter ISO 3166-1 code")
+		}
+	}
+	return v.err()
+}
+
+// Validate the tickets to add
+func (o *AddTickets) Validate() error {
+	return o.validate(false)
+}
+
+// Validate the tickets to add, without checking required fields
+func (o *AddTickets) ValidatePartial() error {
+	return o.validate(true)
+}
+
+func (o *AddTickets) validate(partial bool) error {
+	v := newValidation("AddTickets", partial)
+	v.required("tickets", len(o.Tickets) > 0)
+	if len(o.Tickets) > MaxAddTickets {
+		v.fail("tickets", "at most %d tickets can be added per call", MaxAddTickets)
+	}
+	for i, t := range o.Tickets {
+		if t.Tickettypepriceid != 0 && t.Optionbundleid != 0 {
+			v.fail(fmt.Sprintf("tickets[%d].optionbundleid", i), "must not be combined with tickettypepriceid")
+		}
+		if t.Tickettypepriceid == 0 && t.Optionbundleid == 0 && t.Ticketid == 0 {
+			v.fail(fmt.Sprintf("tickets[%d].tickettypepriceid", i), "is required")
+		}
+	}
+	return v.err()
+}
+
+// Validate the payment to add
+func (o *AddPayments) Validate() error {
+	return o.validate(false)
+}
+
+// Validate the payment to add, without checking required fields
+func (o *AddPayments) ValidatePartial() error {
+	return o.validate(true)
+}
+
+func (o *AddPayments) validate(partial bool) error {
+	v := newValidation("AddPayments", partial)
+	v.required("paymentmethodid", o.Paymentmethodid > 0)
+	return v.err()
+}
+
+// Validate the query
+func (o *QueryRequest) Validate() error {
+	return o.validate(false)
+}
+
+// Validate the query, without checking required fields
+func (o *QueryRequest) ValidatePartial() error {
+	return o.validate(true)
+}
+
+func (o *QueryRequest) validate(partial bool) error {
+	v := newValidation("QueryRequest", partial)
+	v.required("query", o.Query != "")
+	v.positive("limit", o.Limit)
+	v.positive("offset", o.Offset)
+	return v.err()
+}
+
+// Validate a request body: a Validator or a slice of them. Updates (PUT) are
+// validated partially.
+func validateBody(method string, body interface{}) error {
+	partial := method == "PUT"
+
+	if val, ok := body.(Validator); ok {
+		if partial {
+			return val.ValidatePartial()
+		}
+		return val.Validate()
+	}
+
+	rv := reflect.ValueOf(body)
+	if rv.Kind() != reflect.Slice {
+		return nil
+	}
+	for i := 0; i < rv.Len(); i++ {
+		err := validateBody(method, rv.Index(i).Interface())
+		if verr, ok := err.(*ValidationError); ok {
+			for _, f := range verr.Fields {
+				f.Field = fmt.Sprintf("[%d].%s", i, f.Field)
+			}
+			return verr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package ticketmatic
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateEvent(t *testing.T) {
+	start := time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC)
+	ev := &Event{
+		Startts: NewTime(start),
+		Endts:   NewTime(start.Add(-time.Hour)),
+		Saleschannels: []*EventSalesChannel{
+			{Saleschannelid: 1, Salestartts: NewTime(start), Saleendts: NewTime(start.Add(-time.Hour))},
+		},
+	}
+
+	err := ev.Validate()
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+
+	expected := []string{"name", "endts", "saleschannels[0].saleendts"}
+	if len(verr.Fields) != len(expected) {
+		t.Fatalf("Unexpected errors: %s", verr)
+	}
+	for i, f := range expected {
+		if verr.Fields[i].Field != f {
+			t.Errorf("Unexpected verr.Fields[%d].Field, got %#v, expected %#v", i, verr.Fields[i].Field, f)
+		}
+	}
+
+	// Updates don't need the name
+	ev.Endts = NewTime(start.Add(time.Hour))
+	ev.Saleschannels = nil
+	err = ev.ValidatePartial()
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestValidateContact(t *testing.T) {
+	testcases := []struct {
+		email string
+		valid bool
+	}{
+		{"", true},
+		{"info@ticketmatic.com", true},
+		{"invalid", false},
+		{"Info <info@ticketmatic.com>", false},
+	}
+	for _, tc := range testcases {
+		err := (&Contact{Email: tc.email}).Validate()
+		if (err == nil) != tc.valid {
+			t.Errorf("Unexpected result for %#v: %v", tc.email, err)
+		}
+	}
+}
+
+func TestValidateBody(t *testing.T) {
+	err := validateBody("POST", &CreateOrder{})
+	if err == nil {
+		t.Fatal("Expected an error!")
+	}
+
+	err = validateBody("POST", []*Contact{{Email: "a@b.com"}, {Email: "invalid"}})
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	if verr.Fields[0].Field != "[1].email" {
+		t.Errorf("Unexpected field, got %#v", verr.Fields[0].Field)
+	}
+
+	err = validateBody("POST", map[string]string{"a": "b"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestValidateRequests(t *testing.T) {
+	c := NewClient("test", "key", "secret")
+	c.ValidateRequests = true
+
+	r := c.NewRequest("POST", "/{accountname}/orders", "json")
+	r.Body(&CreateOrder{}, "json")
+	err := r.Run(nil)
+	if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("Expected a validation error, got %#v", err)
+	}
+}